@@ -1,6 +1,9 @@
 package api
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Availability Templates
 type AvailabilityTemplateRequest struct {
@@ -38,12 +41,29 @@ type TimeBlockRequest struct {
 }
 
 type TimeBlockResponse struct {
-	ID        string    `json:"id"`
-	TeacherID string    `json:"teacher_id"`
-	Start     time.Time `json:"start"`
-	End       time.Time `json:"end"`
-	Reason    string    `json:"reason"`
-	Type      string    `json:"type"`
+	ID          string    `json:"id"`
+	TeacherID   string    `json:"teacher_id"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Reason      string    `json:"reason"`
+	Type        string    `json:"type"`
+	ExternalUID *string   `json:"external_uid,omitempty"`
+}
+
+type TimeBlockImportSkipped struct {
+	UID    string `json:"uid"`
+	Reason string `json:"reason"`
+}
+
+// TimeBlockImportResponse — итог синхронизации внешнего календаря за окно [from, to)
+type TimeBlockImportResponse struct {
+	TeacherID string                   `json:"teacher_id"`
+	From      time.Time                `json:"from"`
+	To        time.Time                `json:"to"`
+	Created   int                      `json:"created"`
+	Updated   int                      `json:"updated"`
+	Deleted   int                      `json:"deleted"`
+	Skipped   []TimeBlockImportSkipped `json:"skipped"`
 }
 
 // Slots
@@ -74,16 +94,19 @@ type SlotBatchRequest struct {
 
 // Bookings
 type BookingRequest struct {
-	SlotID    string                 `json:"slot_id"`
-	StudentID string                 `json:"student_id"`
+	SlotID    string  `json:"slot_id"`
+	StudentID string  `json:"student_id"`
+	CreditID  *string `json:"credit_id,omitempty"`
 }
 
 type BookingResponse struct {
-	ID        string                 `json:"id"`
-	SlotID    string                 `json:"slot_id"`
-	StudentID string                 `json:"student_id"`
-	TeacherID string                 `json:"teacher_id"`
-	Status    string                 `json:"status"`
+	ID                          string     `json:"id"`
+	SlotID                      string     `json:"slot_id"`
+	StudentID                   string     `json:"student_id"`
+	TeacherID                   string     `json:"teacher_id"`
+	Status                      string     `json:"status"`
+	RequiresTeacherConfirmation bool       `json:"requires_teacher_confirmation"`
+	DeletedAt                   *time.Time `json:"deleted_at,omitempty"`
 }
 
 type BookingRescheduleRequest struct {
@@ -91,16 +114,270 @@ type BookingRescheduleRequest struct {
 	NewSlotID string `json:"new_slot_id"`
 }
 
+type BookingEventResponse struct {
+	ID         string    `json:"id"`
+	BookingID  string    `json:"booking_id"`
+	Type       string    `json:"type"`
+	FromStatus *string   `json:"from_status,omitempty"`
+	ToStatus   *string   `json:"to_status,omitempty"`
+	FromSlotID *string   `json:"from_slot_id,omitempty"`
+	ToSlotID   *string   `json:"to_slot_id,omitempty"`
+	Actor      string    `json:"actor"`
+	RequestID  string    `json:"request_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Booking Rules
+type BookingRulesRequest struct {
+	TeacherID         string  `json:"teacher_id"`
+	TemplateID        *string `json:"template_id,omitempty"`
+	MinNoticeHours    *int    `json:"min_notice_hours,omitempty"`
+	MaxAdvanceDays    *int    `json:"max_advance_days,omitempty"`
+	MaxWeeklyBookings *int    `json:"max_weekly_bookings,omitempty"`
+}
+
+type BookingRulesResponse struct {
+	ID                string  `json:"id"`
+	TeacherID         string  `json:"teacher_id"`
+	TemplateID        *string `json:"template_id,omitempty"`
+	MinNoticeHours    *int    `json:"min_notice_hours,omitempty"`
+	MaxAdvanceDays    *int    `json:"max_advance_days,omitempty"`
+	MaxWeeklyBookings *int    `json:"max_weekly_bookings,omitempty"`
+}
+
 // Attendance
 type AttendanceRequest struct {
 	BookingID string `json:"booking_id"`
 	Status    string `json:"status"`
 	Notes     string `json:"notes"`
+	Excused   bool   `json:"excused"`
+}
+
+// AttendanceBulkRequest — отметка посещаемости за день преподавателя.
+// При mark_rest_present все остальные подтверждённые брони teacher_id на date отмечаются present.
+type AttendanceBulkRequest struct {
+	TeacherID       string              `json:"teacher_id"`
+	Date            string              `json:"date"`
+	Items           []AttendanceRequest `json:"items"`
+	MarkRestPresent bool                `json:"mark_rest_present"`
+}
+
+type AttendanceBulkItemError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type AttendanceBulkItemResult struct {
+	BookingID    string                   `json:"booking_id"`
+	AttendanceID string                   `json:"attendance_id,omitempty"`
+	Status       string                   `json:"status,omitempty"`
+	Created      bool                     `json:"created"`
+	Error        *AttendanceBulkItemError `json:"error,omitempty"`
+}
+
+type AttendanceBulkResponse struct {
+	Results       []AttendanceBulkItemResult `json:"results"`
+	MarkedPresent []AttendanceResponse       `json:"marked_present"`
+}
+
+type AttendanceUpdateRequest struct {
+	Status  string `json:"status"`
+	Notes   string `json:"notes"`
+	Excused bool   `json:"excused"`
+}
+
+type AttendancePatchRequest struct {
+	Status  *string `json:"status,omitempty"`
+	Notes   *string `json:"notes,omitempty"`
+	Excused *bool   `json:"excused,omitempty"`
 }
 
 type AttendanceResponse struct {
+	ID          string     `json:"id"`
+	BookingID   string     `json:"booking_id"`
+	Status      string     `json:"status"`
+	Notes       string     `json:"notes"`
+	AutoMarked  bool       `json:"auto_marked"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+	Excused     bool       `json:"excused"`
+}
+
+type CheckInRequest struct {
+	Code string `json:"code"`
+}
+
+type CheckInCodeResponse struct {
+	SlotID      string    `json:"slot_id"`
+	Code        string    `json:"code"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	StepSeconds int       `json:"step_seconds"`
+}
+
+// Makeup Credits
+type MakeupCreditResponse struct {
+	ID                string     `json:"id"`
+	StudentID         string     `json:"student_id"`
+	TeacherID         string     `json:"teacher_id"`
+	SourceBookingID   string     `json:"source_booking_id"`
+	Reason            string     `json:"reason"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	RedeemedBookingID *string    `json:"redeemed_booking_id,omitempty"`
+	RedeemedAt        *time.Time `json:"redeemed_at,omitempty"`
+}
+
+// Calendars
+type CalendarTokenResponse struct {
+	OwnerType string `json:"owner_type"`
+	OwnerID   string `json:"owner_id"`
+	Token     string `json:"token"`
+	URL       string `json:"url"`
+}
+
+// Webhooks
+type WebhookSubscriptionRequest struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// Secret генерируется, если не передан; при обновлении пустой secret оставляет прежний
+	Secret  string `json:"secret,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+type WebhookSubscriptionResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Enabled    bool      `json:"enabled"`
+	Secret     string    `json:"secret,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastError      *string         `json:"last_error,omitempty"`
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+// API Keys
+type APIKeyRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// TeacherID ограничивает ключ данными одного преподавателя
+	TeacherID *string    `json:"teacher_id,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	TeacherID  *string    `json:"teacher_id,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedBy  *string    `json:"created_by,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	RevokedBy  *string    `json:"revoked_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	// Key — сам ключ, возвращается только при создании
+	Key string `json:"key,omitempty"`
+}
+
+// WebhookEvent — тело доставки вебхука
+type WebhookEvent struct {
 	ID        string    `json:"id"`
-	BookingID string    `json:"booking_id"`
-	Status    string    `json:"status"`
-	Notes     string    `json:"notes"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// BookingEventData — data событий booking.*
+type BookingEventData struct {
+	BookingResponse
+	PreviousStatus *string `json:"previous_status,omitempty"`
+	PreviousSlotID *string `json:"previous_slot_id,omitempty"`
+	Actor          string  `json:"actor,omitempty"`
+}
+
+// AttendanceEventData — data события attendance.recorded
+type AttendanceEventData struct {
+	AttendanceResponse
+	SlotID    string `json:"slot_id"`
+	StudentID string `json:"student_id"`
+	TeacherID string `json:"teacher_id"`
+	Actor     string `json:"actor,omitempty"`
+}
+
+// SlotGeneratedEventData — data события slot.generated
+type SlotGeneratedEventData struct {
+	JobID      string    `json:"job_id"`
+	TemplateID string    `json:"template_id"`
+	TeacherID  string    `json:"teacher_id"`
+	From       time.Time `json:"from"`
+	To         time.Time `json:"to"`
+	Created    int       `json:"created"`
+}
+
+// SlotEventData — data события slot.status_changed: слот после изменения статуса
+type SlotEventData struct {
+	SlotResponse
+	PreviousStatus string `json:"previous_status"`
+}
+
+// Reminders
+type ReminderResponse struct {
+	ID            string     `json:"id"`
+	BookingID     string     `json:"booking_id"`
+	RecipientRole string     `json:"recipient_role"`
+	RecipientID   string     `json:"recipient_id"`
+	OffsetMinutes int        `json:"offset_minutes"`
+	LessonStart   time.Time  `json:"lesson_start"`
+	SendAt        time.Time  `json:"send_at"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     *string    `json:"last_error,omitempty"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+}
+
+// Student Restrictions
+type StudentRestrictionResponse struct {
+	ID                string     `json:"id"`
+	StudentID         string     `json:"student_id"`
+	Mode              string     `json:"mode"`
+	Absences          int        `json:"absences"`
+	LateCancellations int        `json:"late_cancellations"`
+	StartsAt          time.Time  `json:"starts_at"`
+	EndsAt            time.Time  `json:"ends_at"`
+	Active            bool       `json:"active"`
+	LiftedAt          *time.Time `json:"lifted_at,omitempty"`
+	LiftedBy          *string    `json:"lifted_by,omitempty"`
+}
+
+// Reports
+type AttendanceStatsResponse struct {
+	Key            string  `json:"key"`
+	Present        int     `json:"present"`
+	Absent         int     `json:"absent"`
+	Late           int     `json:"late"`
+	Total          int     `json:"total"`
+	AttendanceRate float64 `json:"attendance_rate"`
+	LateRate       float64 `json:"late_rate"`
+}
+
+type AttendanceReportResponse struct {
+	GroupBy       string                    `json:"group_by"`
+	From          time.Time                 `json:"from"`
+	To            time.Time                 `json:"to"`
+	Groups        []AttendanceStatsResponse `json:"groups"`
+	Totals        AttendanceStatsResponse   `json:"totals"`
+	LatenessTrend []AttendanceStatsResponse `json:"lateness_trend"`
 }
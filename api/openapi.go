@@ -0,0 +1,8 @@
+package api
+
+import _ "embed"
+
+// OpenAPISpec — спецификация REST API (openapi.yml), встроенная в бинарь
+//
+//go:embed openapi.yml
+var OpenAPISpec []byte
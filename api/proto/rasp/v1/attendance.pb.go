@@ -0,0 +1,1044 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rasp/v1/attendance.proto
+
+package raspv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Attendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	AutoMarked    bool                   `protobuf:"varint,5,opt,name=auto_marked,json=autoMarked,proto3" json:"auto_marked,omitempty"`
+	CheckedInAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	Excused       bool                   `protobuf:"varint,7,opt,name=excused,proto3" json:"excused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attendance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attendance) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Attendance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Attendance) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Attendance) GetAutoMarked() bool {
+	if x != nil {
+		return x.AutoMarked
+	}
+	return false
+}
+
+func (x *Attendance) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+func (x *Attendance) GetExcused() bool {
+	if x != nil {
+		return x.Excused
+	}
+	return false
+}
+
+type AttendanceInput struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// present, absent или late
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Notes         string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Excused       bool   `protobuf:"varint,4,opt,name=excused,proto3" json:"excused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceInput) Reset() {
+	*x = AttendanceInput{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceInput) ProtoMessage() {}
+
+func (x *AttendanceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceInput.ProtoReflect.Descriptor instead.
+func (*AttendanceInput) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttendanceInput) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *AttendanceInput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendanceInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AttendanceInput) GetExcused() bool {
+	if x != nil {
+		return x.Excused
+	}
+	return false
+}
+
+type CreateAttendanceRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Attendance *AttendanceInput       `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	// Перезаписать существующую отметку по той же брони (REST: ?upsert=true)
+	Upsert        bool `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttendanceRequest) Reset() {
+	*x = CreateAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttendanceRequest) ProtoMessage() {}
+
+func (x *CreateAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAttendanceRequest) GetAttendance() *AttendanceInput {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *CreateAttendanceRequest) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+type CreateAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendance    *Attendance            `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttendanceResponse) Reset() {
+	*x = CreateAttendanceResponse{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttendanceResponse) ProtoMessage() {}
+
+func (x *CreateAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAttendanceResponse) GetAttendance() *Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *CreateAttendanceResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type GetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAttendanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     *string                `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttendanceRequest) Reset() {
+	*x = ListAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttendanceRequest) ProtoMessage() {}
+
+func (x *ListAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ListAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAttendanceRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListAttendanceRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAttendanceRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendance    []*Attendance          `protobuf:"bytes,1,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttendanceResponse) Reset() {
+	*x = ListAttendanceResponse{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttendanceResponse) ProtoMessage() {}
+
+func (x *ListAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*ListAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAttendanceResponse) GetAttendance() []*Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type UpdateAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Excused       bool                   `protobuf:"varint,4,opt,name=excused,proto3" json:"excused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAttendanceRequest) Reset() {
+	*x = UpdateAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAttendanceRequest) ProtoMessage() {}
+
+func (x *UpdateAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateAttendanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAttendanceRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateAttendanceRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateAttendanceRequest) GetExcused() bool {
+	if x != nil {
+		return x.Excused
+	}
+	return false
+}
+
+type PatchAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Notes         *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	Excused       *bool                  `protobuf:"varint,4,opt,name=excused,proto3,oneof" json:"excused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchAttendanceRequest) Reset() {
+	*x = PatchAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchAttendanceRequest) ProtoMessage() {}
+
+func (x *PatchAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*PatchAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PatchAttendanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchAttendanceRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *PatchAttendanceRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *PatchAttendanceRequest) GetExcused() bool {
+	if x != nil && x.Excused != nil {
+		return *x.Excused
+	}
+	return false
+}
+
+type DeleteAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttendanceRequest) Reset() {
+	*x = DeleteAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttendanceRequest) ProtoMessage() {}
+
+func (x *DeleteAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteAttendanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BulkAttendanceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// YYYY-MM-DD
+	Date            string             `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Items           []*AttendanceInput `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	MarkRestPresent bool               `protobuf:"varint,4,opt,name=mark_rest_present,json=markRestPresent,proto3" json:"mark_rest_present,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkAttendanceRequest) Reset() {
+	*x = BulkAttendanceRequest{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAttendanceRequest) ProtoMessage() {}
+
+func (x *BulkAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*BulkAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *BulkAttendanceRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BulkAttendanceRequest) GetItems() []*AttendanceInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BulkAttendanceRequest) GetMarkRestPresent() bool {
+	if x != nil {
+		return x.MarkRestPresent
+	}
+	return false
+}
+
+type BulkAttendanceItemError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAttendanceItemError) Reset() {
+	*x = BulkAttendanceItemError{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAttendanceItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAttendanceItemError) ProtoMessage() {}
+
+func (x *BulkAttendanceItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAttendanceItemError.ProtoReflect.Descriptor instead.
+func (*BulkAttendanceItemError) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BulkAttendanceItemError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BulkAttendanceItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BulkAttendanceItemResult struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	BookingId     string                   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	AttendanceId  string                   `protobuf:"bytes,2,opt,name=attendance_id,json=attendanceId,proto3" json:"attendance_id,omitempty"`
+	Status        string                   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Created       bool                     `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Error         *BulkAttendanceItemError `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAttendanceItemResult) Reset() {
+	*x = BulkAttendanceItemResult{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAttendanceItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAttendanceItemResult) ProtoMessage() {}
+
+func (x *BulkAttendanceItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAttendanceItemResult.ProtoReflect.Descriptor instead.
+func (*BulkAttendanceItemResult) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BulkAttendanceItemResult) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BulkAttendanceItemResult) GetAttendanceId() string {
+	if x != nil {
+		return x.AttendanceId
+	}
+	return ""
+}
+
+func (x *BulkAttendanceItemResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkAttendanceItemResult) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *BulkAttendanceItemResult) GetError() *BulkAttendanceItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BulkAttendanceResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Results       []*BulkAttendanceItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	MarkedPresent []*Attendance               `protobuf:"bytes,2,rep,name=marked_present,json=markedPresent,proto3" json:"marked_present,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAttendanceResponse) Reset() {
+	*x = BulkAttendanceResponse{}
+	mi := &file_rasp_v1_attendance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAttendanceResponse) ProtoMessage() {}
+
+func (x *BulkAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_attendance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*BulkAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_attendance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BulkAttendanceResponse) GetResults() []*BulkAttendanceItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkAttendanceResponse) GetMarkedPresent() []*Attendance {
+	if x != nil {
+		return x.MarkedPresent
+	}
+	return nil
+}
+
+var File_rasp_v1_attendance_proto protoreflect.FileDescriptor
+
+const file_rasp_v1_attendance_proto_rawDesc = "" +
+	"\n" +
+	"\x18rasp/v1/attendance.proto\x12\arasp.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x01\n" +
+	"\n" +
+	"Attendance\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12\x1f\n" +
+	"\vauto_marked\x18\x05 \x01(\bR\n" +
+	"autoMarked\x12>\n" +
+	"\rchecked_in_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcheckedInAt\x12\x18\n" +
+	"\aexcused\x18\a \x01(\bR\aexcused\"x\n" +
+	"\x0fAttendanceInput\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x18\n" +
+	"\aexcused\x18\x04 \x01(\bR\aexcused\"k\n" +
+	"\x17CreateAttendanceRequest\x128\n" +
+	"\n" +
+	"attendance\x18\x01 \x01(\v2\x18.rasp.v1.AttendanceInputR\n" +
+	"attendance\x12\x16\n" +
+	"\x06upsert\x18\x02 \x01(\bR\x06upsert\"i\n" +
+	"\x18CreateAttendanceResponse\x123\n" +
+	"\n" +
+	"attendance\x18\x01 \x01(\v2\x13.rasp.v1.AttendanceR\n" +
+	"attendance\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"&\n" +
+	"\x14GetAttendanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x01\n" +
+	"\x15ListAttendanceRequest\x12\"\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tH\x00R\tteacherId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02toB\r\n" +
+	"\v_teacher_id\"M\n" +
+	"\x16ListAttendanceResponse\x123\n" +
+	"\n" +
+	"attendance\x18\x01 \x03(\v2\x13.rasp.v1.AttendanceR\n" +
+	"attendance\"q\n" +
+	"\x17UpdateAttendanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x18\n" +
+	"\aexcused\x18\x04 \x01(\bR\aexcused\"\xa0\x01\n" +
+	"\x16PatchAttendanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x03 \x01(\tH\x01R\x05notes\x88\x01\x01\x12\x1d\n" +
+	"\aexcused\x18\x04 \x01(\bH\x02R\aexcused\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_notesB\n" +
+	"\n" +
+	"\b_excused\")\n" +
+	"\x17DeleteAttendanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x01\n" +
+	"\x15BulkAttendanceRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12.\n" +
+	"\x05items\x18\x03 \x03(\v2\x18.rasp.v1.AttendanceInputR\x05items\x12*\n" +
+	"\x11mark_rest_present\x18\x04 \x01(\bR\x0fmarkRestPresent\"G\n" +
+	"\x17BulkAttendanceItemError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc8\x01\n" +
+	"\x18BulkAttendanceItemResult\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12#\n" +
+	"\rattendance_id\x18\x02 \x01(\tR\fattendanceId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\bR\acreated\x126\n" +
+	"\x05error\x18\x05 \x01(\v2 .rasp.v1.BulkAttendanceItemErrorR\x05error\"\x91\x01\n" +
+	"\x16BulkAttendanceResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.rasp.v1.BulkAttendanceItemResultR\aresults\x12:\n" +
+	"\x0emarked_present\x18\x02 \x03(\v2\x13.rasp.v1.AttendanceR\rmarkedPresent2\xb9\x04\n" +
+	"\x11AttendanceService\x12W\n" +
+	"\x10CreateAttendance\x12 .rasp.v1.CreateAttendanceRequest\x1a!.rasp.v1.CreateAttendanceResponse\x12C\n" +
+	"\rGetAttendance\x12\x1d.rasp.v1.GetAttendanceRequest\x1a\x13.rasp.v1.Attendance\x12Q\n" +
+	"\x0eListAttendance\x12\x1e.rasp.v1.ListAttendanceRequest\x1a\x1f.rasp.v1.ListAttendanceResponse\x12I\n" +
+	"\x10UpdateAttendance\x12 .rasp.v1.UpdateAttendanceRequest\x1a\x13.rasp.v1.Attendance\x12G\n" +
+	"\x0fPatchAttendance\x12\x1f.rasp.v1.PatchAttendanceRequest\x1a\x13.rasp.v1.Attendance\x12L\n" +
+	"\x10DeleteAttendance\x12 .rasp.v1.DeleteAttendanceRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x0eBulkAttendance\x12\x1e.rasp.v1.BulkAttendanceRequest\x1a\x1f.rasp.v1.BulkAttendanceResponseB'Z%rasp-service/api/proto/rasp/v1;raspv1b\x06proto3"
+
+var (
+	file_rasp_v1_attendance_proto_rawDescOnce sync.Once
+	file_rasp_v1_attendance_proto_rawDescData []byte
+)
+
+func file_rasp_v1_attendance_proto_rawDescGZIP() []byte {
+	file_rasp_v1_attendance_proto_rawDescOnce.Do(func() {
+		file_rasp_v1_attendance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rasp_v1_attendance_proto_rawDesc), len(file_rasp_v1_attendance_proto_rawDesc)))
+	})
+	return file_rasp_v1_attendance_proto_rawDescData
+}
+
+var file_rasp_v1_attendance_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rasp_v1_attendance_proto_goTypes = []any{
+	(*Attendance)(nil),               // 0: rasp.v1.Attendance
+	(*AttendanceInput)(nil),          // 1: rasp.v1.AttendanceInput
+	(*CreateAttendanceRequest)(nil),  // 2: rasp.v1.CreateAttendanceRequest
+	(*CreateAttendanceResponse)(nil), // 3: rasp.v1.CreateAttendanceResponse
+	(*GetAttendanceRequest)(nil),     // 4: rasp.v1.GetAttendanceRequest
+	(*ListAttendanceRequest)(nil),    // 5: rasp.v1.ListAttendanceRequest
+	(*ListAttendanceResponse)(nil),   // 6: rasp.v1.ListAttendanceResponse
+	(*UpdateAttendanceRequest)(nil),  // 7: rasp.v1.UpdateAttendanceRequest
+	(*PatchAttendanceRequest)(nil),   // 8: rasp.v1.PatchAttendanceRequest
+	(*DeleteAttendanceRequest)(nil),  // 9: rasp.v1.DeleteAttendanceRequest
+	(*BulkAttendanceRequest)(nil),    // 10: rasp.v1.BulkAttendanceRequest
+	(*BulkAttendanceItemError)(nil),  // 11: rasp.v1.BulkAttendanceItemError
+	(*BulkAttendanceItemResult)(nil), // 12: rasp.v1.BulkAttendanceItemResult
+	(*BulkAttendanceResponse)(nil),   // 13: rasp.v1.BulkAttendanceResponse
+	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 15: google.protobuf.Empty
+}
+var file_rasp_v1_attendance_proto_depIdxs = []int32{
+	14, // 0: rasp.v1.Attendance.checked_in_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: rasp.v1.CreateAttendanceRequest.attendance:type_name -> rasp.v1.AttendanceInput
+	0,  // 2: rasp.v1.CreateAttendanceResponse.attendance:type_name -> rasp.v1.Attendance
+	14, // 3: rasp.v1.ListAttendanceRequest.from:type_name -> google.protobuf.Timestamp
+	14, // 4: rasp.v1.ListAttendanceRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 5: rasp.v1.ListAttendanceResponse.attendance:type_name -> rasp.v1.Attendance
+	1,  // 6: rasp.v1.BulkAttendanceRequest.items:type_name -> rasp.v1.AttendanceInput
+	11, // 7: rasp.v1.BulkAttendanceItemResult.error:type_name -> rasp.v1.BulkAttendanceItemError
+	12, // 8: rasp.v1.BulkAttendanceResponse.results:type_name -> rasp.v1.BulkAttendanceItemResult
+	0,  // 9: rasp.v1.BulkAttendanceResponse.marked_present:type_name -> rasp.v1.Attendance
+	2,  // 10: rasp.v1.AttendanceService.CreateAttendance:input_type -> rasp.v1.CreateAttendanceRequest
+	4,  // 11: rasp.v1.AttendanceService.GetAttendance:input_type -> rasp.v1.GetAttendanceRequest
+	5,  // 12: rasp.v1.AttendanceService.ListAttendance:input_type -> rasp.v1.ListAttendanceRequest
+	7,  // 13: rasp.v1.AttendanceService.UpdateAttendance:input_type -> rasp.v1.UpdateAttendanceRequest
+	8,  // 14: rasp.v1.AttendanceService.PatchAttendance:input_type -> rasp.v1.PatchAttendanceRequest
+	9,  // 15: rasp.v1.AttendanceService.DeleteAttendance:input_type -> rasp.v1.DeleteAttendanceRequest
+	10, // 16: rasp.v1.AttendanceService.BulkAttendance:input_type -> rasp.v1.BulkAttendanceRequest
+	3,  // 17: rasp.v1.AttendanceService.CreateAttendance:output_type -> rasp.v1.CreateAttendanceResponse
+	0,  // 18: rasp.v1.AttendanceService.GetAttendance:output_type -> rasp.v1.Attendance
+	6,  // 19: rasp.v1.AttendanceService.ListAttendance:output_type -> rasp.v1.ListAttendanceResponse
+	0,  // 20: rasp.v1.AttendanceService.UpdateAttendance:output_type -> rasp.v1.Attendance
+	0,  // 21: rasp.v1.AttendanceService.PatchAttendance:output_type -> rasp.v1.Attendance
+	15, // 22: rasp.v1.AttendanceService.DeleteAttendance:output_type -> google.protobuf.Empty
+	13, // 23: rasp.v1.AttendanceService.BulkAttendance:output_type -> rasp.v1.BulkAttendanceResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_rasp_v1_attendance_proto_init() }
+func file_rasp_v1_attendance_proto_init() {
+	if File_rasp_v1_attendance_proto != nil {
+		return
+	}
+	file_rasp_v1_attendance_proto_msgTypes[5].OneofWrappers = []any{}
+	file_rasp_v1_attendance_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rasp_v1_attendance_proto_rawDesc), len(file_rasp_v1_attendance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rasp_v1_attendance_proto_goTypes,
+		DependencyIndexes: file_rasp_v1_attendance_proto_depIdxs,
+		MessageInfos:      file_rasp_v1_attendance_proto_msgTypes,
+	}.Build()
+	File_rasp_v1_attendance_proto = out.File
+	file_rasp_v1_attendance_proto_goTypes = nil
+	file_rasp_v1_attendance_proto_depIdxs = nil
+}
@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rasp/v1/attendance.proto
+
+package raspv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttendanceService_CreateAttendance_FullMethodName = "/rasp.v1.AttendanceService/CreateAttendance"
+	AttendanceService_GetAttendance_FullMethodName    = "/rasp.v1.AttendanceService/GetAttendance"
+	AttendanceService_ListAttendance_FullMethodName   = "/rasp.v1.AttendanceService/ListAttendance"
+	AttendanceService_UpdateAttendance_FullMethodName = "/rasp.v1.AttendanceService/UpdateAttendance"
+	AttendanceService_PatchAttendance_FullMethodName  = "/rasp.v1.AttendanceService/PatchAttendance"
+	AttendanceService_DeleteAttendance_FullMethodName = "/rasp.v1.AttendanceService/DeleteAttendance"
+	AttendanceService_BulkAttendance_FullMethodName   = "/rasp.v1.AttendanceService/BulkAttendance"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AttendanceService — посещаемость (REST: /attendance)
+type AttendanceServiceClient interface {
+	CreateAttendance(ctx context.Context, in *CreateAttendanceRequest, opts ...grpc.CallOption) (*CreateAttendanceResponse, error)
+	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*Attendance, error)
+	ListAttendance(ctx context.Context, in *ListAttendanceRequest, opts ...grpc.CallOption) (*ListAttendanceResponse, error)
+	UpdateAttendance(ctx context.Context, in *UpdateAttendanceRequest, opts ...grpc.CallOption) (*Attendance, error)
+	PatchAttendance(ctx context.Context, in *PatchAttendanceRequest, opts ...grpc.CallOption) (*Attendance, error)
+	DeleteAttendance(ctx context.Context, in *DeleteAttendanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkAttendance(ctx context.Context, in *BulkAttendanceRequest, opts ...grpc.CallOption) (*BulkAttendanceResponse, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) CreateAttendance(ctx context.Context, in *CreateAttendanceRequest, opts ...grpc.CallOption) (*CreateAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_CreateAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*Attendance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attendance)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) ListAttendance(ctx context.Context, in *ListAttendanceRequest, opts ...grpc.CallOption) (*ListAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_ListAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) UpdateAttendance(ctx context.Context, in *UpdateAttendanceRequest, opts ...grpc.CallOption) (*Attendance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attendance)
+	err := c.cc.Invoke(ctx, AttendanceService_UpdateAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) PatchAttendance(ctx context.Context, in *PatchAttendanceRequest, opts ...grpc.CallOption) (*Attendance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attendance)
+	err := c.cc.Invoke(ctx, AttendanceService_PatchAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) DeleteAttendance(ctx context.Context, in *DeleteAttendanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttendanceService_DeleteAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) BulkAttendance(ctx context.Context, in *BulkAttendanceRequest, opts ...grpc.CallOption) (*BulkAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_BulkAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// AttendanceService — посещаемость (REST: /attendance)
+type AttendanceServiceServer interface {
+	CreateAttendance(context.Context, *CreateAttendanceRequest) (*CreateAttendanceResponse, error)
+	GetAttendance(context.Context, *GetAttendanceRequest) (*Attendance, error)
+	ListAttendance(context.Context, *ListAttendanceRequest) (*ListAttendanceResponse, error)
+	UpdateAttendance(context.Context, *UpdateAttendanceRequest) (*Attendance, error)
+	PatchAttendance(context.Context, *PatchAttendanceRequest) (*Attendance, error)
+	DeleteAttendance(context.Context, *DeleteAttendanceRequest) (*emptypb.Empty, error)
+	BulkAttendance(context.Context, *BulkAttendanceRequest) (*BulkAttendanceResponse, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) CreateAttendance(context.Context, *CreateAttendanceRequest) (*CreateAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*Attendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) ListAttendance(context.Context, *ListAttendanceRequest) (*ListAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) UpdateAttendance(context.Context, *UpdateAttendanceRequest) (*Attendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) PatchAttendance(context.Context, *PatchAttendanceRequest) (*Attendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) DeleteAttendance(context.Context, *DeleteAttendanceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) BulkAttendance(context.Context, *BulkAttendanceRequest) (*BulkAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_CreateAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CreateAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CreateAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CreateAttendance(ctx, req.(*CreateAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_ListAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).ListAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_ListAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).ListAttendance(ctx, req.(*ListAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_UpdateAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).UpdateAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_UpdateAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).UpdateAttendance(ctx, req.(*UpdateAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_PatchAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).PatchAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_PatchAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).PatchAttendance(ctx, req.(*PatchAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_DeleteAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).DeleteAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_DeleteAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).DeleteAttendance(ctx, req.(*DeleteAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_BulkAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).BulkAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_BulkAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).BulkAttendance(ctx, req.(*BulkAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rasp.v1.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAttendance",
+			Handler:    _AttendanceService_CreateAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendance",
+			Handler:    _AttendanceService_GetAttendance_Handler,
+		},
+		{
+			MethodName: "ListAttendance",
+			Handler:    _AttendanceService_ListAttendance_Handler,
+		},
+		{
+			MethodName: "UpdateAttendance",
+			Handler:    _AttendanceService_UpdateAttendance_Handler,
+		},
+		{
+			MethodName: "PatchAttendance",
+			Handler:    _AttendanceService_PatchAttendance_Handler,
+		},
+		{
+			MethodName: "DeleteAttendance",
+			Handler:    _AttendanceService_DeleteAttendance_Handler,
+		},
+		{
+			MethodName: "BulkAttendance",
+			Handler:    _AttendanceService_BulkAttendance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rasp/v1/attendance.proto",
+}
@@ -0,0 +1,1264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rasp/v1/bookings.proto
+
+package raspv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Booking struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Id                          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlotId                      string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	StudentId                   string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	TeacherId                   string                 `protobuf:"bytes,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Status                      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	RequiresTeacherConfirmation bool                   `protobuf:"varint,6,opt,name=requires_teacher_confirmation,json=requiresTeacherConfirmation,proto3" json:"requires_teacher_confirmation,omitempty"`
+	DeletedAt                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *Booking) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Booking) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetRequiresTeacherConfirmation() bool {
+	if x != nil {
+		return x.RequiresTeacherConfirmation
+	}
+	return false
+}
+
+func (x *Booking) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CreditId      *string                `protobuf:"bytes,3,opt,name=credit_id,json=creditId,proto3,oneof" json:"credit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBookingRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetCreditId() string {
+	if x != nil && x.CreditId != nil {
+		return *x.CreditId
+	}
+	return ""
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBookingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      *string                `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3,oneof" json:"student_id,omitempty"`
+	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
+	From           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Status         *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBookingsRequest) GetStudentId() string {
+	if x != nil && x.StudentId != nil {
+		return *x.StudentId
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ConfirmBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingRequest) Reset() {
+	*x = ConfirmBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingRequest) ProtoMessage() {}
+
+func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConfirmBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RescheduleBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	NewSlotId     string                 `protobuf:"bytes,2,opt,name=new_slot_id,json=newSlotId,proto3" json:"new_slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleBookingRequest) Reset() {
+	*x = RescheduleBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleBookingRequest) ProtoMessage() {}
+
+func (x *RescheduleBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleBookingRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RescheduleBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *RescheduleBookingRequest) GetNewSlotId() string {
+	if x != nil {
+		return x.NewSlotId
+	}
+	return ""
+}
+
+type DeleteBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookingRequest) Reset() {
+	*x = DeleteBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookingRequest) ProtoMessage() {}
+
+func (x *DeleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBookingRequest) Reset() {
+	*x = RestoreBookingRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBookingRequest) ProtoMessage() {}
+
+func (x *RestoreBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBookingRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBookingRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBookingHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BookingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	FromStatus    *string                `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3,oneof" json:"from_status,omitempty"`
+	ToStatus      *string                `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3,oneof" json:"to_status,omitempty"`
+	FromSlotId    *string                `protobuf:"bytes,6,opt,name=from_slot_id,json=fromSlotId,proto3,oneof" json:"from_slot_id,omitempty"`
+	ToSlotId      *string                `protobuf:"bytes,7,opt,name=to_slot_id,json=toSlotId,proto3,oneof" json:"to_slot_id,omitempty"`
+	Actor         string                 `protobuf:"bytes,8,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId     string                 `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingEvent) Reset() {
+	*x = BookingEvent{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingEvent) ProtoMessage() {}
+
+func (x *BookingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingEvent.ProtoReflect.Descriptor instead.
+func (*BookingEvent) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookingEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetFromStatus() string {
+	if x != nil && x.FromStatus != nil {
+		return *x.FromStatus
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetToStatus() string {
+	if x != nil && x.ToStatus != nil {
+		return *x.ToStatus
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetFromSlotId() string {
+	if x != nil && x.FromSlotId != nil {
+		return *x.FromSlotId
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetToSlotId() string {
+	if x != nil && x.ToSlotId != nil {
+		return *x.ToSlotId
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*BookingEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetBookingHistoryResponse) GetEvents() []*BookingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListBookingRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingRemindersRequest) Reset() {
+	*x = ListBookingRemindersRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingRemindersRequest) ProtoMessage() {}
+
+func (x *ListBookingRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListBookingRemindersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	RecipientRole string                 `protobuf:"bytes,3,opt,name=recipient_role,json=recipientRole,proto3" json:"recipient_role,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,4,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	OffsetMinutes int32                  `protobuf:"varint,5,opt,name=offset_minutes,json=offsetMinutes,proto3" json:"offset_minutes,omitempty"`
+	LessonStart   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lesson_start,json=lessonStart,proto3" json:"lesson_start,omitempty"`
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     *string                `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Reminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reminder) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Reminder) GetRecipientRole() string {
+	if x != nil {
+		return x.RecipientRole
+	}
+	return ""
+}
+
+func (x *Reminder) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *Reminder) GetOffsetMinutes() int32 {
+	if x != nil {
+		return x.OffsetMinutes
+	}
+	return 0
+}
+
+func (x *Reminder) GetLessonStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LessonStart
+	}
+	return nil
+}
+
+func (x *Reminder) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reminder) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Reminder) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *Reminder) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+type ListBookingRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingRemindersResponse) Reset() {
+	*x = ListBookingRemindersResponse{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingRemindersResponse) ProtoMessage() {}
+
+func (x *ListBookingRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListBookingRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_rasp_v1_bookings_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_bookings_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_bookings_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CheckInRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+var File_rasp_v1_bookings_proto protoreflect.FileDescriptor
+
+const file_rasp_v1_bookings_proto_rawDesc = "" +
+	"\n" +
+	"\x16rasp/v1/bookings.proto\x12\arasp.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18rasp/v1/attendance.proto\"\x87\x02\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aslot_id\x18\x02 \x01(\tR\x06slotId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\tR\tteacherId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12B\n" +
+	"\x1drequires_teacher_confirmation\x18\x06 \x01(\bR\x1brequiresTeacherConfirmation\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"~\n" +
+	"\x14CreateBookingRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\tcredit_id\x18\x03 \x01(\tH\x00R\bcreditId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_credit_id\"#\n" +
+	"\x11GetBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa8\x02\n" +
+	"\x13ListBookingsRequest\x12\"\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tH\x00R\tstudentId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tH\x01R\tteacherId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x02R\x06status\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeletedB\r\n" +
+	"\v_student_idB\r\n" +
+	"\v_teacher_idB\t\n" +
+	"\a_status\"D\n" +
+	"\x14ListBookingsResponse\x12,\n" +
+	"\bbookings\x18\x01 \x03(\v2\x10.rasp.v1.BookingR\bbookings\"&\n" +
+	"\x14CancelBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15ConfirmBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x18RescheduleBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x1e\n" +
+	"\vnew_slot_id\x18\x02 \x01(\tR\tnewSlotId\"&\n" +
+	"\x14DeleteBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15RestoreBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18GetBookingHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x91\x03\n" +
+	"\fBookingEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12$\n" +
+	"\vfrom_status\x18\x04 \x01(\tH\x00R\n" +
+	"fromStatus\x88\x01\x01\x12 \n" +
+	"\tto_status\x18\x05 \x01(\tH\x01R\btoStatus\x88\x01\x01\x12%\n" +
+	"\ffrom_slot_id\x18\x06 \x01(\tH\x02R\n" +
+	"fromSlotId\x88\x01\x01\x12!\n" +
+	"\n" +
+	"to_slot_id\x18\a \x01(\tH\x03R\btoSlotId\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\b \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tR\trequestId\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_from_statusB\f\n" +
+	"\n" +
+	"_to_statusB\x0f\n" +
+	"\r_from_slot_idB\r\n" +
+	"\v_to_slot_id\"J\n" +
+	"\x19GetBookingHistoryResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.rasp.v1.BookingEventR\x06events\"-\n" +
+	"\x1bListBookingRemindersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xba\x03\n" +
+	"\bReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12%\n" +
+	"\x0erecipient_role\x18\x03 \x01(\tR\rrecipientRole\x12!\n" +
+	"\frecipient_id\x18\x04 \x01(\tR\vrecipientId\x12%\n" +
+	"\x0eoffset_minutes\x18\x05 \x01(\x05R\roffsetMinutes\x12=\n" +
+	"\flesson_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vlessonStart\x123\n" +
+	"\asend_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12\"\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tH\x00R\tlastError\x88\x01\x01\x123\n" +
+	"\asent_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAtB\r\n" +
+	"\v_last_error\"O\n" +
+	"\x1cListBookingRemindersResponse\x12/\n" +
+	"\treminders\x18\x01 \x03(\v2\x11.rasp.v1.ReminderR\treminders\"C\n" +
+	"\x0eCheckInRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code2\xab\x06\n" +
+	"\bBookings\x12@\n" +
+	"\rCreateBooking\x12\x1d.rasp.v1.CreateBookingRequest\x1a\x10.rasp.v1.Booking\x12:\n" +
+	"\n" +
+	"GetBooking\x12\x1a.rasp.v1.GetBookingRequest\x1a\x10.rasp.v1.Booking\x12K\n" +
+	"\fListBookings\x12\x1c.rasp.v1.ListBookingsRequest\x1a\x1d.rasp.v1.ListBookingsResponse\x12@\n" +
+	"\rCancelBooking\x12\x1d.rasp.v1.CancelBookingRequest\x1a\x10.rasp.v1.Booking\x12B\n" +
+	"\x0eConfirmBooking\x12\x1e.rasp.v1.ConfirmBookingRequest\x1a\x10.rasp.v1.Booking\x12H\n" +
+	"\x11RescheduleBooking\x12!.rasp.v1.RescheduleBookingRequest\x1a\x10.rasp.v1.Booking\x12F\n" +
+	"\rDeleteBooking\x12\x1d.rasp.v1.DeleteBookingRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x0eRestoreBooking\x12\x1e.rasp.v1.RestoreBookingRequest\x1a\x10.rasp.v1.Booking\x12Z\n" +
+	"\x11GetBookingHistory\x12!.rasp.v1.GetBookingHistoryRequest\x1a\".rasp.v1.GetBookingHistoryResponse\x12c\n" +
+	"\x14ListBookingReminders\x12$.rasp.v1.ListBookingRemindersRequest\x1a%.rasp.v1.ListBookingRemindersResponse\x127\n" +
+	"\aCheckIn\x12\x17.rasp.v1.CheckInRequest\x1a\x13.rasp.v1.AttendanceB'Z%rasp-service/api/proto/rasp/v1;raspv1b\x06proto3"
+
+var (
+	file_rasp_v1_bookings_proto_rawDescOnce sync.Once
+	file_rasp_v1_bookings_proto_rawDescData []byte
+)
+
+func file_rasp_v1_bookings_proto_rawDescGZIP() []byte {
+	file_rasp_v1_bookings_proto_rawDescOnce.Do(func() {
+		file_rasp_v1_bookings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rasp_v1_bookings_proto_rawDesc), len(file_rasp_v1_bookings_proto_rawDesc)))
+	})
+	return file_rasp_v1_bookings_proto_rawDescData
+}
+
+var file_rasp_v1_bookings_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_rasp_v1_bookings_proto_goTypes = []any{
+	(*Booking)(nil),                      // 0: rasp.v1.Booking
+	(*CreateBookingRequest)(nil),         // 1: rasp.v1.CreateBookingRequest
+	(*GetBookingRequest)(nil),            // 2: rasp.v1.GetBookingRequest
+	(*ListBookingsRequest)(nil),          // 3: rasp.v1.ListBookingsRequest
+	(*ListBookingsResponse)(nil),         // 4: rasp.v1.ListBookingsResponse
+	(*CancelBookingRequest)(nil),         // 5: rasp.v1.CancelBookingRequest
+	(*ConfirmBookingRequest)(nil),        // 6: rasp.v1.ConfirmBookingRequest
+	(*RescheduleBookingRequest)(nil),     // 7: rasp.v1.RescheduleBookingRequest
+	(*DeleteBookingRequest)(nil),         // 8: rasp.v1.DeleteBookingRequest
+	(*RestoreBookingRequest)(nil),        // 9: rasp.v1.RestoreBookingRequest
+	(*GetBookingHistoryRequest)(nil),     // 10: rasp.v1.GetBookingHistoryRequest
+	(*BookingEvent)(nil),                 // 11: rasp.v1.BookingEvent
+	(*GetBookingHistoryResponse)(nil),    // 12: rasp.v1.GetBookingHistoryResponse
+	(*ListBookingRemindersRequest)(nil),  // 13: rasp.v1.ListBookingRemindersRequest
+	(*Reminder)(nil),                     // 14: rasp.v1.Reminder
+	(*ListBookingRemindersResponse)(nil), // 15: rasp.v1.ListBookingRemindersResponse
+	(*CheckInRequest)(nil),               // 16: rasp.v1.CheckInRequest
+	(*timestamppb.Timestamp)(nil),        // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 18: google.protobuf.Empty
+	(*Attendance)(nil),                   // 19: rasp.v1.Attendance
+}
+var file_rasp_v1_bookings_proto_depIdxs = []int32{
+	17, // 0: rasp.v1.Booking.deleted_at:type_name -> google.protobuf.Timestamp
+	17, // 1: rasp.v1.ListBookingsRequest.from:type_name -> google.protobuf.Timestamp
+	17, // 2: rasp.v1.ListBookingsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 3: rasp.v1.ListBookingsResponse.bookings:type_name -> rasp.v1.Booking
+	17, // 4: rasp.v1.BookingEvent.created_at:type_name -> google.protobuf.Timestamp
+	11, // 5: rasp.v1.GetBookingHistoryResponse.events:type_name -> rasp.v1.BookingEvent
+	17, // 6: rasp.v1.Reminder.lesson_start:type_name -> google.protobuf.Timestamp
+	17, // 7: rasp.v1.Reminder.send_at:type_name -> google.protobuf.Timestamp
+	17, // 8: rasp.v1.Reminder.sent_at:type_name -> google.protobuf.Timestamp
+	14, // 9: rasp.v1.ListBookingRemindersResponse.reminders:type_name -> rasp.v1.Reminder
+	1,  // 10: rasp.v1.Bookings.CreateBooking:input_type -> rasp.v1.CreateBookingRequest
+	2,  // 11: rasp.v1.Bookings.GetBooking:input_type -> rasp.v1.GetBookingRequest
+	3,  // 12: rasp.v1.Bookings.ListBookings:input_type -> rasp.v1.ListBookingsRequest
+	5,  // 13: rasp.v1.Bookings.CancelBooking:input_type -> rasp.v1.CancelBookingRequest
+	6,  // 14: rasp.v1.Bookings.ConfirmBooking:input_type -> rasp.v1.ConfirmBookingRequest
+	7,  // 15: rasp.v1.Bookings.RescheduleBooking:input_type -> rasp.v1.RescheduleBookingRequest
+	8,  // 16: rasp.v1.Bookings.DeleteBooking:input_type -> rasp.v1.DeleteBookingRequest
+	9,  // 17: rasp.v1.Bookings.RestoreBooking:input_type -> rasp.v1.RestoreBookingRequest
+	10, // 18: rasp.v1.Bookings.GetBookingHistory:input_type -> rasp.v1.GetBookingHistoryRequest
+	13, // 19: rasp.v1.Bookings.ListBookingReminders:input_type -> rasp.v1.ListBookingRemindersRequest
+	16, // 20: rasp.v1.Bookings.CheckIn:input_type -> rasp.v1.CheckInRequest
+	0,  // 21: rasp.v1.Bookings.CreateBooking:output_type -> rasp.v1.Booking
+	0,  // 22: rasp.v1.Bookings.GetBooking:output_type -> rasp.v1.Booking
+	4,  // 23: rasp.v1.Bookings.ListBookings:output_type -> rasp.v1.ListBookingsResponse
+	0,  // 24: rasp.v1.Bookings.CancelBooking:output_type -> rasp.v1.Booking
+	0,  // 25: rasp.v1.Bookings.ConfirmBooking:output_type -> rasp.v1.Booking
+	0,  // 26: rasp.v1.Bookings.RescheduleBooking:output_type -> rasp.v1.Booking
+	18, // 27: rasp.v1.Bookings.DeleteBooking:output_type -> google.protobuf.Empty
+	0,  // 28: rasp.v1.Bookings.RestoreBooking:output_type -> rasp.v1.Booking
+	12, // 29: rasp.v1.Bookings.GetBookingHistory:output_type -> rasp.v1.GetBookingHistoryResponse
+	15, // 30: rasp.v1.Bookings.ListBookingReminders:output_type -> rasp.v1.ListBookingRemindersResponse
+	19, // 31: rasp.v1.Bookings.CheckIn:output_type -> rasp.v1.Attendance
+	21, // [21:32] is the sub-list for method output_type
+	10, // [10:21] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_rasp_v1_bookings_proto_init() }
+func file_rasp_v1_bookings_proto_init() {
+	if File_rasp_v1_bookings_proto != nil {
+		return
+	}
+	file_rasp_v1_attendance_proto_init()
+	file_rasp_v1_bookings_proto_msgTypes[1].OneofWrappers = []any{}
+	file_rasp_v1_bookings_proto_msgTypes[3].OneofWrappers = []any{}
+	file_rasp_v1_bookings_proto_msgTypes[11].OneofWrappers = []any{}
+	file_rasp_v1_bookings_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rasp_v1_bookings_proto_rawDesc), len(file_rasp_v1_bookings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rasp_v1_bookings_proto_goTypes,
+		DependencyIndexes: file_rasp_v1_bookings_proto_depIdxs,
+		MessageInfos:      file_rasp_v1_bookings_proto_msgTypes,
+	}.Build()
+	File_rasp_v1_bookings_proto = out.File
+	file_rasp_v1_bookings_proto_goTypes = nil
+	file_rasp_v1_bookings_proto_depIdxs = nil
+}
@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rasp/v1/bookings.proto
+
+package raspv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Bookings_CreateBooking_FullMethodName        = "/rasp.v1.Bookings/CreateBooking"
+	Bookings_GetBooking_FullMethodName           = "/rasp.v1.Bookings/GetBooking"
+	Bookings_ListBookings_FullMethodName         = "/rasp.v1.Bookings/ListBookings"
+	Bookings_CancelBooking_FullMethodName        = "/rasp.v1.Bookings/CancelBooking"
+	Bookings_ConfirmBooking_FullMethodName       = "/rasp.v1.Bookings/ConfirmBooking"
+	Bookings_RescheduleBooking_FullMethodName    = "/rasp.v1.Bookings/RescheduleBooking"
+	Bookings_DeleteBooking_FullMethodName        = "/rasp.v1.Bookings/DeleteBooking"
+	Bookings_RestoreBooking_FullMethodName       = "/rasp.v1.Bookings/RestoreBooking"
+	Bookings_GetBookingHistory_FullMethodName    = "/rasp.v1.Bookings/GetBookingHistory"
+	Bookings_ListBookingReminders_FullMethodName = "/rasp.v1.Bookings/ListBookingReminders"
+	Bookings_CheckIn_FullMethodName              = "/rasp.v1.Bookings/CheckIn"
+)
+
+// BookingsClient is the client API for Bookings service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Bookings — бронирования слотов (REST: /bookings)
+type BookingsClient interface {
+	// CreateBooking; ключ идемпотентности передаётся в метаданных idempotency-key
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreBooking(ctx context.Context, in *RestoreBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error)
+	ListBookingReminders(ctx context.Context, in *ListBookingRemindersRequest, opts ...grpc.CallOption) (*ListBookingRemindersResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Attendance, error)
+}
+
+type bookingsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingsClient(cc grpc.ClientConnInterface) BookingsClient {
+	return &bookingsClient{cc}
+}
+
+func (c *bookingsClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Bookings_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Bookings_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, Bookings_ListBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Bookings_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Bookings_ConfirmBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Bookings_RescheduleBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bookings_DeleteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) RestoreBooking(ctx context.Context, in *RestoreBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Bookings_RestoreBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingHistoryResponse)
+	err := c.cc.Invoke(ctx, Bookings_GetBookingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) ListBookingReminders(ctx context.Context, in *ListBookingRemindersRequest, opts ...grpc.CallOption) (*ListBookingRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingRemindersResponse)
+	err := c.cc.Invoke(ctx, Bookings_ListBookingReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Attendance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attendance)
+	err := c.cc.Invoke(ctx, Bookings_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingsServer is the server API for Bookings service.
+// All implementations must embed UnimplementedBookingsServer
+// for forward compatibility.
+//
+// Bookings — бронирования слотов (REST: /bookings)
+type BookingsServer interface {
+	// CreateBooking; ключ идемпотентности передаётся в метаданных idempotency-key
+	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
+	GetBooking(context.Context, *GetBookingRequest) (*Booking, error)
+	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	CancelBooking(context.Context, *CancelBookingRequest) (*Booking, error)
+	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*Booking, error)
+	RescheduleBooking(context.Context, *RescheduleBookingRequest) (*Booking, error)
+	DeleteBooking(context.Context, *DeleteBookingRequest) (*emptypb.Empty, error)
+	RestoreBooking(context.Context, *RestoreBookingRequest) (*Booking, error)
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error)
+	ListBookingReminders(context.Context, *ListBookingRemindersRequest) (*ListBookingRemindersResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*Attendance, error)
+	mustEmbedUnimplementedBookingsServer()
+}
+
+// UnimplementedBookingsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingsServer struct{}
+
+func (UnimplementedBookingsServer) CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedBookingsServer) GetBooking(context.Context, *GetBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedBookingsServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedBookingsServer) CancelBooking(context.Context, *CancelBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingsServer) ConfirmBooking(context.Context, *ConfirmBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBooking not implemented")
+}
+func (UnimplementedBookingsServer) RescheduleBooking(context.Context, *RescheduleBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleBooking not implemented")
+}
+func (UnimplementedBookingsServer) DeleteBooking(context.Context, *DeleteBookingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBooking not implemented")
+}
+func (UnimplementedBookingsServer) RestoreBooking(context.Context, *RestoreBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBooking not implemented")
+}
+func (UnimplementedBookingsServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (UnimplementedBookingsServer) ListBookingReminders(context.Context, *ListBookingRemindersRequest) (*ListBookingRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookingReminders not implemented")
+}
+func (UnimplementedBookingsServer) CheckIn(context.Context, *CheckInRequest) (*Attendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedBookingsServer) mustEmbedUnimplementedBookingsServer() {}
+func (UnimplementedBookingsServer) testEmbeddedByValue()                  {}
+
+// UnsafeBookingsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingsServer will
+// result in compilation errors.
+type UnsafeBookingsServer interface {
+	mustEmbedUnimplementedBookingsServer()
+}
+
+func RegisterBookingsServer(s grpc.ServiceRegistrar, srv BookingsServer) {
+	// If the following call pancis, it indicates UnimplementedBookingsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Bookings_ServiceDesc, srv)
+}
+
+func _Bookings_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_ListBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).ListBookings(ctx, req.(*ListBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_ConfirmBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).ConfirmBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_ConfirmBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).ConfirmBooking(ctx, req.(*ConfirmBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_RescheduleBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).RescheduleBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_RescheduleBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).RescheduleBooking(ctx, req.(*RescheduleBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_DeleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).DeleteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_DeleteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).DeleteBooking(ctx, req.(*DeleteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_RestoreBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).RestoreBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_RestoreBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).RestoreBooking(ctx, req.(*RestoreBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_GetBookingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_ListBookingReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).ListBookingReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_ListBookingReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).ListBookingReminders(ctx, req.(*ListBookingRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookings_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bookings_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bookings_ServiceDesc is the grpc.ServiceDesc for Bookings service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bookings_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rasp.v1.Bookings",
+	HandlerType: (*BookingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBooking",
+			Handler:    _Bookings_CreateBooking_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _Bookings_GetBooking_Handler,
+		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _Bookings_ListBookings_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _Bookings_CancelBooking_Handler,
+		},
+		{
+			MethodName: "ConfirmBooking",
+			Handler:    _Bookings_ConfirmBooking_Handler,
+		},
+		{
+			MethodName: "RescheduleBooking",
+			Handler:    _Bookings_RescheduleBooking_Handler,
+		},
+		{
+			MethodName: "DeleteBooking",
+			Handler:    _Bookings_DeleteBooking_Handler,
+		},
+		{
+			MethodName: "RestoreBooking",
+			Handler:    _Bookings_RestoreBooking_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _Bookings_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "ListBookingReminders",
+			Handler:    _Bookings_ListBookingReminders_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _Bookings_CheckIn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rasp/v1/bookings.proto",
+}
@@ -0,0 +1,785 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rasp/v1/slots.proto
+
+package raspv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Slot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	BookingId     *string                `protobuf:"bytes,6,opt,name=booking_id,json=bookingId,proto3,oneof" json:"booking_id,omitempty"`
+	TemplateId    *string                `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Slot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Slot) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Slot) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Slot) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Slot) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Slot) GetBookingId() string {
+	if x != nil && x.BookingId != nil {
+		return *x.BookingId
+	}
+	return ""
+}
+
+func (x *Slot) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+type GetSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlotRequest) Reset() {
+	*x = GetSlotRequest{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlotRequest) ProtoMessage() {}
+
+func (x *GetSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetSlotRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSlotsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId *string                `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
+	From      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Duration  *int32                 `protobuf:"varint,4,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	Status    *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Q         *string                `protobuf:"bytes,6,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	// По умолчанию 1
+	Page int32 `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	// По умолчанию 20, не больше 100
+	PerPage int32 `protobuf:"varint,8,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// По умолчанию starts_at
+	Sort          string `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlotsRequest) Reset() {
+	*x = ListSlotsRequest{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlotsRequest) ProtoMessage() {}
+
+func (x *ListSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSlotsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListSlotsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListSlotsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListSlotsRequest) GetDuration() int32 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *ListSlotsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListSlotsRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ListSlotsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSlotsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListSlotsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*Slot                `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlotsResponse) Reset() {
+	*x = ListSlotsResponse{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlotsResponse) ProtoMessage() {}
+
+func (x *ListSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSlotsResponse) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type BatchGetSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSlotsRequest) Reset() {
+	*x = BatchGetSlotsRequest{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSlotsRequest) ProtoMessage() {}
+
+func (x *BatchGetSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSlotsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetSlotsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GenerateSlotsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId *string                `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	TeacherId  *string                `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
+	// YYYY-MM-DD
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// YYYY-MM-DD
+	To            string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSlotsRequest) Reset() {
+	*x = GenerateSlotsRequest{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSlotsRequest) ProtoMessage() {}
+
+func (x *GenerateSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSlotsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GenerateSlotsRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *GenerateSlotsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *GenerateSlotsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GenerateSlotsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GenerateSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSlotsResponse) Reset() {
+	*x = GenerateSlotsResponse{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSlotsResponse) ProtoMessage() {}
+
+func (x *GenerateSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSlotsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GenerateSlotsResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type SuggestAlternativeSlotsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SlotId string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// По умолчанию 3
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAlternativeSlotsRequest) Reset() {
+	*x = SuggestAlternativeSlotsRequest{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAlternativeSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativeSlotsRequest) ProtoMessage() {}
+
+func (x *SuggestAlternativeSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativeSlotsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativeSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SuggestAlternativeSlotsRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *SuggestAlternativeSlotsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCheckInCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckInCodeRequest) Reset() {
+	*x = GetCheckInCodeRequest{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckInCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckInCodeRequest) ProtoMessage() {}
+
+func (x *GetCheckInCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckInCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckInCodeRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCheckInCodeRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+type CheckInCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	StepSeconds   int32                  `protobuf:"varint,4,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInCode) Reset() {
+	*x = CheckInCode{}
+	mi := &file_rasp_v1_slots_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInCode) ProtoMessage() {}
+
+func (x *CheckInCode) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_slots_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInCode.ProtoReflect.Descriptor instead.
+func (*CheckInCode) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_slots_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckInCode) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CheckInCode) GetStepSeconds() int32 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+var File_rasp_v1_slots_proto protoreflect.FileDescriptor
+
+const file_rasp_v1_slots_proto_rawDesc = "" +
+	"\n" +
+	"\x13rasp/v1/slots.proto\x12\arasp.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x02\n" +
+	"\x04Slot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\tR\tteacherId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\"\n" +
+	"\n" +
+	"booking_id\x18\x06 \x01(\tH\x00R\tbookingId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\a \x01(\tH\x01R\n" +
+	"templateId\x88\x01\x01B\r\n" +
+	"\v_booking_idB\x0e\n" +
+	"\f_template_id\" \n" +
+	"\x0eGetSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd3\x02\n" +
+	"\x10ListSlotsRequest\x12\"\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tH\x00R\tteacherId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\x05H\x01R\bduration\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x11\n" +
+	"\x01q\x18\x06 \x01(\tH\x03R\x01q\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\a \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\b \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04sort\x18\t \x01(\tR\x04sortB\r\n" +
+	"\v_teacher_idB\v\n" +
+	"\t_durationB\t\n" +
+	"\a_statusB\x04\n" +
+	"\x02_q\"8\n" +
+	"\x11ListSlotsResponse\x12#\n" +
+	"\x05slots\x18\x01 \x03(\v2\r.rasp.v1.SlotR\x05slots\"(\n" +
+	"\x14BatchGetSlotsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xa3\x01\n" +
+	"\x14GenerateSlotsRequest\x12$\n" +
+	"\vtemplate_id\x18\x01 \x01(\tH\x00R\n" +
+	"templateId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tH\x01R\tteacherId\x88\x01\x01\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02toB\x0e\n" +
+	"\f_template_idB\r\n" +
+	"\v_teacher_id\".\n" +
+	"\x15GenerateSlotsResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"O\n" +
+	"\x1eSuggestAlternativeSlotsRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"0\n" +
+	"\x15GetCheckInCodeRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\"\x98\x01\n" +
+	"\vCheckInCode\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\fstep_seconds\x18\x04 \x01(\x05R\vstepSeconds2\xc2\x03\n" +
+	"\x05Slots\x121\n" +
+	"\aGetSlot\x12\x17.rasp.v1.GetSlotRequest\x1a\r.rasp.v1.Slot\x12B\n" +
+	"\tListSlots\x12\x19.rasp.v1.ListSlotsRequest\x1a\x1a.rasp.v1.ListSlotsResponse\x12J\n" +
+	"\rBatchGetSlots\x12\x1d.rasp.v1.BatchGetSlotsRequest\x1a\x1a.rasp.v1.ListSlotsResponse\x12N\n" +
+	"\rGenerateSlots\x12\x1d.rasp.v1.GenerateSlotsRequest\x1a\x1e.rasp.v1.GenerateSlotsResponse\x12^\n" +
+	"\x17SuggestAlternativeSlots\x12'.rasp.v1.SuggestAlternativeSlotsRequest\x1a\x1a.rasp.v1.ListSlotsResponse\x12F\n" +
+	"\x0eGetCheckInCode\x12\x1e.rasp.v1.GetCheckInCodeRequest\x1a\x14.rasp.v1.CheckInCodeB'Z%rasp-service/api/proto/rasp/v1;raspv1b\x06proto3"
+
+var (
+	file_rasp_v1_slots_proto_rawDescOnce sync.Once
+	file_rasp_v1_slots_proto_rawDescData []byte
+)
+
+func file_rasp_v1_slots_proto_rawDescGZIP() []byte {
+	file_rasp_v1_slots_proto_rawDescOnce.Do(func() {
+		file_rasp_v1_slots_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rasp_v1_slots_proto_rawDesc), len(file_rasp_v1_slots_proto_rawDesc)))
+	})
+	return file_rasp_v1_slots_proto_rawDescData
+}
+
+var file_rasp_v1_slots_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rasp_v1_slots_proto_goTypes = []any{
+	(*Slot)(nil),                           // 0: rasp.v1.Slot
+	(*GetSlotRequest)(nil),                 // 1: rasp.v1.GetSlotRequest
+	(*ListSlotsRequest)(nil),               // 2: rasp.v1.ListSlotsRequest
+	(*ListSlotsResponse)(nil),              // 3: rasp.v1.ListSlotsResponse
+	(*BatchGetSlotsRequest)(nil),           // 4: rasp.v1.BatchGetSlotsRequest
+	(*GenerateSlotsRequest)(nil),           // 5: rasp.v1.GenerateSlotsRequest
+	(*GenerateSlotsResponse)(nil),          // 6: rasp.v1.GenerateSlotsResponse
+	(*SuggestAlternativeSlotsRequest)(nil), // 7: rasp.v1.SuggestAlternativeSlotsRequest
+	(*GetCheckInCodeRequest)(nil),          // 8: rasp.v1.GetCheckInCodeRequest
+	(*CheckInCode)(nil),                    // 9: rasp.v1.CheckInCode
+	(*timestamppb.Timestamp)(nil),          // 10: google.protobuf.Timestamp
+}
+var file_rasp_v1_slots_proto_depIdxs = []int32{
+	10, // 0: rasp.v1.Slot.start:type_name -> google.protobuf.Timestamp
+	10, // 1: rasp.v1.Slot.end:type_name -> google.protobuf.Timestamp
+	10, // 2: rasp.v1.ListSlotsRequest.from:type_name -> google.protobuf.Timestamp
+	10, // 3: rasp.v1.ListSlotsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 4: rasp.v1.ListSlotsResponse.slots:type_name -> rasp.v1.Slot
+	10, // 5: rasp.v1.CheckInCode.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: rasp.v1.Slots.GetSlot:input_type -> rasp.v1.GetSlotRequest
+	2,  // 7: rasp.v1.Slots.ListSlots:input_type -> rasp.v1.ListSlotsRequest
+	4,  // 8: rasp.v1.Slots.BatchGetSlots:input_type -> rasp.v1.BatchGetSlotsRequest
+	5,  // 9: rasp.v1.Slots.GenerateSlots:input_type -> rasp.v1.GenerateSlotsRequest
+	7,  // 10: rasp.v1.Slots.SuggestAlternativeSlots:input_type -> rasp.v1.SuggestAlternativeSlotsRequest
+	8,  // 11: rasp.v1.Slots.GetCheckInCode:input_type -> rasp.v1.GetCheckInCodeRequest
+	0,  // 12: rasp.v1.Slots.GetSlot:output_type -> rasp.v1.Slot
+	3,  // 13: rasp.v1.Slots.ListSlots:output_type -> rasp.v1.ListSlotsResponse
+	3,  // 14: rasp.v1.Slots.BatchGetSlots:output_type -> rasp.v1.ListSlotsResponse
+	6,  // 15: rasp.v1.Slots.GenerateSlots:output_type -> rasp.v1.GenerateSlotsResponse
+	3,  // 16: rasp.v1.Slots.SuggestAlternativeSlots:output_type -> rasp.v1.ListSlotsResponse
+	9,  // 17: rasp.v1.Slots.GetCheckInCode:output_type -> rasp.v1.CheckInCode
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rasp_v1_slots_proto_init() }
+func file_rasp_v1_slots_proto_init() {
+	if File_rasp_v1_slots_proto != nil {
+		return
+	}
+	file_rasp_v1_slots_proto_msgTypes[0].OneofWrappers = []any{}
+	file_rasp_v1_slots_proto_msgTypes[2].OneofWrappers = []any{}
+	file_rasp_v1_slots_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rasp_v1_slots_proto_rawDesc), len(file_rasp_v1_slots_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rasp_v1_slots_proto_goTypes,
+		DependencyIndexes: file_rasp_v1_slots_proto_depIdxs,
+		MessageInfos:      file_rasp_v1_slots_proto_msgTypes,
+	}.Build()
+	File_rasp_v1_slots_proto = out.File
+	file_rasp_v1_slots_proto_goTypes = nil
+	file_rasp_v1_slots_proto_depIdxs = nil
+}
@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rasp/v1/slots.proto
+
+package raspv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Slots_GetSlot_FullMethodName                 = "/rasp.v1.Slots/GetSlot"
+	Slots_ListSlots_FullMethodName               = "/rasp.v1.Slots/ListSlots"
+	Slots_BatchGetSlots_FullMethodName           = "/rasp.v1.Slots/BatchGetSlots"
+	Slots_GenerateSlots_FullMethodName           = "/rasp.v1.Slots/GenerateSlots"
+	Slots_SuggestAlternativeSlots_FullMethodName = "/rasp.v1.Slots/SuggestAlternativeSlots"
+	Slots_GetCheckInCode_FullMethodName          = "/rasp.v1.Slots/GetCheckInCode"
+)
+
+// SlotsClient is the client API for Slots service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Slots — слоты расписания (REST: /slots)
+type SlotsClient interface {
+	GetSlot(ctx context.Context, in *GetSlotRequest, opts ...grpc.CallOption) (*Slot, error)
+	ListSlots(ctx context.Context, in *ListSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error)
+	BatchGetSlots(ctx context.Context, in *BatchGetSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error)
+	GenerateSlots(ctx context.Context, in *GenerateSlotsRequest, opts ...grpc.CallOption) (*GenerateSlotsResponse, error)
+	SuggestAlternativeSlots(ctx context.Context, in *SuggestAlternativeSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error)
+	GetCheckInCode(ctx context.Context, in *GetCheckInCodeRequest, opts ...grpc.CallOption) (*CheckInCode, error)
+}
+
+type slotsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlotsClient(cc grpc.ClientConnInterface) SlotsClient {
+	return &slotsClient{cc}
+}
+
+func (c *slotsClient) GetSlot(ctx context.Context, in *GetSlotRequest, opts ...grpc.CallOption) (*Slot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Slot)
+	err := c.cc.Invoke(ctx, Slots_GetSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slotsClient) ListSlots(ctx context.Context, in *ListSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSlotsResponse)
+	err := c.cc.Invoke(ctx, Slots_ListSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slotsClient) BatchGetSlots(ctx context.Context, in *BatchGetSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSlotsResponse)
+	err := c.cc.Invoke(ctx, Slots_BatchGetSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slotsClient) GenerateSlots(ctx context.Context, in *GenerateSlotsRequest, opts ...grpc.CallOption) (*GenerateSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSlotsResponse)
+	err := c.cc.Invoke(ctx, Slots_GenerateSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slotsClient) SuggestAlternativeSlots(ctx context.Context, in *SuggestAlternativeSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSlotsResponse)
+	err := c.cc.Invoke(ctx, Slots_SuggestAlternativeSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slotsClient) GetCheckInCode(ctx context.Context, in *GetCheckInCodeRequest, opts ...grpc.CallOption) (*CheckInCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInCode)
+	err := c.cc.Invoke(ctx, Slots_GetCheckInCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlotsServer is the server API for Slots service.
+// All implementations must embed UnimplementedSlotsServer
+// for forward compatibility.
+//
+// Slots — слоты расписания (REST: /slots)
+type SlotsServer interface {
+	GetSlot(context.Context, *GetSlotRequest) (*Slot, error)
+	ListSlots(context.Context, *ListSlotsRequest) (*ListSlotsResponse, error)
+	BatchGetSlots(context.Context, *BatchGetSlotsRequest) (*ListSlotsResponse, error)
+	GenerateSlots(context.Context, *GenerateSlotsRequest) (*GenerateSlotsResponse, error)
+	SuggestAlternativeSlots(context.Context, *SuggestAlternativeSlotsRequest) (*ListSlotsResponse, error)
+	GetCheckInCode(context.Context, *GetCheckInCodeRequest) (*CheckInCode, error)
+	mustEmbedUnimplementedSlotsServer()
+}
+
+// UnimplementedSlotsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSlotsServer struct{}
+
+func (UnimplementedSlotsServer) GetSlot(context.Context, *GetSlotRequest) (*Slot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlot not implemented")
+}
+func (UnimplementedSlotsServer) ListSlots(context.Context, *ListSlotsRequest) (*ListSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlots not implemented")
+}
+func (UnimplementedSlotsServer) BatchGetSlots(context.Context, *BatchGetSlotsRequest) (*ListSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSlots not implemented")
+}
+func (UnimplementedSlotsServer) GenerateSlots(context.Context, *GenerateSlotsRequest) (*GenerateSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSlots not implemented")
+}
+func (UnimplementedSlotsServer) SuggestAlternativeSlots(context.Context, *SuggestAlternativeSlotsRequest) (*ListSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestAlternativeSlots not implemented")
+}
+func (UnimplementedSlotsServer) GetCheckInCode(context.Context, *GetCheckInCodeRequest) (*CheckInCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInCode not implemented")
+}
+func (UnimplementedSlotsServer) mustEmbedUnimplementedSlotsServer() {}
+func (UnimplementedSlotsServer) testEmbeddedByValue()               {}
+
+// UnsafeSlotsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SlotsServer will
+// result in compilation errors.
+type UnsafeSlotsServer interface {
+	mustEmbedUnimplementedSlotsServer()
+}
+
+func RegisterSlotsServer(s grpc.ServiceRegistrar, srv SlotsServer) {
+	// If the following call pancis, it indicates UnimplementedSlotsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Slots_ServiceDesc, srv)
+}
+
+func _Slots_GetSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotsServer).GetSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slots_GetSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotsServer).GetSlot(ctx, req.(*GetSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slots_ListSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotsServer).ListSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slots_ListSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotsServer).ListSlots(ctx, req.(*ListSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slots_BatchGetSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotsServer).BatchGetSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slots_BatchGetSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotsServer).BatchGetSlots(ctx, req.(*BatchGetSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slots_GenerateSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotsServer).GenerateSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slots_GenerateSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotsServer).GenerateSlots(ctx, req.(*GenerateSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slots_SuggestAlternativeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestAlternativeSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotsServer).SuggestAlternativeSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slots_SuggestAlternativeSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotsServer).SuggestAlternativeSlots(ctx, req.(*SuggestAlternativeSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slots_GetCheckInCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckInCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotsServer).GetCheckInCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slots_GetCheckInCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotsServer).GetCheckInCode(ctx, req.(*GetCheckInCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Slots_ServiceDesc is the grpc.ServiceDesc for Slots service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Slots_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rasp.v1.Slots",
+	HandlerType: (*SlotsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSlot",
+			Handler:    _Slots_GetSlot_Handler,
+		},
+		{
+			MethodName: "ListSlots",
+			Handler:    _Slots_ListSlots_Handler,
+		},
+		{
+			MethodName: "BatchGetSlots",
+			Handler:    _Slots_BatchGetSlots_Handler,
+		},
+		{
+			MethodName: "GenerateSlots",
+			Handler:    _Slots_GenerateSlots_Handler,
+		},
+		{
+			MethodName: "SuggestAlternativeSlots",
+			Handler:    _Slots_SuggestAlternativeSlots_Handler,
+		},
+		{
+			MethodName: "GetCheckInCode",
+			Handler:    _Slots_GetCheckInCode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rasp/v1/slots.proto",
+}
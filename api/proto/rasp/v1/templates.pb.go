@@ -0,0 +1,564 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rasp/v1/templates.proto
+
+package raspv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Дни недели: mon, tue, wed, thu, fri, sat, sun
+	Days []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// HH:MM
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// HH:MM
+	EndTime       string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recurrence) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *Recurrence) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Recurrence) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type AvailabilityTemplate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherId           string                 `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Recurrence          *Recurrence            `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	SlotDurationMinutes int32                  `protobuf:"varint,4,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	// YYYY-MM-DD
+	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// YYYY-MM-DD
+	EndDate       string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Enabled       bool   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityTemplate) Reset() {
+	*x = AvailabilityTemplate{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityTemplate) ProtoMessage() {}
+
+func (x *AvailabilityTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityTemplate.ProtoReflect.Descriptor instead.
+func (*AvailabilityTemplate) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AvailabilityTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplate) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplate) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *AvailabilityTemplate) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *AvailabilityTemplate) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplate) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplate) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type AvailabilityTemplateInput struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId           string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Recurrence          *Recurrence            `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	SlotDurationMinutes int32                  `protobuf:"varint,3,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	StartDate           string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate             string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Enabled             bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AvailabilityTemplateInput) Reset() {
+	*x = AvailabilityTemplateInput{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityTemplateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityTemplateInput) ProtoMessage() {}
+
+func (x *AvailabilityTemplateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityTemplateInput.ProtoReflect.Descriptor instead.
+func (*AvailabilityTemplateInput) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AvailabilityTemplateInput) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplateInput) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *AvailabilityTemplateInput) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *AvailabilityTemplateInput) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplateInput) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AvailabilityTemplateInput) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type CreateAvailabilityTemplateRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Template      *AvailabilityTemplateInput `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAvailabilityTemplateRequest) Reset() {
+	*x = CreateAvailabilityTemplateRequest{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAvailabilityTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAvailabilityTemplateRequest) ProtoMessage() {}
+
+func (x *CreateAvailabilityTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAvailabilityTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateAvailabilityTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAvailabilityTemplateRequest) GetTemplate() *AvailabilityTemplateInput {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetAvailabilityTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityTemplateRequest) Reset() {
+	*x = GetAvailabilityTemplateRequest{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityTemplateRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAvailabilityTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateAvailabilityTemplateRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Template      *AvailabilityTemplateInput `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAvailabilityTemplateRequest) Reset() {
+	*x = UpdateAvailabilityTemplateRequest{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAvailabilityTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAvailabilityTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateAvailabilityTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAvailabilityTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAvailabilityTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAvailabilityTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAvailabilityTemplateRequest) GetTemplate() *AvailabilityTemplateInput {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteAvailabilityTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAvailabilityTemplateRequest) Reset() {
+	*x = DeleteAvailabilityTemplateRequest{}
+	mi := &file_rasp_v1_templates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAvailabilityTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAvailabilityTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteAvailabilityTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_templates_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAvailabilityTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAvailabilityTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_templates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAvailabilityTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_rasp_v1_templates_proto protoreflect.FileDescriptor
+
+const file_rasp_v1_templates_proto_rawDesc = "" +
+	"\n" +
+	"\x17rasp/v1/templates.proto\x12\arasp.v1\x1a\x1bgoogle/protobuf/empty.proto\"Z\n" +
+	"\n" +
+	"Recurrence\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\tR\x04days\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x82\x02\n" +
+	"\x14AvailabilityTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tR\tteacherId\x123\n" +
+	"\n" +
+	"recurrence\x18\x03 \x01(\v2\x13.rasp.v1.RecurrenceR\n" +
+	"recurrence\x122\n" +
+	"\x15slot_duration_minutes\x18\x04 \x01(\x05R\x13slotDurationMinutes\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\"\xf7\x01\n" +
+	"\x19AvailabilityTemplateInput\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x123\n" +
+	"\n" +
+	"recurrence\x18\x02 \x01(\v2\x13.rasp.v1.RecurrenceR\n" +
+	"recurrence\x122\n" +
+	"\x15slot_duration_minutes\x18\x03 \x01(\x05R\x13slotDurationMinutes\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"c\n" +
+	"!CreateAvailabilityTemplateRequest\x12>\n" +
+	"\btemplate\x18\x01 \x01(\v2\".rasp.v1.AvailabilityTemplateInputR\btemplate\"0\n" +
+	"\x1eGetAvailabilityTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"!UpdateAvailabilityTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
+	"\btemplate\x18\x02 \x01(\v2\".rasp.v1.AvailabilityTemplateInputR\btemplate\"3\n" +
+	"!DeleteAvailabilityTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xae\x03\n" +
+	"\x15AvailabilityTemplates\x12g\n" +
+	"\x1aCreateAvailabilityTemplate\x12*.rasp.v1.CreateAvailabilityTemplateRequest\x1a\x1d.rasp.v1.AvailabilityTemplate\x12a\n" +
+	"\x17GetAvailabilityTemplate\x12'.rasp.v1.GetAvailabilityTemplateRequest\x1a\x1d.rasp.v1.AvailabilityTemplate\x12g\n" +
+	"\x1aUpdateAvailabilityTemplate\x12*.rasp.v1.UpdateAvailabilityTemplateRequest\x1a\x1d.rasp.v1.AvailabilityTemplate\x12`\n" +
+	"\x1aDeleteAvailabilityTemplate\x12*.rasp.v1.DeleteAvailabilityTemplateRequest\x1a\x16.google.protobuf.EmptyB'Z%rasp-service/api/proto/rasp/v1;raspv1b\x06proto3"
+
+var (
+	file_rasp_v1_templates_proto_rawDescOnce sync.Once
+	file_rasp_v1_templates_proto_rawDescData []byte
+)
+
+func file_rasp_v1_templates_proto_rawDescGZIP() []byte {
+	file_rasp_v1_templates_proto_rawDescOnce.Do(func() {
+		file_rasp_v1_templates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rasp_v1_templates_proto_rawDesc), len(file_rasp_v1_templates_proto_rawDesc)))
+	})
+	return file_rasp_v1_templates_proto_rawDescData
+}
+
+var file_rasp_v1_templates_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rasp_v1_templates_proto_goTypes = []any{
+	(*Recurrence)(nil),                        // 0: rasp.v1.Recurrence
+	(*AvailabilityTemplate)(nil),              // 1: rasp.v1.AvailabilityTemplate
+	(*AvailabilityTemplateInput)(nil),         // 2: rasp.v1.AvailabilityTemplateInput
+	(*CreateAvailabilityTemplateRequest)(nil), // 3: rasp.v1.CreateAvailabilityTemplateRequest
+	(*GetAvailabilityTemplateRequest)(nil),    // 4: rasp.v1.GetAvailabilityTemplateRequest
+	(*UpdateAvailabilityTemplateRequest)(nil), // 5: rasp.v1.UpdateAvailabilityTemplateRequest
+	(*DeleteAvailabilityTemplateRequest)(nil), // 6: rasp.v1.DeleteAvailabilityTemplateRequest
+	(*emptypb.Empty)(nil),                     // 7: google.protobuf.Empty
+}
+var file_rasp_v1_templates_proto_depIdxs = []int32{
+	0, // 0: rasp.v1.AvailabilityTemplate.recurrence:type_name -> rasp.v1.Recurrence
+	0, // 1: rasp.v1.AvailabilityTemplateInput.recurrence:type_name -> rasp.v1.Recurrence
+	2, // 2: rasp.v1.CreateAvailabilityTemplateRequest.template:type_name -> rasp.v1.AvailabilityTemplateInput
+	2, // 3: rasp.v1.UpdateAvailabilityTemplateRequest.template:type_name -> rasp.v1.AvailabilityTemplateInput
+	3, // 4: rasp.v1.AvailabilityTemplates.CreateAvailabilityTemplate:input_type -> rasp.v1.CreateAvailabilityTemplateRequest
+	4, // 5: rasp.v1.AvailabilityTemplates.GetAvailabilityTemplate:input_type -> rasp.v1.GetAvailabilityTemplateRequest
+	5, // 6: rasp.v1.AvailabilityTemplates.UpdateAvailabilityTemplate:input_type -> rasp.v1.UpdateAvailabilityTemplateRequest
+	6, // 7: rasp.v1.AvailabilityTemplates.DeleteAvailabilityTemplate:input_type -> rasp.v1.DeleteAvailabilityTemplateRequest
+	1, // 8: rasp.v1.AvailabilityTemplates.CreateAvailabilityTemplate:output_type -> rasp.v1.AvailabilityTemplate
+	1, // 9: rasp.v1.AvailabilityTemplates.GetAvailabilityTemplate:output_type -> rasp.v1.AvailabilityTemplate
+	1, // 10: rasp.v1.AvailabilityTemplates.UpdateAvailabilityTemplate:output_type -> rasp.v1.AvailabilityTemplate
+	7, // 11: rasp.v1.AvailabilityTemplates.DeleteAvailabilityTemplate:output_type -> google.protobuf.Empty
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rasp_v1_templates_proto_init() }
+func file_rasp_v1_templates_proto_init() {
+	if File_rasp_v1_templates_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rasp_v1_templates_proto_rawDesc), len(file_rasp_v1_templates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rasp_v1_templates_proto_goTypes,
+		DependencyIndexes: file_rasp_v1_templates_proto_depIdxs,
+		MessageInfos:      file_rasp_v1_templates_proto_msgTypes,
+	}.Build()
+	File_rasp_v1_templates_proto = out.File
+	file_rasp_v1_templates_proto_goTypes = nil
+	file_rasp_v1_templates_proto_depIdxs = nil
+}
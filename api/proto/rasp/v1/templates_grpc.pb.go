@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rasp/v1/templates.proto
+
+package raspv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AvailabilityTemplates_CreateAvailabilityTemplate_FullMethodName = "/rasp.v1.AvailabilityTemplates/CreateAvailabilityTemplate"
+	AvailabilityTemplates_GetAvailabilityTemplate_FullMethodName    = "/rasp.v1.AvailabilityTemplates/GetAvailabilityTemplate"
+	AvailabilityTemplates_UpdateAvailabilityTemplate_FullMethodName = "/rasp.v1.AvailabilityTemplates/UpdateAvailabilityTemplate"
+	AvailabilityTemplates_DeleteAvailabilityTemplate_FullMethodName = "/rasp.v1.AvailabilityTemplates/DeleteAvailabilityTemplate"
+)
+
+// AvailabilityTemplatesClient is the client API for AvailabilityTemplates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AvailabilityTemplates — шаблоны доступности преподавателя (REST: /availability_templates)
+type AvailabilityTemplatesClient interface {
+	CreateAvailabilityTemplate(ctx context.Context, in *CreateAvailabilityTemplateRequest, opts ...grpc.CallOption) (*AvailabilityTemplate, error)
+	GetAvailabilityTemplate(ctx context.Context, in *GetAvailabilityTemplateRequest, opts ...grpc.CallOption) (*AvailabilityTemplate, error)
+	UpdateAvailabilityTemplate(ctx context.Context, in *UpdateAvailabilityTemplateRequest, opts ...grpc.CallOption) (*AvailabilityTemplate, error)
+	DeleteAvailabilityTemplate(ctx context.Context, in *DeleteAvailabilityTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type availabilityTemplatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAvailabilityTemplatesClient(cc grpc.ClientConnInterface) AvailabilityTemplatesClient {
+	return &availabilityTemplatesClient{cc}
+}
+
+func (c *availabilityTemplatesClient) CreateAvailabilityTemplate(ctx context.Context, in *CreateAvailabilityTemplateRequest, opts ...grpc.CallOption) (*AvailabilityTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityTemplate)
+	err := c.cc.Invoke(ctx, AvailabilityTemplates_CreateAvailabilityTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityTemplatesClient) GetAvailabilityTemplate(ctx context.Context, in *GetAvailabilityTemplateRequest, opts ...grpc.CallOption) (*AvailabilityTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityTemplate)
+	err := c.cc.Invoke(ctx, AvailabilityTemplates_GetAvailabilityTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityTemplatesClient) UpdateAvailabilityTemplate(ctx context.Context, in *UpdateAvailabilityTemplateRequest, opts ...grpc.CallOption) (*AvailabilityTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityTemplate)
+	err := c.cc.Invoke(ctx, AvailabilityTemplates_UpdateAvailabilityTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityTemplatesClient) DeleteAvailabilityTemplate(ctx context.Context, in *DeleteAvailabilityTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AvailabilityTemplates_DeleteAvailabilityTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AvailabilityTemplatesServer is the server API for AvailabilityTemplates service.
+// All implementations must embed UnimplementedAvailabilityTemplatesServer
+// for forward compatibility.
+//
+// AvailabilityTemplates — шаблоны доступности преподавателя (REST: /availability_templates)
+type AvailabilityTemplatesServer interface {
+	CreateAvailabilityTemplate(context.Context, *CreateAvailabilityTemplateRequest) (*AvailabilityTemplate, error)
+	GetAvailabilityTemplate(context.Context, *GetAvailabilityTemplateRequest) (*AvailabilityTemplate, error)
+	UpdateAvailabilityTemplate(context.Context, *UpdateAvailabilityTemplateRequest) (*AvailabilityTemplate, error)
+	DeleteAvailabilityTemplate(context.Context, *DeleteAvailabilityTemplateRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAvailabilityTemplatesServer()
+}
+
+// UnimplementedAvailabilityTemplatesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAvailabilityTemplatesServer struct{}
+
+func (UnimplementedAvailabilityTemplatesServer) CreateAvailabilityTemplate(context.Context, *CreateAvailabilityTemplateRequest) (*AvailabilityTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAvailabilityTemplate not implemented")
+}
+func (UnimplementedAvailabilityTemplatesServer) GetAvailabilityTemplate(context.Context, *GetAvailabilityTemplateRequest) (*AvailabilityTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityTemplate not implemented")
+}
+func (UnimplementedAvailabilityTemplatesServer) UpdateAvailabilityTemplate(context.Context, *UpdateAvailabilityTemplateRequest) (*AvailabilityTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAvailabilityTemplate not implemented")
+}
+func (UnimplementedAvailabilityTemplatesServer) DeleteAvailabilityTemplate(context.Context, *DeleteAvailabilityTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAvailabilityTemplate not implemented")
+}
+func (UnimplementedAvailabilityTemplatesServer) mustEmbedUnimplementedAvailabilityTemplatesServer() {}
+func (UnimplementedAvailabilityTemplatesServer) testEmbeddedByValue()                               {}
+
+// UnsafeAvailabilityTemplatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AvailabilityTemplatesServer will
+// result in compilation errors.
+type UnsafeAvailabilityTemplatesServer interface {
+	mustEmbedUnimplementedAvailabilityTemplatesServer()
+}
+
+func RegisterAvailabilityTemplatesServer(s grpc.ServiceRegistrar, srv AvailabilityTemplatesServer) {
+	// If the following call pancis, it indicates UnimplementedAvailabilityTemplatesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AvailabilityTemplates_ServiceDesc, srv)
+}
+
+func _AvailabilityTemplates_CreateAvailabilityTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAvailabilityTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityTemplatesServer).CreateAvailabilityTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityTemplates_CreateAvailabilityTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityTemplatesServer).CreateAvailabilityTemplate(ctx, req.(*CreateAvailabilityTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityTemplates_GetAvailabilityTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityTemplatesServer).GetAvailabilityTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityTemplates_GetAvailabilityTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityTemplatesServer).GetAvailabilityTemplate(ctx, req.(*GetAvailabilityTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityTemplates_UpdateAvailabilityTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAvailabilityTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityTemplatesServer).UpdateAvailabilityTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityTemplates_UpdateAvailabilityTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityTemplatesServer).UpdateAvailabilityTemplate(ctx, req.(*UpdateAvailabilityTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityTemplates_DeleteAvailabilityTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAvailabilityTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityTemplatesServer).DeleteAvailabilityTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityTemplates_DeleteAvailabilityTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityTemplatesServer).DeleteAvailabilityTemplate(ctx, req.(*DeleteAvailabilityTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AvailabilityTemplates_ServiceDesc is the grpc.ServiceDesc for AvailabilityTemplates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AvailabilityTemplates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rasp.v1.AvailabilityTemplates",
+	HandlerType: (*AvailabilityTemplatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAvailabilityTemplate",
+			Handler:    _AvailabilityTemplates_CreateAvailabilityTemplate_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityTemplate",
+			Handler:    _AvailabilityTemplates_GetAvailabilityTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateAvailabilityTemplate",
+			Handler:    _AvailabilityTemplates_UpdateAvailabilityTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteAvailabilityTemplate",
+			Handler:    _AvailabilityTemplates_DeleteAvailabilityTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rasp/v1/templates.proto",
+}
@@ -0,0 +1,840 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rasp/v1/time_blocks.proto
+
+package raspv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimeBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	ExternalUid   *string                `protobuf:"bytes,7,opt,name=external_uid,json=externalUid,proto3,oneof" json:"external_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBlock) Reset() {
+	*x = TimeBlock{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBlock) ProtoMessage() {}
+
+func (x *TimeBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBlock.ProtoReflect.Descriptor instead.
+func (*TimeBlock) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeBlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimeBlock) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TimeBlock) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TimeBlock) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *TimeBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TimeBlock) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimeBlock) GetExternalUid() string {
+	if x != nil && x.ExternalUid != nil {
+		return *x.ExternalUid
+	}
+	return ""
+}
+
+type TimeBlockInput struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// RFC 3339
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// RFC 3339
+	End           string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Type          string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBlockInput) Reset() {
+	*x = TimeBlockInput{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBlockInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBlockInput) ProtoMessage() {}
+
+func (x *TimeBlockInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBlockInput.ProtoReflect.Descriptor instead.
+func (*TimeBlockInput) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeBlockInput) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TimeBlockInput) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeBlockInput) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TimeBlockInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TimeBlockInput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CreateTimeBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeBlock     *TimeBlockInput        `protobuf:"bytes,1,opt,name=time_block,json=timeBlock,proto3" json:"time_block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeBlockRequest) Reset() {
+	*x = CreateTimeBlockRequest{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeBlockRequest) ProtoMessage() {}
+
+func (x *CreateTimeBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateTimeBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTimeBlockRequest) GetTimeBlock() *TimeBlockInput {
+	if x != nil {
+		return x.TimeBlock
+	}
+	return nil
+}
+
+type GetTimeBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeBlockRequest) Reset() {
+	*x = GetTimeBlockRequest{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeBlockRequest) ProtoMessage() {}
+
+func (x *GetTimeBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTimeBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTimeBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     *string                `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3,oneof" json:"teacher_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeBlocksRequest) Reset() {
+	*x = ListTimeBlocksRequest{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeBlocksRequest) ProtoMessage() {}
+
+func (x *ListTimeBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListTimeBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTimeBlocksRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListTimeBlocksRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListTimeBlocksRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListTimeBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeBlocks    []*TimeBlock           `protobuf:"bytes,1,rep,name=time_blocks,json=timeBlocks,proto3" json:"time_blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeBlocksResponse) Reset() {
+	*x = ListTimeBlocksResponse{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeBlocksResponse) ProtoMessage() {}
+
+func (x *ListTimeBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListTimeBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTimeBlocksResponse) GetTimeBlocks() []*TimeBlock {
+	if x != nil {
+		return x.TimeBlocks
+	}
+	return nil
+}
+
+type UpdateTimeBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeBlock     *TimeBlockInput        `protobuf:"bytes,2,opt,name=time_block,json=timeBlock,proto3" json:"time_block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimeBlockRequest) Reset() {
+	*x = UpdateTimeBlockRequest{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimeBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimeBlockRequest) ProtoMessage() {}
+
+func (x *UpdateTimeBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimeBlockRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimeBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTimeBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTimeBlockRequest) GetTimeBlock() *TimeBlockInput {
+	if x != nil {
+		return x.TimeBlock
+	}
+	return nil
+}
+
+type DeleteTimeBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimeBlockRequest) Reset() {
+	*x = DeleteTimeBlockRequest{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimeBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimeBlockRequest) ProtoMessage() {}
+
+func (x *DeleteTimeBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimeBlockRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimeBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteTimeBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImportExternalCalendarRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// Содержимое ICS (text/calendar)
+	Ics []byte `protobuf:"bytes,2,opt,name=ics,proto3" json:"ics,omitempty"`
+	// Часовой пояс IANA для событий без зоны; по умолчанию UTC
+	Tz            string `protobuf:"bytes,3,opt,name=tz,proto3" json:"tz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExternalCalendarRequest) Reset() {
+	*x = ImportExternalCalendarRequest{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExternalCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExternalCalendarRequest) ProtoMessage() {}
+
+func (x *ImportExternalCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExternalCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ImportExternalCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportExternalCalendarRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ImportExternalCalendarRequest) GetIcs() []byte {
+	if x != nil {
+		return x.Ics
+	}
+	return nil
+}
+
+func (x *ImportExternalCalendarRequest) GetTz() string {
+	if x != nil {
+		return x.Tz
+	}
+	return ""
+}
+
+type TimeBlockImportSkipped struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBlockImportSkipped) Reset() {
+	*x = TimeBlockImportSkipped{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBlockImportSkipped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBlockImportSkipped) ProtoMessage() {}
+
+func (x *TimeBlockImportSkipped) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBlockImportSkipped.ProtoReflect.Descriptor instead.
+func (*TimeBlockImportSkipped) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TimeBlockImportSkipped) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TimeBlockImportSkipped) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TimeBlockImport struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	TeacherId     string                    `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	From          *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Created       int32                     `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                     `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	Deleted       int32                     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Skipped       []*TimeBlockImportSkipped `protobuf:"bytes,7,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBlockImport) Reset() {
+	*x = TimeBlockImport{}
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBlockImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBlockImport) ProtoMessage() {}
+
+func (x *TimeBlockImport) ProtoReflect() protoreflect.Message {
+	mi := &file_rasp_v1_time_blocks_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBlockImport.ProtoReflect.Descriptor instead.
+func (*TimeBlockImport) Descriptor() ([]byte, []int) {
+	return file_rasp_v1_time_blocks_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimeBlockImport) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TimeBlockImport) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimeBlockImport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TimeBlockImport) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *TimeBlockImport) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *TimeBlockImport) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *TimeBlockImport) GetSkipped() []*TimeBlockImportSkipped {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+var File_rasp_v1_time_blocks_proto protoreflect.FileDescriptor
+
+const file_rasp_v1_time_blocks_proto_rawDesc = "" +
+	"\n" +
+	"\x19rasp/v1/time_blocks.proto\x12\arasp.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x01\n" +
+	"\tTimeBlock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tR\tteacherId\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12&\n" +
+	"\fexternal_uid\x18\a \x01(\tH\x00R\vexternalUid\x88\x01\x01B\x0f\n" +
+	"\r_external_uid\"\x83\x01\n" +
+	"\x0eTimeBlockInput\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\"P\n" +
+	"\x16CreateTimeBlockRequest\x126\n" +
+	"\n" +
+	"time_block\x18\x01 \x01(\v2\x17.rasp.v1.TimeBlockInputR\ttimeBlock\"%\n" +
+	"\x13GetTimeBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x01\n" +
+	"\x15ListTimeBlocksRequest\x12\"\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tH\x00R\tteacherId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02toB\r\n" +
+	"\v_teacher_id\"M\n" +
+	"\x16ListTimeBlocksResponse\x123\n" +
+	"\vtime_blocks\x18\x01 \x03(\v2\x12.rasp.v1.TimeBlockR\n" +
+	"timeBlocks\"`\n" +
+	"\x16UpdateTimeBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\n" +
+	"time_block\x18\x02 \x01(\v2\x17.rasp.v1.TimeBlockInputR\ttimeBlock\"(\n" +
+	"\x16DeleteTimeBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x1dImportExternalCalendarRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12\x10\n" +
+	"\x03ics\x18\x02 \x01(\fR\x03ics\x12\x0e\n" +
+	"\x02tz\x18\x03 \x01(\tR\x02tz\"B\n" +
+	"\x16TimeBlockImportSkipped\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x95\x02\n" +
+	"\x0fTimeBlockImport\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x05 \x01(\x05R\aupdated\x12\x18\n" +
+	"\adeleted\x18\x06 \x01(\x05R\adeleted\x129\n" +
+	"\askipped\x18\a \x03(\v2\x1f.rasp.v1.TimeBlockImportSkippedR\askipped2\xd9\x03\n" +
+	"\n" +
+	"TimeBlocks\x12F\n" +
+	"\x0fCreateTimeBlock\x12\x1f.rasp.v1.CreateTimeBlockRequest\x1a\x12.rasp.v1.TimeBlock\x12@\n" +
+	"\fGetTimeBlock\x12\x1c.rasp.v1.GetTimeBlockRequest\x1a\x12.rasp.v1.TimeBlock\x12Q\n" +
+	"\x0eListTimeBlocks\x12\x1e.rasp.v1.ListTimeBlocksRequest\x1a\x1f.rasp.v1.ListTimeBlocksResponse\x12F\n" +
+	"\x0fUpdateTimeBlock\x12\x1f.rasp.v1.UpdateTimeBlockRequest\x1a\x12.rasp.v1.TimeBlock\x12J\n" +
+	"\x0fDeleteTimeBlock\x12\x1f.rasp.v1.DeleteTimeBlockRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\x16ImportExternalCalendar\x12&.rasp.v1.ImportExternalCalendarRequest\x1a\x18.rasp.v1.TimeBlockImportB'Z%rasp-service/api/proto/rasp/v1;raspv1b\x06proto3"
+
+var (
+	file_rasp_v1_time_blocks_proto_rawDescOnce sync.Once
+	file_rasp_v1_time_blocks_proto_rawDescData []byte
+)
+
+func file_rasp_v1_time_blocks_proto_rawDescGZIP() []byte {
+	file_rasp_v1_time_blocks_proto_rawDescOnce.Do(func() {
+		file_rasp_v1_time_blocks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rasp_v1_time_blocks_proto_rawDesc), len(file_rasp_v1_time_blocks_proto_rawDesc)))
+	})
+	return file_rasp_v1_time_blocks_proto_rawDescData
+}
+
+var file_rasp_v1_time_blocks_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_rasp_v1_time_blocks_proto_goTypes = []any{
+	(*TimeBlock)(nil),                     // 0: rasp.v1.TimeBlock
+	(*TimeBlockInput)(nil),                // 1: rasp.v1.TimeBlockInput
+	(*CreateTimeBlockRequest)(nil),        // 2: rasp.v1.CreateTimeBlockRequest
+	(*GetTimeBlockRequest)(nil),           // 3: rasp.v1.GetTimeBlockRequest
+	(*ListTimeBlocksRequest)(nil),         // 4: rasp.v1.ListTimeBlocksRequest
+	(*ListTimeBlocksResponse)(nil),        // 5: rasp.v1.ListTimeBlocksResponse
+	(*UpdateTimeBlockRequest)(nil),        // 6: rasp.v1.UpdateTimeBlockRequest
+	(*DeleteTimeBlockRequest)(nil),        // 7: rasp.v1.DeleteTimeBlockRequest
+	(*ImportExternalCalendarRequest)(nil), // 8: rasp.v1.ImportExternalCalendarRequest
+	(*TimeBlockImportSkipped)(nil),        // 9: rasp.v1.TimeBlockImportSkipped
+	(*TimeBlockImport)(nil),               // 10: rasp.v1.TimeBlockImport
+	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 12: google.protobuf.Empty
+}
+var file_rasp_v1_time_blocks_proto_depIdxs = []int32{
+	11, // 0: rasp.v1.TimeBlock.start:type_name -> google.protobuf.Timestamp
+	11, // 1: rasp.v1.TimeBlock.end:type_name -> google.protobuf.Timestamp
+	1,  // 2: rasp.v1.CreateTimeBlockRequest.time_block:type_name -> rasp.v1.TimeBlockInput
+	11, // 3: rasp.v1.ListTimeBlocksRequest.from:type_name -> google.protobuf.Timestamp
+	11, // 4: rasp.v1.ListTimeBlocksRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 5: rasp.v1.ListTimeBlocksResponse.time_blocks:type_name -> rasp.v1.TimeBlock
+	1,  // 6: rasp.v1.UpdateTimeBlockRequest.time_block:type_name -> rasp.v1.TimeBlockInput
+	11, // 7: rasp.v1.TimeBlockImport.from:type_name -> google.protobuf.Timestamp
+	11, // 8: rasp.v1.TimeBlockImport.to:type_name -> google.protobuf.Timestamp
+	9,  // 9: rasp.v1.TimeBlockImport.skipped:type_name -> rasp.v1.TimeBlockImportSkipped
+	2,  // 10: rasp.v1.TimeBlocks.CreateTimeBlock:input_type -> rasp.v1.CreateTimeBlockRequest
+	3,  // 11: rasp.v1.TimeBlocks.GetTimeBlock:input_type -> rasp.v1.GetTimeBlockRequest
+	4,  // 12: rasp.v1.TimeBlocks.ListTimeBlocks:input_type -> rasp.v1.ListTimeBlocksRequest
+	6,  // 13: rasp.v1.TimeBlocks.UpdateTimeBlock:input_type -> rasp.v1.UpdateTimeBlockRequest
+	7,  // 14: rasp.v1.TimeBlocks.DeleteTimeBlock:input_type -> rasp.v1.DeleteTimeBlockRequest
+	8,  // 15: rasp.v1.TimeBlocks.ImportExternalCalendar:input_type -> rasp.v1.ImportExternalCalendarRequest
+	0,  // 16: rasp.v1.TimeBlocks.CreateTimeBlock:output_type -> rasp.v1.TimeBlock
+	0,  // 17: rasp.v1.TimeBlocks.GetTimeBlock:output_type -> rasp.v1.TimeBlock
+	5,  // 18: rasp.v1.TimeBlocks.ListTimeBlocks:output_type -> rasp.v1.ListTimeBlocksResponse
+	0,  // 19: rasp.v1.TimeBlocks.UpdateTimeBlock:output_type -> rasp.v1.TimeBlock
+	12, // 20: rasp.v1.TimeBlocks.DeleteTimeBlock:output_type -> google.protobuf.Empty
+	10, // 21: rasp.v1.TimeBlocks.ImportExternalCalendar:output_type -> rasp.v1.TimeBlockImport
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_rasp_v1_time_blocks_proto_init() }
+func file_rasp_v1_time_blocks_proto_init() {
+	if File_rasp_v1_time_blocks_proto != nil {
+		return
+	}
+	file_rasp_v1_time_blocks_proto_msgTypes[0].OneofWrappers = []any{}
+	file_rasp_v1_time_blocks_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rasp_v1_time_blocks_proto_rawDesc), len(file_rasp_v1_time_blocks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rasp_v1_time_blocks_proto_goTypes,
+		DependencyIndexes: file_rasp_v1_time_blocks_proto_depIdxs,
+		MessageInfos:      file_rasp_v1_time_blocks_proto_msgTypes,
+	}.Build()
+	File_rasp_v1_time_blocks_proto = out.File
+	file_rasp_v1_time_blocks_proto_goTypes = nil
+	file_rasp_v1_time_blocks_proto_depIdxs = nil
+}
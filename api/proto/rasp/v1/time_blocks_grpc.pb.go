@@ -0,0 +1,318 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rasp/v1/time_blocks.proto
+
+package raspv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimeBlocks_CreateTimeBlock_FullMethodName        = "/rasp.v1.TimeBlocks/CreateTimeBlock"
+	TimeBlocks_GetTimeBlock_FullMethodName           = "/rasp.v1.TimeBlocks/GetTimeBlock"
+	TimeBlocks_ListTimeBlocks_FullMethodName         = "/rasp.v1.TimeBlocks/ListTimeBlocks"
+	TimeBlocks_UpdateTimeBlock_FullMethodName        = "/rasp.v1.TimeBlocks/UpdateTimeBlock"
+	TimeBlocks_DeleteTimeBlock_FullMethodName        = "/rasp.v1.TimeBlocks/DeleteTimeBlock"
+	TimeBlocks_ImportExternalCalendar_FullMethodName = "/rasp.v1.TimeBlocks/ImportExternalCalendar"
+)
+
+// TimeBlocksClient is the client API for TimeBlocks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TimeBlocks — блокировки времени преподавателя (REST: /time_blocks)
+type TimeBlocksClient interface {
+	CreateTimeBlock(ctx context.Context, in *CreateTimeBlockRequest, opts ...grpc.CallOption) (*TimeBlock, error)
+	GetTimeBlock(ctx context.Context, in *GetTimeBlockRequest, opts ...grpc.CallOption) (*TimeBlock, error)
+	ListTimeBlocks(ctx context.Context, in *ListTimeBlocksRequest, opts ...grpc.CallOption) (*ListTimeBlocksResponse, error)
+	UpdateTimeBlock(ctx context.Context, in *UpdateTimeBlockRequest, opts ...grpc.CallOption) (*TimeBlock, error)
+	DeleteTimeBlock(ctx context.Context, in *DeleteTimeBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImportExternalCalendar синхронизирует занятость из ICS (REST: POST /time_blocks/import)
+	ImportExternalCalendar(ctx context.Context, in *ImportExternalCalendarRequest, opts ...grpc.CallOption) (*TimeBlockImport, error)
+}
+
+type timeBlocksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimeBlocksClient(cc grpc.ClientConnInterface) TimeBlocksClient {
+	return &timeBlocksClient{cc}
+}
+
+func (c *timeBlocksClient) CreateTimeBlock(ctx context.Context, in *CreateTimeBlockRequest, opts ...grpc.CallOption) (*TimeBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeBlock)
+	err := c.cc.Invoke(ctx, TimeBlocks_CreateTimeBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeBlocksClient) GetTimeBlock(ctx context.Context, in *GetTimeBlockRequest, opts ...grpc.CallOption) (*TimeBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeBlock)
+	err := c.cc.Invoke(ctx, TimeBlocks_GetTimeBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeBlocksClient) ListTimeBlocks(ctx context.Context, in *ListTimeBlocksRequest, opts ...grpc.CallOption) (*ListTimeBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTimeBlocksResponse)
+	err := c.cc.Invoke(ctx, TimeBlocks_ListTimeBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeBlocksClient) UpdateTimeBlock(ctx context.Context, in *UpdateTimeBlockRequest, opts ...grpc.CallOption) (*TimeBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeBlock)
+	err := c.cc.Invoke(ctx, TimeBlocks_UpdateTimeBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeBlocksClient) DeleteTimeBlock(ctx context.Context, in *DeleteTimeBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TimeBlocks_DeleteTimeBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeBlocksClient) ImportExternalCalendar(ctx context.Context, in *ImportExternalCalendarRequest, opts ...grpc.CallOption) (*TimeBlockImport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeBlockImport)
+	err := c.cc.Invoke(ctx, TimeBlocks_ImportExternalCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimeBlocksServer is the server API for TimeBlocks service.
+// All implementations must embed UnimplementedTimeBlocksServer
+// for forward compatibility.
+//
+// TimeBlocks — блокировки времени преподавателя (REST: /time_blocks)
+type TimeBlocksServer interface {
+	CreateTimeBlock(context.Context, *CreateTimeBlockRequest) (*TimeBlock, error)
+	GetTimeBlock(context.Context, *GetTimeBlockRequest) (*TimeBlock, error)
+	ListTimeBlocks(context.Context, *ListTimeBlocksRequest) (*ListTimeBlocksResponse, error)
+	UpdateTimeBlock(context.Context, *UpdateTimeBlockRequest) (*TimeBlock, error)
+	DeleteTimeBlock(context.Context, *DeleteTimeBlockRequest) (*emptypb.Empty, error)
+	// ImportExternalCalendar синхронизирует занятость из ICS (REST: POST /time_blocks/import)
+	ImportExternalCalendar(context.Context, *ImportExternalCalendarRequest) (*TimeBlockImport, error)
+	mustEmbedUnimplementedTimeBlocksServer()
+}
+
+// UnimplementedTimeBlocksServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimeBlocksServer struct{}
+
+func (UnimplementedTimeBlocksServer) CreateTimeBlock(context.Context, *CreateTimeBlockRequest) (*TimeBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTimeBlock not implemented")
+}
+func (UnimplementedTimeBlocksServer) GetTimeBlock(context.Context, *GetTimeBlockRequest) (*TimeBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeBlock not implemented")
+}
+func (UnimplementedTimeBlocksServer) ListTimeBlocks(context.Context, *ListTimeBlocksRequest) (*ListTimeBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimeBlocks not implemented")
+}
+func (UnimplementedTimeBlocksServer) UpdateTimeBlock(context.Context, *UpdateTimeBlockRequest) (*TimeBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTimeBlock not implemented")
+}
+func (UnimplementedTimeBlocksServer) DeleteTimeBlock(context.Context, *DeleteTimeBlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTimeBlock not implemented")
+}
+func (UnimplementedTimeBlocksServer) ImportExternalCalendar(context.Context, *ImportExternalCalendarRequest) (*TimeBlockImport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExternalCalendar not implemented")
+}
+func (UnimplementedTimeBlocksServer) mustEmbedUnimplementedTimeBlocksServer() {}
+func (UnimplementedTimeBlocksServer) testEmbeddedByValue()                    {}
+
+// UnsafeTimeBlocksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimeBlocksServer will
+// result in compilation errors.
+type UnsafeTimeBlocksServer interface {
+	mustEmbedUnimplementedTimeBlocksServer()
+}
+
+func RegisterTimeBlocksServer(s grpc.ServiceRegistrar, srv TimeBlocksServer) {
+	// If the following call pancis, it indicates UnimplementedTimeBlocksServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimeBlocks_ServiceDesc, srv)
+}
+
+func _TimeBlocks_CreateTimeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTimeBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeBlocksServer).CreateTimeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeBlocks_CreateTimeBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeBlocksServer).CreateTimeBlock(ctx, req.(*CreateTimeBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeBlocks_GetTimeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeBlocksServer).GetTimeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeBlocks_GetTimeBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeBlocksServer).GetTimeBlock(ctx, req.(*GetTimeBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeBlocks_ListTimeBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimeBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeBlocksServer).ListTimeBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeBlocks_ListTimeBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeBlocksServer).ListTimeBlocks(ctx, req.(*ListTimeBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeBlocks_UpdateTimeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTimeBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeBlocksServer).UpdateTimeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeBlocks_UpdateTimeBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeBlocksServer).UpdateTimeBlock(ctx, req.(*UpdateTimeBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeBlocks_DeleteTimeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTimeBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeBlocksServer).DeleteTimeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeBlocks_DeleteTimeBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeBlocksServer).DeleteTimeBlock(ctx, req.(*DeleteTimeBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeBlocks_ImportExternalCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExternalCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeBlocksServer).ImportExternalCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeBlocks_ImportExternalCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeBlocksServer).ImportExternalCalendar(ctx, req.(*ImportExternalCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimeBlocks_ServiceDesc is the grpc.ServiceDesc for TimeBlocks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimeBlocks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rasp.v1.TimeBlocks",
+	HandlerType: (*TimeBlocksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTimeBlock",
+			Handler:    _TimeBlocks_CreateTimeBlock_Handler,
+		},
+		{
+			MethodName: "GetTimeBlock",
+			Handler:    _TimeBlocks_GetTimeBlock_Handler,
+		},
+		{
+			MethodName: "ListTimeBlocks",
+			Handler:    _TimeBlocks_ListTimeBlocks_Handler,
+		},
+		{
+			MethodName: "UpdateTimeBlock",
+			Handler:    _TimeBlocks_UpdateTimeBlock_Handler,
+		},
+		{
+			MethodName: "DeleteTimeBlock",
+			Handler:    _TimeBlocks_DeleteTimeBlock_Handler,
+		},
+		{
+			MethodName: "ImportExternalCalendar",
+			Handler:    _TimeBlocks_ImportExternalCalendar_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rasp/v1/time_blocks.proto",
+}
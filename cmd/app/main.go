@@ -1,6 +1,7 @@
 package main
 
 import (
+	"rasp-service/api"
 	"rasp-service/internal/config"
 	availCreate "rasp-service/internal/http-server/handlers/availability_templates/create"
 	availGet "rasp-service/internal/http-server/handlers/availability_templates/get"
@@ -10,31 +11,79 @@ import (
 	timeBlockGet "rasp-service/internal/http-server/handlers/time_blocks/get"
 	timeBlockUpdate "rasp-service/internal/http-server/handlers/time_blocks/update"
 	timeBlockDelete "rasp-service/internal/http-server/handlers/time_blocks/delete"
+	timeBlockImport "rasp-service/internal/http-server/handlers/time_blocks/ics"
 	slotGet "rasp-service/internal/http-server/handlers/slots/get"
 	slotGenerate "rasp-service/internal/http-server/handlers/slots/generate"
+	slotCheckIn "rasp-service/internal/http-server/handlers/slots/checkin"
+	slotStream "rasp-service/internal/http-server/handlers/slots/stream"
 	bookingCreate "rasp-service/internal/http-server/handlers/bookings/create"
 	bookingGet "rasp-service/internal/http-server/handlers/bookings/get"
 	bookingCancel "rasp-service/internal/http-server/handlers/bookings/cancel"
 	bookingReschedule "rasp-service/internal/http-server/handlers/bookings/reschedule"
 	bookingConfirm "rasp-service/internal/http-server/handlers/bookings/confirm"
 	bookingDelete "rasp-service/internal/http-server/handlers/bookings/delete"
+	bookingHistory "rasp-service/internal/http-server/handlers/bookings/history"
+	bookingRestore "rasp-service/internal/http-server/handlers/bookings/restore"
+	bookingCheckIn "rasp-service/internal/http-server/handlers/bookings/checkin"
+	bookingReminders "rasp-service/internal/http-server/handlers/bookings/reminders"
+	bookingRulesCreate "rasp-service/internal/http-server/handlers/booking_rules/create"
+	bookingRulesGet "rasp-service/internal/http-server/handlers/booking_rules/get"
+	bookingRulesUpdate "rasp-service/internal/http-server/handlers/booking_rules/update"
+	bookingRulesDelete "rasp-service/internal/http-server/handlers/booking_rules/delete"
 	attendanceCreate "rasp-service/internal/http-server/handlers/attendance/create"
 	attendanceGet "rasp-service/internal/http-server/handlers/attendance/get"
+	attendanceUpdate "rasp-service/internal/http-server/handlers/attendance/update"
+	attendancePatch "rasp-service/internal/http-server/handlers/attendance/patch"
+	attendanceDelete "rasp-service/internal/http-server/handlers/attendance/delete"
+	attendanceBulk "rasp-service/internal/http-server/handlers/attendance/bulk"
+	reportAttendance "rasp-service/internal/http-server/handlers/reports/attendance"
+	creditGet "rasp-service/internal/http-server/handlers/credits/get"
+	calendarFeed "rasp-service/internal/http-server/handlers/calendars/feed"
+	calendarToken "rasp-service/internal/http-server/handlers/calendars/token"
+	restrictionGet "rasp-service/internal/http-server/handlers/restrictions/get"
+	restrictionLift "rasp-service/internal/http-server/handlers/restrictions/lift"
+	webhookCreate "rasp-service/internal/http-server/handlers/webhooks/create"
+	webhookGet "rasp-service/internal/http-server/handlers/webhooks/get"
+	webhookUpdate "rasp-service/internal/http-server/handlers/webhooks/update"
+	webhookDelete "rasp-service/internal/http-server/handlers/webhooks/delete"
+	webhookDeliveries "rasp-service/internal/http-server/handlers/webhooks/deliveries"
+	webhookReplay "rasp-service/internal/http-server/handlers/webhooks/replay"
+	apiKeyCreate "rasp-service/internal/http-server/handlers/api_keys/create"
+	apiKeyGet "rasp-service/internal/http-server/handlers/api_keys/get"
+	apiKeyRevoke "rasp-service/internal/http-server/handlers/api_keys/revoke"
 	svc "rasp-service/internal/service"
+	grpcserver "rasp-service/internal/grpc-server"
 	"rasp-service/internal/storage/postgres"
 	"rasp-service/internal/lock"
+	"rasp-service/internal/models"
+	"rasp-service/internal/notifier"
+	"rasp-service/internal/publisher"
+	"rasp-service/internal/slotfeed"
+	"rasp-service/internal/worker"
 	slogpretty "rasp-service/pkg/handlers/slogPretty"
+	"rasp-service/pkg/jwt"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/middleware/apikey"
+	"rasp-service/pkg/middleware/auth"
 	"rasp-service/pkg/middleware/mwLogger"
+	"rasp-service/pkg/middleware/openapi"
+	"rasp-service/pkg/middleware/tenant"
 	"rasp-service/pkg/sl"
 	"context"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -46,8 +95,8 @@ const (
 func CORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key, X-Actor-ID, X-API-Key, X-Tenant-ID")
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 
 		if r.Method == http.MethodOptions {
@@ -68,6 +117,12 @@ func main() {
 	log.Info("Starting API", slog.String("env", cfg.Env))
 	log.Debug("Debug messages are enabled")
 
+	tenants, err := tenant.NewResolver(cfg.Tenancy.Default, cfg.Tenancy.Tenants)
+	if err != nil {
+		log.Error("Invalid tenancy config", sl.Err(err))
+		os.Exit(1)
+	}
+
 	storage, err := postgres.New(cfg.StoragePath)
 	if err != nil {
 		log.Error("Failed to init storage", sl.Err(err))
@@ -80,60 +135,258 @@ func main() {
 		os.Exit(1)
 	}
 
-	service := svc.NewService(storage, locker)
+	penaltyMode := models.RestrictionMode(cfg.Penalties.Mode)
+	if penaltyMode != models.RestrictionReject && penaltyMode != models.RestrictionRequireConfirmation {
+		log.Error("Invalid penalties mode", slog.String("mode", cfg.Penalties.Mode))
+		os.Exit(1)
+	}
+
+	var publishers publisher.Fanout
+	for _, name := range cfg.Outbox.Publishers {
+		switch name {
+		case "log":
+			publishers = append(publishers, publisher.NewLog(log))
+		case "webhook":
+			publishers = append(publishers, publisher.NewWebhook(storage))
+		case "redis":
+			publishers = append(publishers, publisher.NewRedisStream(locker.Client(), cfg.Outbox.RedisStream, cfg.Outbox.RedisMaxLen))
+		default:
+			log.Error("Unknown outbox publisher", slog.String("publisher", name))
+			os.Exit(1)
+		}
+	}
+
+	// лента слотов получает события из того же outbox, что и остальные publishers
+	var feed *slotfeed.Feed
+	if cfg.SlotStream.Enabled {
+		feed = slotfeed.New(locker.Client(), cfg.SlotStream.History)
+		publishers = append(publishers, feed)
+	}
+
+	var reminders *notifier.Reminders
+	if cfg.Reminders.Enabled {
+		reminders, err = newReminderNotifier(log, cfg.Reminders)
+		if err != nil {
+			log.Error("Failed to init reminders", sl.Err(err))
+			os.Exit(1)
+		}
+	}
+
+	recipients := make([]models.ReminderRecipient, 0, len(cfg.Reminders.Recipients))
+	for _, role := range cfg.Reminders.Recipients {
+		recipient := models.ReminderRecipient(role)
+		if recipient != models.ReminderStudent && recipient != models.ReminderTeacher {
+			log.Error("Unknown reminder recipient", slog.String("recipient", role))
+			os.Exit(1)
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	opts := []svc.Option{svc.WithPenaltyPolicy(svc.PenaltyPolicy{
+		Enabled:          cfg.Penalties.Enabled,
+		Threshold:        cfg.Penalties.Threshold,
+		Window:           cfg.Penalties.Window,
+		Cooldown:         cfg.Penalties.Cooldown,
+		LateCancelNotice: cfg.Penalties.LateCancelNotice,
+		Mode:             penaltyMode,
+	}), svc.WithMakeupCreditTTL(cfg.Credits.TTL), svc.WithWebhookPolicy(svc.WebhookPolicy{
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		BackoffBase: cfg.Webhooks.BackoffBase,
+		BackoffMax:  cfg.Webhooks.BackoffMax,
+		Timeout:     cfg.Webhooks.Timeout,
+	}), svc.WithEventPublisher(publishers)}
+	if reminders != nil {
+		opts = append(opts, svc.WithReminders(svc.ReminderPolicy{
+			Offsets:     cfg.Reminders.Offsets,
+			Recipients:  recipients,
+			MaxAttempts: cfg.Reminders.MaxAttempts,
+			RetryDelay:  cfg.Reminders.RetryDelay,
+			Timeout:     cfg.Reminders.Timeout,
+		}, reminders))
+	}
+
+	service := svc.NewService(storage, locker, opts...)
+
+	var authenticator *auth.Authenticator
+	if cfg.Auth.Enabled {
+		authenticator, err = newAuthenticator(cfg.Auth, tenants)
+		if err != nil {
+			log.Error("Failed to init authentication", sl.Err(err))
+			os.Exit(1)
+		}
+	} else {
+		log.Warn("Authentication is disabled, every endpoint is open")
+	}
 
 	router := chi.NewRouter()
 
 	router.Use(middleware.RequestID)
+	// до apikey.New: ключ ищется среди ключей арендатора запроса
+	router.Use(tenant.New(log, tenants))
+	router.Use(actor.New())
+	// после actor.New: инициатором запроса с ключом становится сам ключ, а не X-Actor-ID
+	router.Use(apikey.New(log, service))
 	router.Use(mwLogger.New(log))
 	router.Use(middleware.Recoverer)
-	router.Use(middleware.URLFormat)
+	// URLFormat из chi v5: версия v1 не видит контекст маршрутизатора v5
+	router.Use(chimw.URLFormat)
 	router.Use(CORS)
 
+	if cfg.OpenAPI.ValidateRequests {
+		validator, err := openapi.New(log, api.OpenAPISpec, openapi.Options{
+			ValidateResponses: cfg.OpenAPI.ValidateResponses,
+		})
+		if err != nil {
+			log.Error("Failed to load OpenAPI spec", sl.Err(err))
+			os.Exit(1)
+		}
+		router.Use(validator)
+	}
+
+	// всё, кроме ICS-лент (у них свой токен в ссылке), требует bearer-токен или API-ключ
+	protected := router.With()
+	if authenticator != nil {
+		protected = router.With(auth.New(log, authenticator))
+	}
+
 	// Availability Templates
-	router.Post("/availability_templates", availCreate.New(log, service))
-	router.Get("/availability_templates/{id}", availGet.New(log, service))
-	router.Put("/availability_templates/{id}", availUpdate.New(log, service))
-	router.Delete("/availability_templates/{id}", availDelete.New(log, service))
+	protected.Post("/availability_templates", availCreate.New(log, service))
+	protected.Get("/availability_templates/{id}", availGet.New(log, service))
+	protected.Put("/availability_templates/{id}", availUpdate.New(log, service))
+	protected.Delete("/availability_templates/{id}", availDelete.New(log, service))
 
 	// Time Blocks
-	router.Post("/time_blocks", timeBlockCreate.New(log, service))
-	router.Get("/time_blocks/{id}", timeBlockGet.New(log, service))
-	router.Put("/time_blocks/{id}", timeBlockUpdate.New(log, service))
-	router.Delete("/time_blocks/{id}", timeBlockDelete.New(log, service))
+	protected.Post("/time_blocks", timeBlockCreate.New(log, service))
+	protected.Get("/time_blocks/{id}", timeBlockGet.New(log, service))
+	protected.Put("/time_blocks/{id}", timeBlockUpdate.New(log, service))
+	protected.Delete("/time_blocks/{id}", timeBlockDelete.New(log, service))
+	protected.Post("/time_blocks/import", timeBlockImport.New(log, service))
 
 	// Slots
 	// router.Get("/slots", slotGet.New(log, service))
-	router.Get("/slots/{id}", slotGet.New(log, service))
-	router.Get("/slots/batch", slotGet.New(log, service))
-	router.Post("/slots/generate", slotGenerate.New(log, service))
+	if feed != nil {
+		protected.Get("/slots/stream", slotStream.New(log, feed, cfg.SlotStream.Heartbeat))
+	}
+	protected.Get("/slots/{id}", slotGet.New(log, service))
+	protected.Get("/slots/batch", slotGet.New(log, service))
+	protected.Post("/slots/generate", slotGenerate.New(log, service))
+	protected.Get("/slots/{id}/check-in-code", slotCheckIn.New(log, service))
 
 	// Bookings
-	router.Post("/bookings", bookingCreate.New(log, service))
-	// router.Get("/bookings", bookingGet.New(log, service))
-	router.Get("/bookings/{id}", bookingGet.New(log, service))
-	router.Put("/bookings/{id}/cancel", bookingCancel.New(log, service))
-	router.Post("/bookings/reschedule", bookingReschedule.New(log, service))
-	router.Post("/bookings/{id}/confirm", bookingConfirm.New(log, service))
-	router.Delete("/bookings/{id}", bookingDelete.New(log, service))
+	protected.Post("/bookings", bookingCreate.New(log, service))
+	protected.Get("/bookings", bookingGet.New(log, service))
+	protected.Get("/bookings/{id}", bookingGet.New(log, service))
+	protected.Put("/bookings/{id}/cancel", bookingCancel.New(log, service))
+	protected.Post("/bookings/reschedule", bookingReschedule.New(log, service))
+	protected.Post("/bookings/{id}/confirm", bookingConfirm.New(log, service))
+	protected.Delete("/bookings/{id}", bookingDelete.New(log, service))
+	protected.Get("/bookings/{id}/history", bookingHistory.New(log, service))
+	protected.Post("/bookings/{id}/restore", bookingRestore.New(log, service))
+	protected.Post("/bookings/{id}/check-in", bookingCheckIn.New(log, service))
+	protected.Get("/bookings/{id}/reminders", bookingReminders.New(log, service))
+
+	// Booking Rules
+	protected.Post("/booking_rules", bookingRulesCreate.New(log, service))
+	protected.Get("/booking_rules", bookingRulesGet.New(log, service))
+	protected.Get("/booking_rules/{id}", bookingRulesGet.New(log, service))
+	protected.Put("/booking_rules/{id}", bookingRulesUpdate.New(log, service))
+	protected.Delete("/booking_rules/{id}", bookingRulesDelete.New(log, service))
 
 	// Attendance
-	router.Post("/attendance", attendanceCreate.New(log, service))
-	router.Get("/attendance", attendanceGet.New(log, service))
-	router.Get("/attendance/{id}", attendanceGet.New(log, service))
+	protected.Post("/attendance", attendanceCreate.New(log, service))
+	protected.Get("/attendance", attendanceGet.New(log, service))
+	protected.Post("/attendance/bulk", attendanceBulk.New(log, service))
+	protected.Get("/attendance/{id}", attendanceGet.New(log, service))
+	protected.Put("/attendance/{id}", attendanceUpdate.New(log, service))
+	protected.Patch("/attendance/{id}", attendancePatch.New(log, service))
+	protected.Delete("/attendance/{id}", attendanceDelete.New(log, service))
+
+	// Calendars (.ics срезается URLFormat)
+	router.Get("/calendars/teachers/{id}", calendarFeed.New(log, service, string(models.CalendarOwnerTeacher)))
+	router.Get("/calendars/students/{id}", calendarFeed.New(log, service, string(models.CalendarOwnerStudent)))
+	protected.Post("/calendars/teachers/{id}/token", calendarToken.New(log, service, string(models.CalendarOwnerTeacher)))
+	protected.Post("/calendars/students/{id}/token", calendarToken.New(log, service, string(models.CalendarOwnerStudent)))
+
+	// Makeup Credits
+	protected.Get("/students/{id}/credits", creditGet.New(log, service))
+
+	// Student Restrictions (admin)
+	protected.Get("/admin/restrictions", restrictionGet.New(log, service))
+	protected.Get("/admin/restrictions/{id}", restrictionGet.New(log, service))
+	protected.Post("/admin/restrictions/{id}/lift", restrictionLift.New(log, service))
+
+	// Webhooks (admin)
+	protected.Post("/webhooks", webhookCreate.New(log, service))
+	protected.Get("/webhooks", webhookGet.New(log, service))
+	protected.Get("/webhooks/{id}", webhookGet.New(log, service))
+	protected.Put("/webhooks/{id}", webhookUpdate.New(log, service))
+	protected.Delete("/webhooks/{id}", webhookDelete.New(log, service))
+	protected.Get("/webhooks/{id}/deliveries", webhookDeliveries.New(log, service))
+	protected.Post("/webhooks/deliveries/{id}/replay", webhookReplay.New(log, service))
+
+	// Reports
+	protected.Get("/reports/attendance", reportAttendance.New(log, service))
+
+	// API Keys (admin)
+	protected.Post("/admin/api_keys", apiKeyCreate.New(log, service))
+	protected.Get("/admin/api_keys", apiKeyGet.New(log, service))
+	protected.Get("/admin/api_keys/{id}", apiKeyGet.New(log, service))
+	protected.Post("/admin/api_keys/{id}/revoke", apiKeyRevoke.New(log, service))
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+
+	if cfg.NoShow.Enabled {
+		noShow := worker.NewNoShow(log, service, locker, tenants.Tenants(), cfg.NoShow.Interval, cfg.NoShow.GracePeriod, cfg.NoShow.BatchSize)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			noShow.Run(workerCtx)
+		}()
+	}
+
+	if cfg.Outbox.Enabled {
+		relay := worker.NewOutbox(log, service, tenants.Tenants(), cfg.Outbox.Interval, cfg.Outbox.BatchSize, cfg.Outbox.Retention)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			relay.Run(workerCtx)
+		}()
+	}
+
+	if reminders != nil {
+		reminderWorker := worker.NewReminders(log, service, tenants.Tenants(), cfg.Reminders.Interval, cfg.Reminders.BatchSize)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			reminderWorker.Run(workerCtx)
+		}()
+	}
+
+	if cfg.Webhooks.Enabled {
+		webhooks := worker.NewWebhooks(log, service, locker, tenants.Tenants(), cfg.Webhooks.Interval, cfg.Webhooks.BatchSize)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			webhooks.Run(workerCtx)
+		}()
+	}
 
 	serv := &http.Server{
-		Addr:         cfg.Address,
+		Addr:         cfg.HTTPServer.Address,
 		Handler:      router,
 		ReadTimeout:  cfg.HTTPServer.Timeout,
 		WriteTimeout: cfg.HTTPServer.Timeout,
 		IdleTimeout:  cfg.HTTPServer.IdleTimeout,
 	}
+	if feed != nil {
+		serv.RegisterOnShutdown(feed.Close)
+	}
 
 	serverErrCh := make(chan error, 1)
 
 	go func() {
-		log.Info("Starting HTTP server", slog.String("addr", cfg.Address))
+		log.Info("Starting HTTP server", slog.String("addr", cfg.HTTPServer.Address))
 		if err := serv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			serverErrCh <- err
 		} else {
@@ -141,6 +394,30 @@ func main() {
 		}
 	}()
 
+	var grpcServ *grpc.Server
+	var grpcErrCh chan error
+
+	if cfg.GRPC.Enabled {
+		lis, err := net.Listen("tcp", cfg.GRPC.Address)
+		if err != nil {
+			log.Error("Failed to listen gRPC address", slog.String("addr", cfg.GRPC.Address), sl.Err(err))
+			os.Exit(1)
+		}
+
+		grpcOpts := []grpc.ServerOption{grpcserver.WithTenants(tenants), grpcserver.WithAPIKeys(service)}
+		if authenticator != nil {
+			grpcOpts = append(grpcOpts, grpcserver.WithAuth(authenticator))
+		}
+
+		grpcServ = grpcserver.New(log, service, grpcOpts...)
+		grpcErrCh = make(chan error, 1)
+
+		go func() {
+			log.Info("Starting gRPC server", slog.String("addr", cfg.GRPC.Address))
+			grpcErrCh <- grpcServ.Serve(lis)
+		}()
+	}
+
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
@@ -153,6 +430,8 @@ func main() {
 		} else {
 			log.Info("HTTP server stopped gracefully")
 		}
+	case err := <-grpcErrCh:
+		log.Error("gRPC server stopped unexpectedly", sl.Err(err))
 	}
 
 	shutdownTimeout := cfg.HTTPServer.ShutdownTimeout
@@ -168,6 +447,16 @@ func main() {
 		log.Info("Server shutdown complete")
 	}
 
+	if grpcServ != nil {
+		log.Info("Shutting down gRPC server")
+		stopGRPC(ctx, grpcServ)
+		log.Info("gRPC server stopped")
+	}
+
+	stopWorkers()
+	workers.Wait()
+	log.Info("Background workers stopped")
+
 	if storage != nil {
 		if err := storage.Close(); err != nil {
 			log.Error("Failed to close storage", sl.Err(err))
@@ -190,6 +479,83 @@ func main() {
 
 }
 
+// stopGRPC дожидается завершения активных вызовов, а по истечении ctx обрывает их
+func stopGRPC(ctx context.Context, serv *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		serv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		serv.Stop()
+		<-done
+	}
+}
+
+// newAuthenticator собирает проверку токенов: HS256 по секрету и/или RS256 по JWKS-файлу
+func newAuthenticator(cfg config.Auth, tenants *tenant.Resolver) (*auth.Authenticator, error) {
+	opts := jwt.Options{
+		Secret:   []byte(cfg.Secret),
+		Issuer:   cfg.Issuer,
+		Audience: cfg.Audience,
+		Leeway:   cfg.Leeway,
+		MaxAge:   cfg.MaxAge,
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := jwt.LoadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		opts.Keys = keys
+	}
+
+	verifier, err := jwt.NewVerifier(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewAuthenticator(verifier, cfg.RoleClaim).WithTenants(cfg.TenantClaim, tenants), nil
+}
+
+// newReminderNotifier собирает шаблоны напоминаний и выбранного провайдера доставки
+func newReminderNotifier(log *slog.Logger, cfg config.Reminders) (*notifier.Reminders, error) {
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("timezone: %w", err)
+	}
+
+	var sender notifier.Sender
+	switch cfg.Provider {
+	case "log":
+		sender = notifier.NewLog(log)
+	case "smtp":
+		sender, err = notifier.NewSMTP(notifier.SMTPConfig{
+			Host:            cfg.SMTP.Host,
+			Port:            cfg.SMTP.Port,
+			Username:        cfg.SMTP.Username,
+			Password:        cfg.SMTP.Password,
+			From:            cfg.SMTP.From,
+			AddressTemplate: cfg.SMTP.AddressTemplate,
+		})
+		if err != nil {
+			return nil, err
+		}
+	case "http":
+		if cfg.HTTP.URL == "" {
+			return nil, fmt.Errorf("http provider requires url")
+		}
+		sender = notifier.NewHTTP(cfg.HTTP.URL, cfg.HTTP.Timeout)
+	default:
+		return nil, fmt.Errorf("unknown provider %q", cfg.Provider)
+	}
+
+	return notifier.New(sender, cfg.Subject, cfg.Body, loc)
+}
+
 func setupLogger(env string) *slog.Logger {
 	var log *slog.Logger
 	switch env {
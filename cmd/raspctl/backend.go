@@ -0,0 +1,204 @@
+package main
+
+import (
+	"rasp-service/api"
+	"rasp-service/internal/config"
+	"rasp-service/internal/lock"
+	"rasp-service/internal/models"
+	svc "rasp-service/internal/service"
+	"rasp-service/internal/storage/postgres"
+	"rasp-service/pkg/client"
+	"rasp-service/pkg/middleware/tenant"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// backend — операции raspctl; реализуются напрямую через сервисный слой или через HTTP API
+type backend interface {
+	GenerateSlots(ctx context.Context, req api.SlotGenerateRequest) (string, error)
+	ListBookings(ctx context.Context, filter client.BookingFilter) ([]api.BookingResponse, error)
+	CancelBooking(ctx context.Context, id string) (*api.BookingResponse, error)
+	CreateTimeBlock(ctx context.Context, req api.TimeBlockRequest) (*api.TimeBlockResponse, error)
+	// TeacherSlots — слоты преподавателя в [from, to)
+	TeacherSlots(ctx context.Context, teacherID string, from, to time.Time) ([]api.SlotResponse, error)
+	CheckConsistency(ctx context.Context, limit int) ([]*models.ConsistencyIssue, error)
+	Close() error
+}
+
+// slotBatchSize — сколько слотов запрашивать за один GET /slots/batch
+const slotBatchSize = 100
+
+// errDirectOnly — операция доступна только при прямом подключении к базе
+var errDirectOnly = errors.New("not available over HTTP, run without -server to use the database directly")
+
+// directBackend работает с базой и Redis из конфигурации сервиса в пределах одного арендатора
+type directBackend struct {
+	service *svc.Service
+	storage *postgres.Storage
+	locker  *lock.RedisLock
+	tenant  string
+}
+
+func newDirectBackend(tenantID string) (*directBackend, error) {
+	cfg := config.MustLoad()
+
+	tenants, err := tenant.NewResolver(cfg.Tenancy.Default, cfg.Tenancy.Tenants)
+	if err != nil {
+		return nil, fmt.Errorf("tenancy config: %w", err)
+	}
+	resolved, err := tenants.Resolve(tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %q: %w", tenantID, err)
+	}
+
+	storage, err := postgres.New(cfg.StoragePath)
+	if err != nil {
+		return nil, fmt.Errorf("init storage: %w", err)
+	}
+
+	locker, err := lock.NewRedisLock(cfg.RedisAddr)
+	if err != nil {
+		storage.Close()
+		return nil, fmt.Errorf("init redis lock: %w", err)
+	}
+
+	penaltyMode := models.RestrictionMode(cfg.Penalties.Mode)
+	if penaltyMode != models.RestrictionReject && penaltyMode != models.RestrictionRequireConfirmation {
+		storage.Close()
+		locker.Close()
+		return nil, fmt.Errorf("invalid penalties mode %q", cfg.Penalties.Mode)
+	}
+
+	// события пишутся в outbox той же транзакцией; публикует их relay сервиса
+	service := svc.NewService(storage, locker, svc.WithPenaltyPolicy(svc.PenaltyPolicy{
+		Enabled:          cfg.Penalties.Enabled,
+		Threshold:        cfg.Penalties.Threshold,
+		Window:           cfg.Penalties.Window,
+		Cooldown:         cfg.Penalties.Cooldown,
+		LateCancelNotice: cfg.Penalties.LateCancelNotice,
+		Mode:             penaltyMode,
+	}), svc.WithMakeupCreditTTL(cfg.Credits.TTL))
+
+	return &directBackend{service: service, storage: storage, locker: locker, tenant: resolved}, nil
+}
+
+func (b *directBackend) GenerateSlots(ctx context.Context, req api.SlotGenerateRequest) (string, error) {
+	return b.service.GenerateSlots(tenant.WithTenant(ctx, b.tenant), &req)
+}
+
+func (b *directBackend) ListBookings(ctx context.Context, filter client.BookingFilter) ([]api.BookingResponse, error) {
+	list, err := b.service.ListBookings(tenant.WithTenant(ctx, b.tenant), optional(filter.StudentID), optional(filter.TeacherID), filter.From, filter.To, optional(filter.Status), filter.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return values(list), nil
+}
+
+func (b *directBackend) CancelBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
+	return b.service.CancelBooking(tenant.WithTenant(ctx, b.tenant), id)
+}
+
+func (b *directBackend) CreateTimeBlock(ctx context.Context, req api.TimeBlockRequest) (*api.TimeBlockResponse, error) {
+	return b.service.CreateTimeBlock(tenant.WithTenant(ctx, b.tenant), &req)
+}
+
+func (b *directBackend) TeacherSlots(ctx context.Context, teacherID string, from, to time.Time) ([]api.SlotResponse, error) {
+	list, err := b.service.ListSlots(tenant.WithTenant(ctx, b.tenant), &svc.SlotFilters{TeacherID: &teacherID, From: &from, To: &to})
+	if err != nil {
+		return nil, err
+	}
+	return values(list), nil
+}
+
+func (b *directBackend) CheckConsistency(ctx context.Context, limit int) ([]*models.ConsistencyIssue, error) {
+	return b.service.CheckConsistency(tenant.WithTenant(ctx, b.tenant), limit)
+}
+
+func (b *directBackend) Close() error {
+	return errors.Join(b.storage.Close(), b.locker.Close())
+}
+
+// httpBackend работает с запущенным сервером через pkg/client
+type httpBackend struct {
+	client *client.Client
+}
+
+func newHTTPBackend(server, actorID, token, apiKey, tenantID string) (*httpBackend, error) {
+	c, err := client.New(server, client.WithActor(actorID), client.WithToken(token), client.WithAPIKey(apiKey), client.WithTenant(tenantID))
+	if err != nil {
+		return nil, err
+	}
+	return &httpBackend{client: c}, nil
+}
+
+func (b *httpBackend) GenerateSlots(ctx context.Context, req api.SlotGenerateRequest) (string, error) {
+	return b.client.GenerateSlots(ctx, req)
+}
+
+func (b *httpBackend) ListBookings(ctx context.Context, filter client.BookingFilter) ([]api.BookingResponse, error) {
+	return b.client.ListBookings(ctx, filter)
+}
+
+func (b *httpBackend) CancelBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
+	return b.client.CancelBooking(ctx, id)
+}
+
+func (b *httpBackend) CreateTimeBlock(ctx context.Context, req api.TimeBlockRequest) (*api.TimeBlockResponse, error) {
+	return b.client.CreateTimeBlock(ctx, req)
+}
+
+// TeacherSlots: API не отдаёт список слотов, поэтому по HTTP видны только слоты
+// активных броней преподавателя. Фильтр броней по времени идёт по дате создания,
+// так что слоты отбираются по времени урока уже после загрузки.
+func (b *httpBackend) TeacherSlots(ctx context.Context, teacherID string, from, to time.Time) ([]api.SlotResponse, error) {
+	var ids []string
+	for _, status := range []models.BookingStatus{models.BookingPending, models.BookingConfirmed} {
+		bookings, err := b.client.ListBookings(ctx, client.BookingFilter{TeacherID: teacherID, Status: string(status)})
+		if err != nil {
+			return nil, err
+		}
+		for _, booking := range bookings {
+			ids = append(ids, booking.SlotID)
+		}
+	}
+
+	var slots []api.SlotResponse
+	for start := 0; start < len(ids); start += slotBatchSize {
+		batch, err := b.client.BatchGetSlots(ctx, ids[start:min(start+slotBatchSize, len(ids))])
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range batch {
+			if !slot.Start.Before(from) && slot.Start.Before(to) {
+				slots = append(slots, slot)
+			}
+		}
+	}
+
+	return slots, nil
+}
+
+func (b *httpBackend) CheckConsistency(ctx context.Context, limit int) ([]*models.ConsistencyIssue, error) {
+	return nil, errDirectOnly
+}
+
+func (b *httpBackend) Close() error {
+	return nil
+}
+
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func values[T any](list []*T) []T {
+	out := make([]T, len(list))
+	for i, v := range list {
+		out[i] = *v
+	}
+	return out
+}
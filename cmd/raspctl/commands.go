@@ -0,0 +1,335 @@
+package main
+
+import (
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/client"
+	"context"
+	"fmt"
+	"time"
+)
+
+// generate
+
+func runGenerate(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "generate", "(-teacher ID | -template ID) -from DATE -to DATE")
+	teacherID := fs.String("teacher", "", "generate from all enabled templates of the teacher")
+	templateID := fs.String("template", "", "generate from one template")
+	fromStr := fs.String("from", "", "first day, YYYY-MM-DD or RFC3339")
+	toStr := fs.String("to", "", "last day (inclusive), YYYY-MM-DD or RFC3339")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if (*teacherID == "") == (*templateID == "") {
+		return usageError(fs, "exactly one of -teacher or -template is required")
+	}
+	if *fromStr == "" || *toStr == "" {
+		return usageError(fs, "-from and -to are required")
+	}
+	from, err := parseDate(*fromStr, e.loc)
+	if err != nil {
+		return usageError(fs, "-from: %v", err)
+	}
+	to, err := parseDate(*toStr, e.loc)
+	if err != nil {
+		return usageError(fs, "-to: %v", err)
+	}
+	if to.Before(from) {
+		return usageError(fs, "-to is before -from")
+	}
+
+	b, err := e.backend()
+	if err != nil {
+		return err
+	}
+
+	jobID, err := b.GenerateSlots(ctx, api.SlotGenerateRequest{
+		TemplateID: optional(*templateID),
+		TeacherID:  optional(*teacherID),
+		From:       from.Format(time.RFC3339),
+		To:         to.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(e.stdout, "slots generated for %s..%s, job %s\n", from.Format(time.DateOnly), to.Format(time.DateOnly), jobID)
+	return nil
+}
+
+// bookings
+
+func runBookings(ctx context.Context, e *env, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintf(e.stderr, "Usage: raspctl bookings list [flags]\n       raspctl bookings cancel ID...\n")
+		return errUsage
+	}
+
+	switch args[0] {
+	case "list":
+		return runBookingsList(ctx, e, args[1:])
+	case "cancel":
+		return runBookingsCancel(ctx, e, args[1:])
+	}
+
+	fmt.Fprintf(e.stderr, "raspctl bookings: unknown subcommand %q (want list or cancel)\n", args[0])
+	return errUsage
+}
+
+func runBookingsList(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "bookings list", "[flags]")
+	teacherID := fs.String("teacher", "", "teacher id")
+	studentID := fs.String("student", "", "student id")
+	status := fs.String("status", "", "pending, confirmed or cancelled")
+	createdFrom := fs.String("created-from", "", "created at or after, YYYY-MM-DD or RFC3339")
+	createdTo := fs.String("created-to", "", "created at or before, YYYY-MM-DD or RFC3339")
+	deleted := fs.Bool("deleted", false, "include soft-deleted bookings")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	filter := client.BookingFilter{
+		TeacherID:      *teacherID,
+		StudentID:      *studentID,
+		Status:         *status,
+		IncludeDeleted: *deleted,
+	}
+	if *createdFrom != "" {
+		t, err := parseDate(*createdFrom, e.loc)
+		if err != nil {
+			return usageError(fs, "-created-from: %v", err)
+		}
+		filter.From = &t
+	}
+	if *createdTo != "" {
+		t, err := parseDate(*createdTo, e.loc)
+		if err != nil {
+			return usageError(fs, "-created-to: %v", err)
+		}
+		filter.To = &t
+	}
+
+	b, err := e.backend()
+	if err != nil {
+		return err
+	}
+
+	bookings, err := b.ListBookings(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	w := e.table()
+	fmt.Fprintln(w, "ID\tSLOT\tSTUDENT\tTEACHER\tSTATUS\tDELETED")
+	for _, booking := range bookings {
+		deletedAt := "-"
+		if booking.DeletedAt != nil {
+			deletedAt = booking.DeletedAt.In(e.loc).Format(time.DateTime)
+		}
+		status := booking.Status
+		if booking.RequiresTeacherConfirmation && booking.Status == string(models.BookingPending) {
+			status += " (needs teacher)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", booking.ID, booking.SlotID, booking.StudentID, booking.TeacherID, status, deletedAt)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(e.stdout, "%d booking(s)\n", len(bookings))
+	return nil
+}
+
+func runBookingsCancel(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "bookings cancel", "ID...")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return usageError(fs, "at least one booking id is required")
+	}
+
+	b, err := e.backend()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, id := range fs.Args() {
+		booking, err := b.CancelBooking(ctx, id)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "%s: %v\n", id, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(e.stdout, "%s: %s\n", booking.ID, booking.Status)
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(e.stderr, "%d of %d booking(s) not cancelled\n", failed, fs.NArg())
+		return errSilent
+	}
+	return nil
+}
+
+// block
+
+func runBlock(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "block", "-teacher ID[,ID...] -from DATE -to DATE [-start HH:MM -end HH:MM] [flags]")
+	teachers := fs.String("teacher", "", "teacher id, or several separated by commas")
+	fromStr := fs.String("from", "", "first blocked day, YYYY-MM-DD")
+	toStr := fs.String("to", "", "last blocked day (inclusive), YYYY-MM-DD")
+	startStr := fs.String("start", "", "block only from this time of day, HH:MM (one block per day)")
+	endStr := fs.String("end", "", "block only until this time of day, HH:MM")
+	blockType := fs.String("type", string(models.TimeBlockOther), "vacation, sick or other")
+	reason := fs.String("reason", "", "reason shown to the teacher")
+	dryRun := fs.Bool("dry-run", false, "print the blocks without creating them")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	teacherIDs := splitList(*teachers)
+	if len(teacherIDs) == 0 {
+		return usageError(fs, "-teacher is required")
+	}
+	if *fromStr == "" || *toStr == "" {
+		return usageError(fs, "-from and -to are required")
+	}
+	from, err := time.ParseInLocation(time.DateOnly, *fromStr, e.loc)
+	if err != nil {
+		return usageError(fs, "-from must be YYYY-MM-DD")
+	}
+	to, err := time.ParseInLocation(time.DateOnly, *toStr, e.loc)
+	if err != nil {
+		return usageError(fs, "-to must be YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return usageError(fs, "-to is before -from")
+	}
+	switch models.TimeBlockType(*blockType) {
+	case models.TimeBlockVacation, models.TimeBlockSick, models.TimeBlockOther:
+	default:
+		return usageError(fs, "-type must be vacation, sick or other")
+	}
+
+	ranges, err := blockRanges(from, to, *startStr, *endStr)
+	if err != nil {
+		return usageError(fs, "%v", err)
+	}
+
+	var b backend
+	if !*dryRun {
+		if b, err = e.backend(); err != nil {
+			return err
+		}
+	}
+
+	w := e.table()
+	fmt.Fprintln(w, "TEACHER\tSTART\tEND\tBLOCK")
+	failed := 0
+	for _, teacherID := range teacherIDs {
+		for _, r := range ranges {
+			req := api.TimeBlockRequest{
+				TeacherID: teacherID,
+				Start:     r[0].Format(time.RFC3339),
+				End:       r[1].Format(time.RFC3339),
+				Reason:    *reason,
+				Type:      *blockType,
+			}
+
+			result := "dry run"
+			if b != nil {
+				block, err := b.CreateTimeBlock(ctx, req)
+				if err != nil {
+					result = "error: " + err.Error()
+					failed++
+				} else {
+					result = block.ID
+				}
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", teacherID, r[0].Format("2006-01-02 15:04"), r[1].Format("2006-01-02 15:04"), result)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(e.stderr, "%d block(s) not created\n", failed)
+		return errSilent
+	}
+	return nil
+}
+
+// blockRanges — интервалы блокировки дней [from, to]: один на весь диапазон или,
+// если задано время дня, по одному на каждый день
+func blockRanges(from, to time.Time, startStr, endStr string) ([][2]time.Time, error) {
+	if startStr == "" && endStr == "" {
+		return [][2]time.Time{{from, to.AddDate(0, 0, 1)}}, nil
+	}
+	if startStr == "" || endStr == "" {
+		return nil, fmt.Errorf("-start and -end must be set together")
+	}
+
+	start, err := time.Parse("15:04", startStr)
+	if err != nil {
+		return nil, fmt.Errorf("-start must be HH:MM")
+	}
+	end, err := time.Parse("15:04", endStr)
+	if err != nil {
+		return nil, fmt.Errorf("-end must be HH:MM")
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("-end must be after -start")
+	}
+
+	var ranges [][2]time.Time
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		ranges = append(ranges, [2]time.Time{
+			time.Date(d.Year(), d.Month(), d.Day(), start.Hour(), start.Minute(), 0, 0, d.Location()),
+			time.Date(d.Year(), d.Month(), d.Day(), end.Hour(), end.Minute(), 0, 0, d.Location()),
+		})
+	}
+	return ranges, nil
+}
+
+// check
+
+func runCheck(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "check", "[-limit N]")
+	limit := fs.Int("limit", 100, "max issues reported per check")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *limit <= 0 {
+		return usageError(fs, "-limit must be positive")
+	}
+
+	b, err := e.backend()
+	if err != nil {
+		return err
+	}
+
+	issues, err := b.CheckConsistency(ctx, *limit)
+	if err != nil {
+		return err
+	}
+
+	if len(issues) == 0 {
+		fmt.Fprintln(e.stdout, "no issues found")
+		return nil
+	}
+
+	w := e.table()
+	fmt.Fprintln(w, "CHECK\tID\tTEACHER\tDETAIL")
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", issue.Check, issue.EntityID, issue.TeacherID, issue.Detail)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(e.stderr, "%d issue(s) found\n", len(issues))
+	return errSilent
+}
@@ -0,0 +1,225 @@
+// raspctl — утилита администратора расписания: генерация слотов, просмотр и отмена
+// броней, массовая блокировка дат, проверки целостности и недельная сетка
+// преподавателя. Без -server работает с базой напрямую по конфигурации сервиса,
+// с -server — с запущенным сервером по HTTP.
+package main
+
+import (
+	"rasp-service/pkg/middleware/actor"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+)
+
+const defaultActor = "raspctl"
+
+// errUsage — неверные аргументы; подсказка уже выведена
+var errUsage = errors.New("usage")
+
+// errSilent — команда завершилась неудачно и сама сообщила почему
+var errSilent = errors.New("failed")
+
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, e *env, args []string) error
+}
+
+var commands = []command{
+	{"generate", "generate slots from a teacher's templates or from one template", runGenerate},
+	{"bookings", "list or cancel bookings", runBookings},
+	{"block", "block a date range for one or more teachers", runBlock},
+	{"check", "run consistency checks (database mode only)", runCheck},
+	{"week", "print a teacher's week as a table", runWeek},
+	{"token", "issue an HS256 access token signed with the service config secret", runToken},
+}
+
+// env — общие параметры и ленивое подключение к бэкенду
+type env struct {
+	server string
+	actor  string
+	token  string
+	apiKey string
+	tenant string
+	loc    *time.Location
+	stdout io.Writer
+	stderr io.Writer
+
+	b backend
+}
+
+func (e *env) backend() (backend, error) {
+	if e.b != nil {
+		return e.b, nil
+	}
+
+	var err error
+	if e.server != "" {
+		e.b, err = newHTTPBackend(e.server, e.actor, e.token, e.apiKey, e.tenant)
+	} else {
+		e.b, err = newDirectBackend(e.tenant)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e.b, nil
+}
+
+func (e *env) remote() bool {
+	return e.server != ""
+}
+
+func (e *env) table() *tabwriter.Writer {
+	return tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("raspctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	server := fs.String("server", os.Getenv("RASPCTL_SERVER"), "base URL of a running server; empty — use the database from the service config")
+	token := fs.String("token", os.Getenv("RASPCTL_TOKEN"), "bearer token for -server (see 'raspctl token')")
+	apiKey := fs.String("api-key", os.Getenv("RASPCTL_API_KEY"), "API key for -server, used instead of -token")
+	actorID := fs.String("actor", defaultActor, "actor recorded in booking history (X-Actor-ID)")
+	tenantID := fs.String("tenant", os.Getenv("RASPCTL_TENANT"), "tenant (school) to operate on; empty — the default tenant")
+	timeout := fs.Duration("timeout", time.Minute, "overall command timeout")
+	tz := fs.String("tz", "Local", "time zone for dates and tables (IANA name)")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: raspctl [flags] <command> [command flags]\n\nCommands:\n")
+		for _, c := range commands {
+			fmt.Fprintf(stderr, "  %-10s %s\n", c.name, c.summary)
+		}
+		fmt.Fprintf(stderr, "\nFlags:\n")
+		fs.PrintDefaults()
+		fmt.Fprintf(stderr, "\nRun 'raspctl <command> -h' for command flags.\n")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitCode(err)
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		fmt.Fprintf(stderr, "raspctl: invalid -tz: %v\n", err)
+		return 2
+	}
+
+	name := fs.Arg(0)
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == name {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "raspctl: unknown command %q\n\n", name)
+		fs.Usage()
+		return 2
+	}
+
+	e := &env{
+		server: strings.TrimSpace(*server),
+		actor:  *actorID,
+		token:  strings.TrimSpace(*token),
+		apiKey: strings.TrimSpace(*apiKey),
+		tenant: strings.TrimSpace(*tenantID),
+		loc:    loc,
+		stdout: stdout,
+		stderr: stderr,
+	}
+	defer func() {
+		if e.b != nil {
+			e.b.Close()
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	ctx = actor.WithActor(ctx, *actorID)
+
+	err = cmd.run(ctx, e, fs.Args()[1:])
+	if err != nil && !errors.Is(err, errUsage) && !errors.Is(err, errSilent) && !errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintf(stderr, "raspctl %s: %v\n", name, err)
+	}
+	return exitCode(err)
+}
+
+func exitCode(err error) int {
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// newFlagSet — флаги подкоманды; ошибки разбора печатаются вместе с подсказкой
+func newFlagSet(e *env, name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet("raspctl "+name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: raspctl %s %s\n\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags разбирает флаги подкоманды; ошибка разбора — errUsage
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	return nil
+}
+
+// usageError печатает ошибку аргументов с подсказкой подкоманды
+func usageError(fs *flag.FlagSet, format string, args ...any) error {
+	fmt.Fprintf(fs.Output(), "%s: %s\n\n", fs.Name(), fmt.Sprintf(format, args...))
+	fs.Usage()
+	return errUsage
+}
+
+// parseDate принимает YYYY-MM-DD (полночь в loc) или RFC3339
+func parseDate(v string, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", v, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither YYYY-MM-DD nor RFC3339", v)
+	}
+	return t.In(loc), nil
+}
+
+// splitList разбирает список через запятую без пустых элементов
+func splitList(v string) []string {
+	var out []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
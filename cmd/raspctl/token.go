@@ -0,0 +1,77 @@
+package main
+
+import (
+	"rasp-service/internal/config"
+	"rasp-service/pkg/jwt"
+	"rasp-service/pkg/middleware/auth"
+	"rasp-service/pkg/middleware/tenant"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// token
+
+func runToken(_ context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "token", "-sub ID -role admin|teacher|student [-ttl DURATION] [-tenant ID]")
+	subject := fs.String("sub", "", "user id (teacher_id or student_id for those roles)")
+	role := fs.String("role", "", "admin, teacher or student")
+	ttl := fs.Duration("ttl", time.Hour, "token lifetime")
+	tenantID := fs.String("tenant", e.tenant, "tenant claim; empty — the default tenant from the service config")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *subject == "" {
+		return usageError(fs, "-sub is required")
+	}
+	switch auth.Role(*role) {
+	case auth.RoleAdmin, auth.RoleTeacher, auth.RoleStudent:
+	default:
+		return usageError(fs, "-role must be admin, teacher or student")
+	}
+	if *ttl <= 0 {
+		return usageError(fs, "-ttl must be positive")
+	}
+
+	cfg := config.MustLoad()
+	if cfg.Auth.Secret == "" {
+		return errors.New("auth.secret is empty in the service config: tokens are issued by the RS256 key owner")
+	}
+	if cfg.Auth.MaxAge > 0 && *ttl > cfg.Auth.MaxAge {
+		return usageError(fs, "-ttl must not exceed auth.max_age (%s)", cfg.Auth.MaxAge)
+	}
+
+	tenants, err := tenant.NewResolver(cfg.Tenancy.Default, cfg.Tenancy.Tenants)
+	if err != nil {
+		return fmt.Errorf("tenancy config: %w", err)
+	}
+	claimTenant, err := tenants.Resolve(*tenantID)
+	if err != nil {
+		return usageError(fs, "-tenant %q: %v", *tenantID, err)
+	}
+
+	now := time.Now()
+	claims := map[string]any{
+		"sub":  *subject,
+		"iat":  now.Unix(),
+		"exp":  now.Add(*ttl).Unix(),
+		cfg.Auth.RoleClaim: *role,
+	}
+	// токен всегда несёт арендатора: без него сервер с несколькими школами его не примет
+	claims[cfg.Auth.TenantClaim] = claimTenant
+	if cfg.Auth.Issuer != "" {
+		claims["iss"] = cfg.Auth.Issuer
+	}
+	if cfg.Auth.Audience != "" {
+		claims["aud"] = cfg.Auth.Audience
+	}
+
+	token, err := jwt.SignHS256(claims, []byte(cfg.Auth.Secret))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(e.stdout, token)
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/client"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// week
+
+func runWeek(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "week", "-teacher ID [-date DATE]")
+	teacherID := fs.String("teacher", "", "teacher id")
+	dateStr := fs.String("date", "", "any day of the week, YYYY-MM-DD (default: today)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *teacherID == "" {
+		return usageError(fs, "-teacher is required")
+	}
+
+	day := time.Now().In(e.loc)
+	if *dateStr != "" {
+		d, err := time.ParseInLocation(time.DateOnly, *dateStr, e.loc)
+		if err != nil {
+			return usageError(fs, "-date must be YYYY-MM-DD")
+		}
+		day = d
+	}
+	monday := weekStart(day)
+	next := monday.AddDate(0, 0, 7)
+
+	b, err := e.backend()
+	if err != nil {
+		return err
+	}
+
+	slots, err := b.TeacherSlots(ctx, *teacherID, monday, next)
+	if err != nil {
+		return err
+	}
+
+	students, err := weekStudents(ctx, b, *teacherID, slots)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(e.stdout, "Teacher %s, week %s - %s (%s)\n", *teacherID, monday.Format(time.DateOnly), next.AddDate(0, 0, -1).Format(time.DateOnly), e.loc)
+	if e.remote() {
+		fmt.Fprintln(e.stdout, "Over HTTP only booked slots are shown: the API has no slot listing.")
+	}
+	fmt.Fprintln(e.stdout)
+
+	if len(slots) == 0 {
+		fmt.Fprintln(e.stdout, "no slots")
+		return nil
+	}
+
+	return printWeek(e, monday, slots, students)
+}
+
+// weekStart — понедельник недели day, полночь
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	d := day.AddDate(0, 0, -offset)
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+}
+
+// weekStudents сопоставляет занятые слоты со студентами активных броней преподавателя
+func weekStudents(ctx context.Context, b backend, teacherID string, slots []api.SlotResponse) (map[string]api.BookingResponse, error) {
+	booked := false
+	for _, slot := range slots {
+		if slot.BookingID != nil {
+			booked = true
+			break
+		}
+	}
+	if !booked {
+		return nil, nil
+	}
+
+	bookings, err := b.ListBookings(ctx, client.BookingFilter{TeacherID: teacherID})
+	if err != nil {
+		return nil, err
+	}
+
+	bySlot := make(map[string]api.BookingResponse, len(bookings))
+	for _, booking := range bookings {
+		if booking.Status != string(models.BookingCancelled) {
+			bySlot[booking.SlotID] = booking
+		}
+	}
+	return bySlot, nil
+}
+
+// printWeek печатает сетку: строки — интервалы времени, колонки — дни недели
+func printWeek(e *env, monday time.Time, slots []api.SlotResponse, students map[string]api.BookingResponse) error {
+	type cellKey struct {
+		row string
+		day int
+	}
+
+	cells := map[cellKey][]string{}
+	rows := map[string]time.Duration{}
+	for _, slot := range slots {
+		start, end := slot.Start.In(e.loc), slot.End.In(e.loc)
+		row := start.Format("15:04") + "-" + end.Format("15:04")
+		rows[row] = start.Sub(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, e.loc))
+
+		day := int(start.Sub(monday).Hours() / 24)
+		if day < 0 || day > 6 {
+			continue
+		}
+		key := cellKey{row, day}
+		cells[key] = append(cells[key], slotCell(slot, students))
+	}
+
+	order := make([]string, 0, len(rows))
+	for row := range rows {
+		order = append(order, row)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		if rows[order[i]] != rows[order[j]] {
+			return rows[order[i]] < rows[order[j]]
+		}
+		return order[i] < order[j]
+	})
+
+	w := e.table()
+	header := []string{"TIME"}
+	for d := 0; d < 7; d++ {
+		header = append(header, monday.AddDate(0, 0, d).Format("Mon 02.01"))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, row := range order {
+		line := []string{row}
+		for d := 0; d < 7; d++ {
+			cell := "."
+			if c := cells[cellKey{row, d}]; len(c) > 0 {
+				cell = strings.Join(c, ", ")
+			}
+			line = append(line, cell)
+		}
+		fmt.Fprintln(w, strings.Join(line, "\t"))
+	}
+
+	return w.Flush()
+}
+
+// slotCell — содержимое ячейки: студент для занятого слота, иначе статус
+func slotCell(slot api.SlotResponse, students map[string]api.BookingResponse) string {
+	if slot.Status != string(models.SlotBooked) {
+		return slot.Status
+	}
+
+	booking, ok := students[slot.ID]
+	if !ok {
+		return "booked"
+	}
+	if booking.Status == string(models.BookingPending) {
+		return booking.StudentID + " (pending)"
+	}
+	return booking.StudentID
+}
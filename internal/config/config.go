@@ -12,6 +12,17 @@ type Config struct {
 	StoragePath string `yaml:"storage_path" env-required:"true"`
 	RedisAddr   string `yaml:"redis_addr" env-default:"localhost:6379"`
 	HTTPServer  `yaml:"http_server"`
+	NoShow      `yaml:"no_show"`
+	Penalties   `yaml:"penalties"`
+	Credits     `yaml:"credits"`
+	Webhooks    `yaml:"webhooks"`
+	Outbox      `yaml:"outbox"`
+	SlotStream  `yaml:"slot_stream"`
+	Reminders   `yaml:"reminders"`
+	GRPC        `yaml:"grpc"`
+	OpenAPI     `yaml:"openapi"`
+	Auth        `yaml:"auth"`
+	Tenancy     `yaml:"tenancy"`
 }
 
 type HTTPServer struct {
@@ -21,6 +32,136 @@ type HTTPServer struct {
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env-default:"15s"`
 }
 
+// NoShow — автоотметка неявок по закончившимся урокам
+type NoShow struct {
+	Enabled     bool          `yaml:"enabled" env-default:"true"`
+	Interval    time.Duration `yaml:"interval" env-default:"1m"`
+	GracePeriod time.Duration `yaml:"grace_period" env-default:"30m"`
+	BatchSize   int           `yaml:"batch_size" env-default:"100"`
+}
+
+// Penalties — ограничение записи после серии неявок и поздних отмен
+type Penalties struct {
+	Enabled          bool          `yaml:"enabled" env-default:"false"`
+	Threshold        int           `yaml:"threshold" env-default:"3"`
+	Window           time.Duration `yaml:"window" env-default:"720h"`
+	Cooldown         time.Duration `yaml:"cooldown" env-default:"336h"`
+	LateCancelNotice time.Duration `yaml:"late_cancel_notice" env-default:"24h"`
+	// reject или require_confirmation
+	Mode string `yaml:"mode" env-default:"reject"`
+}
+
+// Credits — кредиты на отработку за уважительные неявки и отмены преподавателем
+type Credits struct {
+	TTL time.Duration `yaml:"ttl" env-default:"720h"`
+}
+
+// Webhooks — доставка исходящих вебхуков с повторами по экспоненте
+type Webhooks struct {
+	Enabled     bool          `yaml:"enabled" env-default:"true"`
+	Interval    time.Duration `yaml:"interval" env-default:"5s"`
+	BatchSize   int           `yaml:"batch_size" env-default:"50"`
+	Timeout     time.Duration `yaml:"timeout" env-default:"10s"`
+	MaxAttempts int           `yaml:"max_attempts" env-default:"8"`
+	BackoffBase time.Duration `yaml:"backoff_base" env-default:"30s"`
+	BackoffMax  time.Duration `yaml:"backoff_max" env-default:"6h"`
+}
+
+// Outbox — релей доменных событий из таблицы outbox
+type Outbox struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Interval  time.Duration `yaml:"interval" env-default:"1s"`
+	BatchSize int           `yaml:"batch_size" env-default:"100"`
+	Retention time.Duration `yaml:"retention" env-default:"168h"`
+	// log, webhook, redis
+	Publishers  []string `yaml:"publishers" env-default:"webhook"`
+	RedisStream string   `yaml:"redis_stream" env-default:"rasp:events"`
+	RedisMaxLen int64    `yaml:"redis_max_len" env-default:"100000"`
+}
+
+// SlotStream — живая лента слотов GET /slots/stream
+type SlotStream struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Heartbeat time.Duration `yaml:"heartbeat" env-default:"15s"`
+	// примерное число событий на преподавателя, доступных для продолжения по Last-Event-ID
+	History int64 `yaml:"history" env-default:"1000"`
+}
+
+// Reminders — напоминания об уроках по подтверждённым броням
+type Reminders struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Interval  time.Duration `yaml:"interval" env-default:"30s"`
+	BatchSize int           `yaml:"batch_size" env-default:"100"`
+	// за сколько до начала урока напоминать
+	Offsets []time.Duration `yaml:"offsets" env-default:"24h,1h"`
+	// student, teacher
+	Recipients  []string      `yaml:"recipients" env-default:"student,teacher"`
+	MaxAttempts int           `yaml:"max_attempts" env-default:"5"`
+	RetryDelay  time.Duration `yaml:"retry_delay" env-default:"5m"`
+	Timeout     time.Duration `yaml:"timeout" env-default:"30s"`
+	// часовой пояс времени урока в тексте напоминания
+	Timezone string `yaml:"timezone" env-default:"UTC"`
+	// шаблоны text/template; пустые — шаблоны по умолчанию
+	Subject string `yaml:"subject"`
+	Body    string `yaml:"body"`
+	// log, smtp или http
+	Provider string       `yaml:"provider" env-default:"log"`
+	SMTP     ReminderSMTP `yaml:"smtp"`
+	HTTP     ReminderHTTP `yaml:"http"`
+}
+
+type ReminderSMTP struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port" env-default:"587"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password" env:"REMINDERS_SMTP_PASSWORD"`
+	From     string `yaml:"from"`
+	// адрес получателя из его id, например "{{.RecipientID}}@school.example"
+	AddressTemplate string `yaml:"address_template"`
+}
+
+type ReminderHTTP struct {
+	URL     string        `yaml:"url"`
+	Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+}
+
+// GRPC — gRPC API на отдельном порту; останавливается вместе с HTTP-сервером
+type GRPC struct {
+	Enabled bool   `yaml:"enabled" env-default:"false"`
+	Address string `yaml:"address" env-default:"localhost:9090"`
+}
+
+// OpenAPI — проверка запросов по api/openapi.yml. ValidateResponses сверяет
+// и ответы, расхождения пишутся в лог; режим для разработки
+type OpenAPI struct {
+	ValidateRequests  bool `yaml:"validate_requests" env-default:"true"`
+	ValidateResponses bool `yaml:"validate_responses" env-default:"false"`
+}
+
+// Auth — bearer-токены JWT: HS256 с общим секретом и/или RS256 с ключами из
+// локального JWKS-файла. Роль (admin, teacher, student) — в поле RoleClaim.
+type Auth struct {
+	Enabled  bool   `yaml:"enabled" env-default:"true"`
+	Secret   string `yaml:"secret" env:"AUTH_SECRET"`
+	JWKSFile string `yaml:"jwks_file" env:"AUTH_JWKS_FILE"`
+	// пустые iss и aud не проверяются
+	Issuer    string        `yaml:"issuer"`
+	Audience  string        `yaml:"audience"`
+	Leeway    time.Duration `yaml:"leeway" env-default:"30s"`
+	// токен без exp отклоняется всегда; max_age ограничивает exp − iat, 0 — без ограничения
+	MaxAge    time.Duration `yaml:"max_age" env-default:"0s"`
+	RoleClaim string        `yaml:"role_claim" env-default:"role"`
+	// арендатор токена; при нескольких арендаторах токен без этого поля отклоняется
+	TenantClaim string `yaml:"tenant_claim" env-default:"tenant"`
+}
+
+// Tenancy — школы одного развёртывания. Арендатор запроса — из заголовка X-Tenant-ID
+// или токена; пустой Default делает заголовок обязательным.
+type Tenancy struct {
+	Default string   `yaml:"default" env-default:"default"`
+	Tenants []string `yaml:"tenants" env-default:"default"`
+}
+
 func MustLoad() *Config {
 	var cfg Config
 
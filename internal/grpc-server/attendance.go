@@ -0,0 +1,204 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"rasp-service/api"
+	raspv1 "rasp-service/api/proto/rasp/v1"
+	"rasp-service/pkg/response"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Attendance
+
+func (s *server) CreateAttendance(ctx context.Context, req *raspv1.CreateAttendanceRequest) (*raspv1.CreateAttendanceResponse, error) {
+	const op = "grpcserver.CreateAttendance"
+
+	in := attendanceRequest(req.GetAttendance())
+	if err := required("attendance.booking_id", in.BookingID, "attendance.status", in.Status); err != nil {
+		return nil, err
+	}
+
+	var attendance *api.AttendanceResponse
+	var err error
+	created := true
+	if req.GetUpsert() {
+		attendance, created, err = s.svc.UpsertAttendance(ctx, in)
+	} else {
+		attendance, err = s.svc.CreateAttendance(ctx, in)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &raspv1.CreateAttendanceResponse{Attendance: attendanceToPb(attendance), Created: created}, nil
+}
+
+func (s *server) GetAttendance(ctx context.Context, req *raspv1.GetAttendanceRequest) (*raspv1.Attendance, error) {
+	const op = "grpcserver.GetAttendance"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	attendance, err := s.svc.GetAttendance(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return attendanceToPb(attendance), nil
+}
+
+func (s *server) ListAttendance(ctx context.Context, req *raspv1.ListAttendanceRequest) (*raspv1.ListAttendanceResponse, error) {
+	const op = "grpcserver.ListAttendance"
+
+	attendances, err := s.svc.ListAttendance(ctx, req.TeacherId, timeOrNil(req.GetFrom()), timeOrNil(req.GetTo()))
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.ListAttendanceResponse{Attendance: make([]*raspv1.Attendance, 0, len(attendances))}
+	for _, attendance := range attendances {
+		resp.Attendance = append(resp.Attendance, attendanceToPb(attendance))
+	}
+
+	return resp, nil
+}
+
+func (s *server) UpdateAttendance(ctx context.Context, req *raspv1.UpdateAttendanceRequest) (*raspv1.Attendance, error) {
+	const op = "grpcserver.UpdateAttendance"
+
+	if err := required("id", req.GetId(), "status", req.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	attendance, err := s.svc.UpdateAttendance(ctx, req.GetId(), &api.AttendanceUpdateRequest{
+		Status:  req.GetStatus(),
+		Notes:   req.GetNotes(),
+		Excused: req.GetExcused(),
+	})
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return attendanceToPb(attendance), nil
+}
+
+func (s *server) PatchAttendance(ctx context.Context, req *raspv1.PatchAttendanceRequest) (*raspv1.Attendance, error) {
+	const op = "grpcserver.PatchAttendance"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	if req.Status == nil && req.Notes == nil && req.Excused == nil {
+		return nil, invalidArgument("status", "status, notes or excused is required")
+	}
+
+	attendance, err := s.svc.PatchAttendance(ctx, req.GetId(), &api.AttendancePatchRequest{
+		Status:  req.Status,
+		Notes:   req.Notes,
+		Excused: req.Excused,
+	})
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return attendanceToPb(attendance), nil
+}
+
+func (s *server) DeleteAttendance(ctx context.Context, req *raspv1.DeleteAttendanceRequest) (*emptypb.Empty, error) {
+	const op = "grpcserver.DeleteAttendance"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteAttendance(ctx, req.GetId()); err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// BulkAttendance при невалидных пунктах ничего не записывает и возвращает
+// InvalidArgument с нарушениями по каждому пункту
+func (s *server) BulkAttendance(ctx context.Context, req *raspv1.BulkAttendanceRequest) (*raspv1.BulkAttendanceResponse, error) {
+	const op = "grpcserver.BulkAttendance"
+
+	if len(req.GetItems()) == 0 && !req.GetMarkRestPresent() {
+		return nil, invalidArgument("items", "items or mark_rest_present is required")
+	}
+	if req.GetMarkRestPresent() {
+		if err := required("teacher_id", req.GetTeacherId(), "date", req.GetDate()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetDate() != "" {
+		if _, err := time.Parse("2006-01-02", req.GetDate()); err != nil {
+			return nil, invalidArgument("date", "must be in YYYY-MM-DD format")
+		}
+	}
+
+	in := &api.AttendanceBulkRequest{
+		TeacherID:       req.GetTeacherId(),
+		Date:            req.GetDate(),
+		Items:           make([]api.AttendanceRequest, 0, len(req.GetItems())),
+		MarkRestPresent: req.GetMarkRestPresent(),
+	}
+	for _, item := range req.GetItems() {
+		in.Items = append(in.Items, *attendanceRequest(item))
+	}
+
+	result, err := s.svc.BulkAttendance(ctx, in)
+	if errors.Is(err, response.ErrBulkValidation) {
+		return nil, bulkStatus(result)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.BulkAttendanceResponse{
+		Results:       make([]*raspv1.BulkAttendanceItemResult, 0, len(result.Results)),
+		MarkedPresent: make([]*raspv1.Attendance, 0, len(result.MarkedPresent)),
+	}
+	for _, item := range result.Results {
+		r := &raspv1.BulkAttendanceItemResult{
+			BookingId:    item.BookingID,
+			AttendanceId: item.AttendanceID,
+			Status:       item.Status,
+			Created:      item.Created,
+		}
+		if item.Error != nil {
+			r.Error = &raspv1.BulkAttendanceItemError{Code: item.Error.Code, Message: item.Error.Message}
+		}
+		resp.Results = append(resp.Results, r)
+	}
+	for i := range result.MarkedPresent {
+		resp.MarkedPresent = append(resp.MarkedPresent, attendanceToPb(&result.MarkedPresent[i]))
+	}
+
+	return resp, nil
+}
+
+func attendanceRequest(in *raspv1.AttendanceInput) *api.AttendanceRequest {
+	return &api.AttendanceRequest{
+		BookingID: in.GetBookingId(),
+		Status:    in.GetStatus(),
+		Notes:     in.GetNotes(),
+		Excused:   in.GetExcused(),
+	}
+}
+
+func attendanceToPb(a *api.AttendanceResponse) *raspv1.Attendance {
+	return &raspv1.Attendance{
+		Id:          a.ID,
+		BookingId:   a.BookingID,
+		Status:      a.Status,
+		Notes:       a.Notes,
+		AutoMarked:  a.AutoMarked,
+		CheckedInAt: timestampPtr(a.CheckedInAt),
+		Excused:     a.Excused,
+	}
+}
@@ -0,0 +1,102 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/middleware/apikey"
+	"rasp-service/pkg/middleware/auth"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/response"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorizationKey — метаданные с bearer-токеном, аналог заголовка Authorization
+	AuthorizationKey = "authorization"
+	// APIKeyKey — метаданные с API-ключом, аналог заголовка X-API-Key
+	APIKeyKey = "x-api-key"
+	// TenantKey — метаданные с арендатором, аналог заголовка X-Tenant-ID
+	TenantKey = "x-tenant-id"
+)
+
+// WithTenants кладёт в контекст арендатора из метаданных x-tenant-id, как tenant.New
+// в REST. Опция должна идти первой: API-ключ ищется среди ключей арендатора.
+func WithTenants(r *tenant.Resolver) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(resolveTenant(r))
+}
+
+func resolveTenant(r *tenant.Resolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requested := incoming(ctx, TenantKey)
+
+		id, err := r.Resolve(requested)
+		if err != nil {
+			return nil, withInfo(status.New(codes.InvalidArgument, err.Error()), response.UNKNOWN_TENANT, nil)
+		}
+
+		ctx = tenant.WithTenant(ctx, id)
+		if requested != "" {
+			ctx = tenant.WithRequested(ctx, id)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// WithAuth требует bearer-токен у каждого вызова, как auth.New в REST. Перехватчик
+// встаёт после requestContext, поэтому инициатором становится subject токена.
+func WithAuth(a *auth.Authenticator) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(authenticate(a))
+}
+
+// WithAPIKeys принимает API-ключ из метаданных x-api-key, как apikey.New в REST.
+// Опция должна идти раньше WithAuth: вызов с ключом токен уже не проверяет.
+func WithAPIKeys(keys apikey.Authenticator) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(authenticateAPIKey(keys))
+}
+
+func authenticateAPIKey(keys apikey.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := incoming(ctx, APIKeyKey)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		p, err := keys.AuthenticateAPIKey(ctx, key)
+		if err != nil {
+			if errors.Is(err, response.ErrUnauthorized) {
+				return nil, withInfo(status.New(codes.Unauthenticated, "invalid api key"), response.UNAUTHORIZED, nil)
+			}
+			return nil, status.Error(codes.Internal, "failed to check api key")
+		}
+
+		ctx = auth.WithPrincipal(ctx, p)
+		ctx = actor.WithActor(ctx, p.Subject)
+		return handler(ctx, req)
+	}
+}
+
+func authenticate(a *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := auth.FromContext(ctx); ok {
+			return handler(ctx, req)
+		}
+
+		p, err := a.Authenticate(incoming(ctx, AuthorizationKey))
+		if err != nil {
+			return nil, withInfo(status.New(codes.Unauthenticated, err.Error()), response.UNAUTHORIZED, nil)
+		}
+
+		ctx, err = auth.BindTenant(ctx, p)
+		if err != nil {
+			return nil, withInfo(status.New(codes.PermissionDenied, "token belongs to another tenant"), response.FORBIDDEN, nil)
+		}
+
+		ctx = auth.WithPrincipal(ctx, p)
+		ctx = actor.WithActor(ctx, p.Subject)
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,270 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"rasp-service/api"
+	raspv1 "rasp-service/api/proto/rasp/v1"
+	"rasp-service/internal/service"
+	"rasp-service/pkg/response"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Bookings
+
+// CreateBooking при занятом слоте, как и REST, подбирает альтернативы: их id
+// передаются через запятую в ErrorInfo.Metadata["alternatives"]
+func (s *server) CreateBooking(ctx context.Context, req *raspv1.CreateBookingRequest) (*raspv1.Booking, error) {
+	const op = "grpcserver.CreateBooking"
+
+	if err := required("slot_id", req.GetSlotId(), "student_id", req.GetStudentId()); err != nil {
+		return nil, err
+	}
+
+	var idempotencyKey *string
+	if key := incoming(ctx, IdempotencyKeyKey); key != "" {
+		idempotencyKey = &key
+	}
+
+	booking, err := s.svc.CreateBooking(ctx, &api.BookingRequest{
+		SlotID:    req.GetSlotId(),
+		StudentID: req.GetStudentId(),
+		CreditID:  req.CreditId,
+	}, idempotencyKey)
+
+	if errors.Is(err, response.ErrSlotNotAvailable) {
+		return nil, s.slotNotAvailable(ctx, req.GetSlotId())
+	}
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (s *server) slotNotAvailable(ctx context.Context, slotID string) error {
+	var ids []string
+	alternatives, err := s.svc.SuggestAlternativeSlots(ctx, slotID, service.DefaultAlternativeSlots)
+	if err == nil {
+		for _, slot := range alternatives {
+			ids = append(ids, slot.ID)
+		}
+	}
+
+	st, _ := status.FromError(toStatus(response.ErrSlotNotAvailable))
+	detailed, err := status.New(st.Code(), st.Message()).WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(response.SLOT_NOT_AVAILABLE),
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"alternatives": strings.Join(ids, ",")},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (s *server) GetBooking(ctx context.Context, req *raspv1.GetBookingRequest) (*raspv1.Booking, error) {
+	const op = "grpcserver.GetBooking"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.svc.GetBooking(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (s *server) ListBookings(ctx context.Context, req *raspv1.ListBookingsRequest) (*raspv1.ListBookingsResponse, error) {
+	const op = "grpcserver.ListBookings"
+
+	bookings, err := s.svc.ListBookings(ctx,
+		req.StudentId,
+		req.TeacherId,
+		timeOrNil(req.GetFrom()),
+		timeOrNil(req.GetTo()),
+		req.Status,
+		req.GetIncludeDeleted(),
+	)
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.ListBookingsResponse{Bookings: make([]*raspv1.Booking, 0, len(bookings))}
+	for _, booking := range bookings {
+		resp.Bookings = append(resp.Bookings, bookingToPb(booking))
+	}
+
+	return resp, nil
+}
+
+func (s *server) CancelBooking(ctx context.Context, req *raspv1.CancelBookingRequest) (*raspv1.Booking, error) {
+	const op = "grpcserver.CancelBooking"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.svc.CancelBooking(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (s *server) ConfirmBooking(ctx context.Context, req *raspv1.ConfirmBookingRequest) (*raspv1.Booking, error) {
+	const op = "grpcserver.ConfirmBooking"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.svc.ConfirmBooking(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (s *server) RescheduleBooking(ctx context.Context, req *raspv1.RescheduleBookingRequest) (*raspv1.Booking, error) {
+	const op = "grpcserver.RescheduleBooking"
+
+	if err := required("booking_id", req.GetBookingId(), "new_slot_id", req.GetNewSlotId()); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.svc.RescheduleBooking(ctx, req.GetBookingId(), req.GetNewSlotId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (s *server) DeleteBooking(ctx context.Context, req *raspv1.DeleteBookingRequest) (*emptypb.Empty, error) {
+	const op = "grpcserver.DeleteBooking"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteBooking(ctx, req.GetId()); err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) RestoreBooking(ctx context.Context, req *raspv1.RestoreBookingRequest) (*raspv1.Booking, error) {
+	const op = "grpcserver.RestoreBooking"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.svc.RestoreBooking(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (s *server) GetBookingHistory(ctx context.Context, req *raspv1.GetBookingHistoryRequest) (*raspv1.GetBookingHistoryResponse, error) {
+	const op = "grpcserver.GetBookingHistory"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	events, err := s.svc.GetBookingHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.GetBookingHistoryResponse{Events: make([]*raspv1.BookingEvent, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &raspv1.BookingEvent{
+			Id:         e.ID,
+			BookingId:  e.BookingID,
+			Type:       e.Type,
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			FromSlotId: e.FromSlotID,
+			ToSlotId:   e.ToSlotID,
+			Actor:      e.Actor,
+			RequestId:  e.RequestID,
+			CreatedAt:  timestamp(e.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *server) ListBookingReminders(ctx context.Context, req *raspv1.ListBookingRemindersRequest) (*raspv1.ListBookingRemindersResponse, error) {
+	const op = "grpcserver.ListBookingReminders"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	reminders, err := s.svc.ListBookingReminders(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.ListBookingRemindersResponse{Reminders: make([]*raspv1.Reminder, 0, len(reminders))}
+	for _, r := range reminders {
+		resp.Reminders = append(resp.Reminders, &raspv1.Reminder{
+			Id:            r.ID,
+			BookingId:     r.BookingID,
+			RecipientRole: r.RecipientRole,
+			RecipientId:   r.RecipientID,
+			OffsetMinutes: int32(r.OffsetMinutes),
+			LessonStart:   timestamp(r.LessonStart),
+			SendAt:        timestamp(r.SendAt),
+			Status:        r.Status,
+			Attempts:      int32(r.Attempts),
+			LastError:     r.LastError,
+			SentAt:        timestampPtr(r.SentAt),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *server) CheckIn(ctx context.Context, req *raspv1.CheckInRequest) (*raspv1.Attendance, error) {
+	const op = "grpcserver.CheckIn"
+
+	if err := required("booking_id", req.GetBookingId(), "code", req.GetCode()); err != nil {
+		return nil, err
+	}
+
+	attendance, err := s.svc.CheckIn(ctx, req.GetBookingId(), req.GetCode())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return attendanceToPb(attendance), nil
+}
+
+func bookingToPb(b *api.BookingResponse) *raspv1.Booking {
+	return &raspv1.Booking{
+		Id:                          b.ID,
+		SlotId:                      b.SlotID,
+		StudentId:                   b.StudentID,
+		TeacherId:                   b.TeacherID,
+		Status:                      b.Status,
+		RequiresTeacherConfirmation: b.RequiresTeacherConfirmation,
+		DeletedAt:                   timestampPtr(b.DeletedAt),
+	}
+}
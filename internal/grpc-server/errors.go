@@ -0,0 +1,176 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain — домен google.rpc.ErrorInfo; Reason в нём — код ошибки REST API (response.ErrCode)
+const ErrorDomain = "rasp-service"
+
+// statusRule сопоставляет sentinel-ошибку сервиса коду gRPC и коду ошибки REST
+type statusRule struct {
+	err    error
+	code   codes.Code
+	reason response.ErrCode
+}
+
+// statusRules проверяются по порядку, побеждает первое совпадение
+var statusRules = []statusRule{
+	{response.ErrNotFound, codes.NotFound, response.NOT_FOUND},
+	{response.ErrBadRequest, codes.InvalidArgument, response.INVALID_REQUEST},
+	{response.ErrInvalidId, codes.InvalidArgument, response.INVALID_REQUEST},
+	{response.ErrBulkValidation, codes.InvalidArgument, response.BULK_VALIDATION_FAILED},
+	{response.ErrInvalidCheckInCode, codes.InvalidArgument, response.INVALID_CHECK_IN_CODE},
+	// ресурс занят параллельной операцией — клиент может повторить запрос
+	{response.ErrLocked, codes.Aborted, response.LOCKED},
+	{response.ErrConflict, codes.AlreadyExists, response.CONFLICT},
+	{response.ErrAttendanceExists, codes.AlreadyExists, response.ATTENDANCE_EXISTS},
+	{response.ErrSlotNotAvailable, codes.FailedPrecondition, response.SLOT_NOT_AVAILABLE},
+	{response.ErrBookingOverlap, codes.FailedPrecondition, response.BOOKING_OVERLAP},
+	{response.ErrRuleViolation, codes.FailedPrecondition, response.BOOKING_RULE_VIOLATION},
+	{response.ErrBookingNotConfirmed, codes.FailedPrecondition, response.BOOKING_NOT_CONFIRMED},
+	{response.ErrLessonNotStarted, codes.FailedPrecondition, response.LESSON_NOT_STARTED},
+	{response.ErrCheckInClosed, codes.FailedPrecondition, response.CHECK_IN_CLOSED},
+	{response.ErrCreditNotAvailable, codes.FailedPrecondition, response.CREDIT_NOT_AVAILABLE},
+	{response.ErrStudentRestricted, codes.PermissionDenied, response.STUDENT_RESTRICTED},
+	{response.ErrTeacherConfirmationRequired, codes.PermissionDenied, response.TEACHER_CONFIRMATION_REQUIRED},
+	{response.ErrInvalidCalendarToken, codes.Unauthenticated, response.INVALID_CALENDAR_TOKEN},
+	{response.ErrUnauthorized, codes.Unauthenticated, response.UNAUTHORIZED},
+	{response.ErrForbidden, codes.PermissionDenied, response.FORBIDDEN},
+	{response.ErrUnknownTenant, codes.InvalidArgument, response.UNKNOWN_TENANT},
+	{response.ErrTenantRequired, codes.InvalidArgument, response.UNKNOWN_TENANT},
+}
+
+// toStatus переводит ошибку сервиса в статус gRPC. Текст статуса — только текст
+// sentinel-ошибки: цепочка op с внутренними подробностями клиенту не уходит.
+// Подробности, которые REST отдаёт в details, передаются в ErrorInfo.Metadata.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	}
+
+	for _, rule := range statusRules {
+		if errors.Is(err, rule.err) {
+			return withInfo(status.New(rule.code, rule.err.Error()), rule.reason, errorMetadata(err))
+		}
+	}
+
+	return withInfo(status.New(codes.Internal, "internal error"), response.FAILED_REQUEST, nil)
+}
+
+// errorMetadata собирает подробности типизированных ошибок response
+func errorMetadata(err error) map[string]string {
+	var overlapErr *response.BookingOverlapError
+	if errors.As(err, &overlapErr) {
+		return map[string]string{"conflicting_booking_id": overlapErr.BookingID}
+	}
+
+	var restrictedErr *response.StudentRestrictedError
+	if errors.As(err, &restrictedErr) {
+		return map[string]string{
+			"restriction_id": restrictedErr.RestrictionID,
+			"until":          restrictedErr.Until.Format(time.RFC3339),
+		}
+	}
+
+	var ruleErr *response.RuleViolationError
+	if errors.As(err, &ruleErr) {
+		return map[string]string{
+			"rule":    ruleErr.Rule,
+			"limit":   fmt.Sprint(ruleErr.Limit),
+			"message": ruleErr.Message,
+		}
+	}
+
+	var existsErr *response.AttendanceExistsError
+	if errors.As(err, &existsErr) {
+		return map[string]string{"attendance_id": existsErr.AttendanceID}
+	}
+
+	return nil
+}
+
+func withInfo(st *status.Status, reason response.ErrCode, metadata map[string]string) error {
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(reason),
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// invalidArgument — ошибка валидации запроса с указанием поля
+func invalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("%s: %s", field, description))
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(response.BAD_REQUEST), Domain: ErrorDomain},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		}},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// required проверяет обязательные строковые поля; пары — имя поля и значение
+func required(fields ...string) error {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if strings.TrimSpace(fields[i+1]) == "" {
+			return invalidArgument(fields[i], "is required")
+		}
+	}
+	return nil
+}
+
+// bulkStatus — ошибка пакетной отметки: невалидные пункты передаются как нарушения
+// полей items[i], как и в ответе REST, ничего не записано
+func bulkStatus(result *api.AttendanceBulkResponse) error {
+	st := status.New(codes.InvalidArgument, response.ErrBulkValidation.Error())
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0)
+	if result != nil {
+		for i, item := range result.Results {
+			if item.Error == nil {
+				continue
+			}
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("items[%d]", i),
+				Description: fmt.Sprintf("%s: %s", item.Error.Code, item.Error.Message),
+			})
+		}
+	}
+
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(response.BULK_VALIDATION_FAILED), Domain: ErrorDomain},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,142 @@
+// Package grpcserver — gRPC API сервиса. Повторяет REST API (шаблоны, блокировки,
+// слоты, брони, посещаемость) поверх того же *service.Service.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	raspv1 "rasp-service/api/proto/rasp/v1"
+	"rasp-service/internal/service"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/sl"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ключи метаданных — аналоги заголовков REST API
+const (
+	RequestIDKey      = "x-request-id"
+	ActorKey          = "x-actor-id"
+	IdempotencyKeyKey = "idempotency-key"
+)
+
+// server реализует сервисы raspv1 поверх *service.Service
+type server struct {
+	raspv1.UnimplementedAvailabilityTemplatesServer
+	raspv1.UnimplementedTimeBlocksServer
+	raspv1.UnimplementedSlotsServer
+	raspv1.UnimplementedBookingsServer
+	raspv1.UnimplementedAttendanceServiceServer
+
+	log *slog.Logger
+	svc *service.Service
+}
+
+// New создаёт gRPC-сервер со всеми сервисами raspv1. Перехватчики кладут в контекст
+// request id и инициатора из метаданных (как RequestID и actor в REST), пишут лог
+// запроса и превращают панику в codes.Internal.
+func New(log *slog.Logger, svc *service.Service, opts ...grpc.ServerOption) *grpc.Server {
+	log = log.With(slog.String("component", "grpc"))
+
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			requestContext,
+			logging(log),
+			recovery(log),
+		),
+	}, opts...)
+
+	gs := grpc.NewServer(opts...)
+
+	srv := &server{log: log, svc: svc}
+	raspv1.RegisterAvailabilityTemplatesServer(gs, srv)
+	raspv1.RegisterTimeBlocksServer(gs, srv)
+	raspv1.RegisterSlotsServer(gs, srv)
+	raspv1.RegisterBookingsServer(gs, srv)
+	raspv1.RegisterAttendanceServiceServer(gs, srv)
+
+	return gs
+}
+
+// requestContext — аналог middleware.RequestID и actor.New для gRPC
+func requestContext(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	requestID := incoming(ctx, RequestIDKey)
+	if requestID == "" {
+		requestID = fmt.Sprintf("grpc-%06d", middleware.NextRequestID())
+	}
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, requestID)
+
+	if id := incoming(ctx, ActorKey); id != "" {
+		ctx = actor.WithActor(ctx, id)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, requestID))
+
+	return handler(ctx, req)
+}
+
+func logging(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		t1 := time.Now()
+		resp, err := handler(ctx, req)
+
+		log.Info("request completed",
+			slog.String("method", info.FullMethod),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("code", status.Code(err).String()),
+			slog.String("duration", time.Since(t1).String()),
+		)
+
+		return resp, err
+	}
+}
+
+func recovery(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				log.Error("panic recovered",
+					slog.String("method", info.FullMethod),
+					slog.Any("panic", p),
+					slog.String("stack", string(debug.Stack())),
+				)
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// incoming возвращает первое значение ключа из входящих метаданных
+func incoming(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// fail логирует ошибку сервиса и переводит её в статус gRPC
+func (s *server) fail(ctx context.Context, op string, err error) error {
+	st := toStatus(err)
+	if status.Code(st) == codes.Internal {
+		s.log.Error("Request failed",
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			sl.Err(err),
+		)
+	}
+	return st
+}
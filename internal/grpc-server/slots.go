@@ -0,0 +1,167 @@
+package grpcserver
+
+import (
+	"context"
+	"rasp-service/api"
+	raspv1 "rasp-service/api/proto/rasp/v1"
+	"rasp-service/internal/service"
+)
+
+// Пагинация ListSlots по умолчанию — как в GET /slots
+const (
+	defaultSlotsPage    = 1
+	defaultSlotsPerPage = 20
+	maxSlotsPerPage     = 100
+	defaultSlotsSort    = "starts_at"
+)
+
+// Slots
+
+func (s *server) GetSlot(ctx context.Context, req *raspv1.GetSlotRequest) (*raspv1.Slot, error) {
+	const op = "grpcserver.GetSlot"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	slot, err := s.svc.GetSlot(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return slotToPb(slot), nil
+}
+
+func (s *server) ListSlots(ctx context.Context, req *raspv1.ListSlotsRequest) (*raspv1.ListSlotsResponse, error) {
+	const op = "grpcserver.ListSlots"
+
+	page := int(req.GetPage())
+	if page <= 0 {
+		page = defaultSlotsPage
+	}
+	perPage := int(req.GetPerPage())
+	if perPage <= 0 || perPage > maxSlotsPerPage {
+		perPage = defaultSlotsPerPage
+	}
+	sort := req.GetSort()
+	if sort == "" {
+		sort = defaultSlotsSort
+	}
+
+	filters := &service.SlotFilters{
+		TeacherID: req.TeacherId,
+		From:      timeOrNil(req.GetFrom()),
+		To:        timeOrNil(req.GetTo()),
+		Status:    req.Status,
+		Q:         req.Q,
+		Page:      &page,
+		PerPage:   &perPage,
+		Sort:      &sort,
+	}
+	if req.Duration != nil {
+		duration := int(req.GetDuration())
+		filters.Duration = &duration
+	}
+
+	slots, err := s.svc.ListSlots(ctx, filters)
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return slotsToPb(slots), nil
+}
+
+func (s *server) BatchGetSlots(ctx context.Context, req *raspv1.BatchGetSlotsRequest) (*raspv1.ListSlotsResponse, error) {
+	const op = "grpcserver.BatchGetSlots"
+
+	if len(req.GetIds()) == 0 {
+		return nil, invalidArgument("ids", "is required")
+	}
+
+	slots, err := s.svc.GetSlotsByIDs(ctx, req.GetIds())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return slotsToPb(slots), nil
+}
+
+func (s *server) GenerateSlots(ctx context.Context, req *raspv1.GenerateSlotsRequest) (*raspv1.GenerateSlotsResponse, error) {
+	const op = "grpcserver.GenerateSlots"
+
+	if req.TemplateId == nil && req.TeacherId == nil {
+		return nil, invalidArgument("template_id", "template_id or teacher_id is required")
+	}
+
+	jobID, err := s.svc.GenerateSlots(ctx, &api.SlotGenerateRequest{
+		TemplateID: req.TemplateId,
+		TeacherID:  req.TeacherId,
+		From:       req.GetFrom(),
+		To:         req.GetTo(),
+	})
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &raspv1.GenerateSlotsResponse{JobId: jobID}, nil
+}
+
+func (s *server) SuggestAlternativeSlots(ctx context.Context, req *raspv1.SuggestAlternativeSlotsRequest) (*raspv1.ListSlotsResponse, error) {
+	const op = "grpcserver.SuggestAlternativeSlots"
+
+	if err := required("slot_id", req.GetSlotId()); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = service.DefaultAlternativeSlots
+	}
+
+	slots, err := s.svc.SuggestAlternativeSlots(ctx, req.GetSlotId(), limit)
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return slotsToPb(slots), nil
+}
+
+func (s *server) GetCheckInCode(ctx context.Context, req *raspv1.GetCheckInCodeRequest) (*raspv1.CheckInCode, error) {
+	const op = "grpcserver.GetCheckInCode"
+
+	if err := required("slot_id", req.GetSlotId()); err != nil {
+		return nil, err
+	}
+
+	code, err := s.svc.GetCheckInCode(ctx, req.GetSlotId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &raspv1.CheckInCode{
+		SlotId:      code.SlotID,
+		Code:        code.Code,
+		ExpiresAt:   timestamp(code.ExpiresAt),
+		StepSeconds: int32(code.StepSeconds),
+	}, nil
+}
+
+func slotToPb(slot *api.SlotResponse) *raspv1.Slot {
+	return &raspv1.Slot{
+		Id:         slot.ID,
+		Start:      timestamp(slot.Start),
+		End:        timestamp(slot.End),
+		TeacherId:  slot.TeacherID,
+		Status:     slot.Status,
+		BookingId:  slot.BookingID,
+		TemplateId: slot.TemplateID,
+	}
+}
+
+func slotsToPb(slots []*api.SlotResponse) *raspv1.ListSlotsResponse {
+	resp := &raspv1.ListSlotsResponse{Slots: make([]*raspv1.Slot, 0, len(slots))}
+	for _, slot := range slots {
+		resp.Slots = append(resp.Slots, slotToPb(slot))
+	}
+	return resp
+}
@@ -0,0 +1,102 @@
+package grpcserver
+
+import (
+	"context"
+	"rasp-service/api"
+	raspv1 "rasp-service/api/proto/rasp/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Availability Templates
+
+func (s *server) CreateAvailabilityTemplate(ctx context.Context, req *raspv1.CreateAvailabilityTemplateRequest) (*raspv1.AvailabilityTemplate, error) {
+	const op = "grpcserver.CreateAvailabilityTemplate"
+
+	in := templateRequest(req.GetTemplate())
+	if err := required("template.teacher_id", in.TeacherID); err != nil {
+		return nil, err
+	}
+
+	template, err := s.svc.CreateAvailabilityTemplate(ctx, in)
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return templateToPb(template), nil
+}
+
+func (s *server) GetAvailabilityTemplate(ctx context.Context, req *raspv1.GetAvailabilityTemplateRequest) (*raspv1.AvailabilityTemplate, error) {
+	const op = "grpcserver.GetAvailabilityTemplate"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	template, err := s.svc.GetAvailabilityTemplate(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return templateToPb(template), nil
+}
+
+func (s *server) UpdateAvailabilityTemplate(ctx context.Context, req *raspv1.UpdateAvailabilityTemplateRequest) (*raspv1.AvailabilityTemplate, error) {
+	const op = "grpcserver.UpdateAvailabilityTemplate"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	template, err := s.svc.UpdateAvailabilityTemplate(ctx, req.GetId(), templateRequest(req.GetTemplate()))
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return templateToPb(template), nil
+}
+
+func (s *server) DeleteAvailabilityTemplate(ctx context.Context, req *raspv1.DeleteAvailabilityTemplateRequest) (*emptypb.Empty, error) {
+	const op = "grpcserver.DeleteAvailabilityTemplate"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteAvailabilityTemplate(ctx, req.GetId()); err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func templateRequest(in *raspv1.AvailabilityTemplateInput) *api.AvailabilityTemplateRequest {
+	return &api.AvailabilityTemplateRequest{
+		TeacherID: in.GetTeacherId(),
+		Recurrence: api.RecurrenceConfig{
+			Days:      in.GetRecurrence().GetDays(),
+			StartTime: in.GetRecurrence().GetStartTime(),
+			EndTime:   in.GetRecurrence().GetEndTime(),
+		},
+		SlotDurationMinutes: int(in.GetSlotDurationMinutes()),
+		StartDate:           in.GetStartDate(),
+		EndDate:             in.GetEndDate(),
+		Enabled:             in.GetEnabled(),
+	}
+}
+
+func templateToPb(t *api.AvailabilityTemplateResponse) *raspv1.AvailabilityTemplate {
+	return &raspv1.AvailabilityTemplate{
+		Id:        t.ID,
+		TeacherId: t.TeacherID,
+		Recurrence: &raspv1.Recurrence{
+			Days:      t.Recurrence.Days,
+			StartTime: t.Recurrence.StartTime,
+			EndTime:   t.Recurrence.EndTime,
+		},
+		SlotDurationMinutes: int32(t.SlotDurationMinutes),
+		StartDate:           t.StartDate,
+		EndDate:             t.EndDate,
+		Enabled:             t.Enabled,
+	}
+}
@@ -0,0 +1,148 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"rasp-service/api"
+	raspv1 "rasp-service/api/proto/rasp/v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Time Blocks
+
+func (s *server) CreateTimeBlock(ctx context.Context, req *raspv1.CreateTimeBlockRequest) (*raspv1.TimeBlock, error) {
+	const op = "grpcserver.CreateTimeBlock"
+
+	in := timeBlockRequest(req.GetTimeBlock())
+	if err := required("time_block.teacher_id", in.TeacherID); err != nil {
+		return nil, err
+	}
+
+	block, err := s.svc.CreateTimeBlock(ctx, in)
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return timeBlockToPb(block), nil
+}
+
+func (s *server) GetTimeBlock(ctx context.Context, req *raspv1.GetTimeBlockRequest) (*raspv1.TimeBlock, error) {
+	const op = "grpcserver.GetTimeBlock"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	block, err := s.svc.GetTimeBlock(ctx, req.GetId())
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return timeBlockToPb(block), nil
+}
+
+func (s *server) ListTimeBlocks(ctx context.Context, req *raspv1.ListTimeBlocksRequest) (*raspv1.ListTimeBlocksResponse, error) {
+	const op = "grpcserver.ListTimeBlocks"
+
+	blocks, err := s.svc.ListTimeBlocks(ctx, req.TeacherId, timeOrNil(req.GetFrom()), timeOrNil(req.GetTo()))
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.ListTimeBlocksResponse{TimeBlocks: make([]*raspv1.TimeBlock, 0, len(blocks))}
+	for _, block := range blocks {
+		resp.TimeBlocks = append(resp.TimeBlocks, timeBlockToPb(block))
+	}
+
+	return resp, nil
+}
+
+func (s *server) UpdateTimeBlock(ctx context.Context, req *raspv1.UpdateTimeBlockRequest) (*raspv1.TimeBlock, error) {
+	const op = "grpcserver.UpdateTimeBlock"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	block, err := s.svc.UpdateTimeBlock(ctx, req.GetId(), timeBlockRequest(req.GetTimeBlock()))
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return timeBlockToPb(block), nil
+}
+
+func (s *server) DeleteTimeBlock(ctx context.Context, req *raspv1.DeleteTimeBlockRequest) (*emptypb.Empty, error) {
+	const op = "grpcserver.DeleteTimeBlock"
+
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteTimeBlock(ctx, req.GetId()); err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ImportExternalCalendar(ctx context.Context, req *raspv1.ImportExternalCalendarRequest) (*raspv1.TimeBlockImport, error) {
+	const op = "grpcserver.ImportExternalCalendar"
+
+	if err := required("teacher_id", req.GetTeacherId()); err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if tz := req.GetTz(); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, invalidArgument("tz", "must be an IANA time zone")
+		}
+		loc = l
+	}
+
+	result, err := s.svc.ImportExternalCalendar(ctx, req.GetTeacherId(), bytes.NewReader(req.GetIcs()), loc)
+	if err != nil {
+		return nil, s.fail(ctx, op, err)
+	}
+
+	resp := &raspv1.TimeBlockImport{
+		TeacherId: result.TeacherID,
+		From:      timestamp(result.From),
+		To:        timestamp(result.To),
+		Created:   int32(result.Created),
+		Updated:   int32(result.Updated),
+		Deleted:   int32(result.Deleted),
+		Skipped:   make([]*raspv1.TimeBlockImportSkipped, 0, len(result.Skipped)),
+	}
+	for _, skipped := range result.Skipped {
+		resp.Skipped = append(resp.Skipped, &raspv1.TimeBlockImportSkipped{Uid: skipped.UID, Reason: skipped.Reason})
+	}
+
+	return resp, nil
+}
+
+func timeBlockRequest(in *raspv1.TimeBlockInput) *api.TimeBlockRequest {
+	return &api.TimeBlockRequest{
+		TeacherID: in.GetTeacherId(),
+		Start:     in.GetStart(),
+		End:       in.GetEnd(),
+		Reason:    in.GetReason(),
+		Type:      in.GetType(),
+	}
+}
+
+func timeBlockToPb(b *api.TimeBlockResponse) *raspv1.TimeBlock {
+	return &raspv1.TimeBlock{
+		Id:          b.ID,
+		TeacherId:   b.TeacherID,
+		Start:       timestamp(b.Start),
+		End:         timestamp(b.End),
+		Reason:      b.Reason,
+		Type:        b.Type,
+		ExternalUid: b.ExternalUID,
+	}
+}
@@ -0,0 +1,84 @@
+package create
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// InvalidAPIKeyMessage — ответ на запрос ключа с неверными полями
+const InvalidAPIKeyMessage = "name and at least one known scope are required, " +
+	"teacher_id must not be empty and expires_at must be in the future"
+
+type APIKeyCreator interface {
+	CreateAPIKey(ctx context.Context, req *api.APIKeyRequest) (*api.APIKeyResponse, error)
+}
+
+type Request struct {
+	api.APIKeyRequest
+}
+
+type Response struct {
+	response.Response
+	APIKey api.APIKeyResponse `json:"api_key,omitempty"`
+}
+
+func New(log *slog.Logger, creator APIKeyCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.api_keys.create.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		log.Info("Request body decoded", slog.String("name", req.Name), slog.Any("scopes", req.Scopes))
+
+		key, err := creator.CreateAPIKey(r.Context(), &req.APIKeyRequest)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid api key request", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), InvalidAPIKeyMessage))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to create api key", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to create api key"))
+			return
+		}
+
+		// сам ключ в лог не пишем
+		log.Info("API key created", slog.String("id", key.ID), slog.String("prefix", key.Prefix))
+
+		w.WriteHeader(http.StatusCreated)
+		render.JSON(w, r, Response{
+			APIKey: *key,
+		})
+	}
+}
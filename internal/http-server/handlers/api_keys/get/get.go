@@ -0,0 +1,97 @@
+package get
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type APIKeyGetter interface {
+	GetAPIKey(ctx context.Context, id string) (*api.APIKeyResponse, error)
+	ListAPIKeys(ctx context.Context) ([]*api.APIKeyResponse, error)
+}
+
+type Response struct {
+	response.Response
+	APIKeys []api.APIKeyResponse `json:"api_keys,omitempty"`
+	APIKey  *api.APIKeyResponse  `json:"api_key,omitempty"`
+}
+
+func New(log *slog.Logger, getter APIKeyGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.api_keys.get.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+
+		if id != "" {
+			// Get by ID
+			key, err := getter.GetAPIKey(r.Context(), id)
+
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
+			if errors.Is(err, response.ErrNotFound) {
+				log.Error("resource not found")
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+				return
+			}
+
+			if err != nil {
+				log.Error("Failed to get api key", sl.Err(err))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to get api key"))
+				return
+			}
+
+			log.Info("API key retrieved", slog.String("id", key.ID))
+			render.JSON(w, r, Response{
+				APIKey: key,
+			})
+			return
+		}
+
+		// List
+		list, err := getter.ListAPIKeys(r.Context())
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list api keys", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list api keys"))
+			return
+		}
+
+		log.Info("API keys retrieved", slog.Int("count", len(list)))
+		listResponse := make([]api.APIKeyResponse, len(list))
+		for i, key := range list {
+			listResponse[i] = *key
+		}
+		render.JSON(w, r, Response{
+			APIKeys: listResponse,
+		})
+	}
+}
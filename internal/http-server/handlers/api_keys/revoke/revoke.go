@@ -0,0 +1,69 @@
+package revoke
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type APIKeyRevoker interface {
+	RevokeAPIKey(ctx context.Context, id string) (*api.APIKeyResponse, error)
+}
+
+type Response struct {
+	response.Response
+	APIKey *api.APIKeyResponse `json:"api_key,omitempty"`
+}
+
+func New(log *slog.Logger, revoker APIKeyRevoker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.api_keys.revoke.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		key, err := revoker.RevokeAPIKey(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("api key not found or already revoked")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "api key not found or already revoked"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to revoke api key", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to revoke api key"))
+			return
+		}
+
+		log.Info("API key revoked", slog.String("id", id))
+		render.JSON(w, r, Response{APIKey: key})
+	}
+}
@@ -0,0 +1,109 @@
+package bulk
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type AttendanceBulkMarker interface {
+	BulkAttendance(ctx context.Context, req *api.AttendanceBulkRequest) (*api.AttendanceBulkResponse, error)
+}
+
+type Request struct {
+	api.AttendanceBulkRequest
+}
+
+type Response struct {
+	response.Response
+	*api.AttendanceBulkResponse
+}
+
+func New(log *slog.Logger, marker AttendanceBulkMarker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.attendance.bulk.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		log.Info("Request body decoded", slog.Int("items", len(req.Items)), slog.Bool("mark_rest_present", req.MarkRestPresent))
+
+		if len(req.Items) == 0 && !req.MarkRestPresent {
+			log.Error("items are empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "items or mark_rest_present is required"))
+			return
+		}
+
+		if req.MarkRestPresent && (req.TeacherID == "" || req.Date == "") {
+			log.Error("teacher_id or date is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "teacher_id and date are required for mark_rest_present"))
+			return
+		}
+
+		if req.Date != "" {
+			if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+				log.Error("invalid date", sl.Err(err))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "date must be in YYYY-MM-DD format"))
+				return
+			}
+		}
+
+		result, err := marker.BulkAttendance(r.Context(), &req.AttendanceBulkRequest)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBulkValidation) {
+			log.Error("bulk attendance validation failed")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, Response{
+				Response:               response.Error(string(response.BULK_VALIDATION_FAILED), "some items are invalid, nothing was recorded"),
+				AttendanceBulkResponse: result,
+			})
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid bulk request", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "invalid bulk request"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to record attendance", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to record attendance"))
+			return
+		}
+
+		log.Info("Attendance recorded", slog.Int("items", len(result.Results)), slog.Int("marked_present", len(result.MarkedPresent)))
+		render.JSON(w, r, Response{AttendanceBulkResponse: result})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 type AttendanceCreator interface {
 	CreateAttendance(ctx context.Context, req *api.AttendanceRequest) (*api.AttendanceResponse, error)
+	UpsertAttendance(ctx context.Context, req *api.AttendanceRequest) (*api.AttendanceResponse, bool, error)
 }
 
 type Request struct {
@@ -60,7 +61,31 @@ func New(log *slog.Logger, creator AttendanceCreator) http.HandlerFunc {
 			return
 		}
 
-		attendance, err := creator.CreateAttendance(r.Context(), &req.AttendanceRequest)
+		// ?upsert=true перезаписывает существующую отметку по той же брони
+		upsert := r.URL.Query().Get("upsert") == "true"
+
+		var attendance *api.AttendanceResponse
+		var err error
+		created := true
+		if upsert {
+			attendance, created, err = creator.UpsertAttendance(r.Context(), &req.AttendanceRequest)
+		} else {
+			attendance, err = creator.CreateAttendance(r.Context(), &req.AttendanceRequest)
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid attendance status", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "status must be one of: present, absent, late; only an absence can be excused"))
+			return
+		}
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
 
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
@@ -69,6 +94,37 @@ func New(log *slog.Logger, creator AttendanceCreator) http.HandlerFunc {
 			return
 		}
 
+		if errors.Is(err, response.ErrBookingNotConfirmed) {
+			log.Error("booking is not confirmed")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.BOOKING_NOT_CONFIRMED), "attendance can only be recorded for confirmed bookings"))
+			return
+		}
+
+		if errors.Is(err, response.ErrLessonNotStarted) {
+			log.Error("lesson has not started yet")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.Error(string(response.LESSON_NOT_STARTED), "attendance cannot be recorded before the lesson starts"))
+			return
+		}
+
+		var existsErr *response.AttendanceExistsError
+		if errors.As(err, &existsErr) {
+			log.Error("attendance already recorded", slog.String("attendance_id", existsErr.AttendanceID))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.ATTENDANCE_EXISTS), "attendance already recorded for this booking", map[string]any{
+				"attendance_id": existsErr.AttendanceID,
+			}))
+			return
+		}
+
+		if errors.Is(err, response.ErrAttendanceExists) {
+			log.Error("attendance already recorded")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.ATTENDANCE_EXISTS), "attendance already recorded for this booking"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to create attendance", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
@@ -76,9 +132,11 @@ func New(log *slog.Logger, creator AttendanceCreator) http.HandlerFunc {
 			return
 		}
 
-		log.Info("Attendance created", slog.Any("attendance", attendance))
+		log.Info("Attendance recorded", slog.Any("attendance", attendance), slog.Bool("created", created))
 
-		w.WriteHeader(http.StatusCreated)
+		if created {
+			w.WriteHeader(http.StatusCreated)
+		}
 		responseOK(w, r, attendance)
 	}
 }
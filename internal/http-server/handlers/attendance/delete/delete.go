@@ -0,0 +1,63 @@
+package delete
+
+import (
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type AttendanceDeleter interface {
+	DeleteAttendance(ctx context.Context, id string) error
+}
+
+func New(log *slog.Logger, deleter AttendanceDeleter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.attendance.delete.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		err := deleter.DeleteAttendance(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to delete attendance", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to delete attendance"))
+			return
+		}
+
+		log.Info("Attendance deleted", slog.String("id", id))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
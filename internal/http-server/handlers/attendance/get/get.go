@@ -44,6 +44,13 @@ func New(log *slog.Logger, getter AttendanceGetter) http.HandlerFunc {
 			// Get by ID
 			attendance, err := getter.GetAttendance(r.Context(), id)
 
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
 			if errors.Is(err, response.ErrNotFound) {
 				log.Error("resource not found")
 				w.WriteHeader(http.StatusNotFound)
@@ -87,6 +94,13 @@ func New(log *slog.Logger, getter AttendanceGetter) http.HandlerFunc {
 
 		attendances, err := getter.ListAttendance(r.Context(), teacherIDPtr, from, to)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to list attendance", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
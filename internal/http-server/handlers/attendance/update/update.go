@@ -0,0 +1,118 @@
+package update
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type AttendanceUpdater interface {
+	UpdateAttendance(ctx context.Context, id string, req *api.AttendanceUpdateRequest) (*api.AttendanceResponse, error)
+}
+
+type Request struct {
+	api.AttendanceUpdateRequest
+}
+
+type Response struct {
+	response.Response
+	Attendance api.AttendanceResponse `json:"attendance,omitempty"`
+}
+
+func New(log *slog.Logger, updater AttendanceUpdater) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.attendance.update.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		log.Info("Request body decoded", slog.Any("request", req))
+
+		if req.Status == "" {
+			log.Error("status is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "status is required"))
+			return
+		}
+
+		attendance, err := updater.UpdateAttendance(r.Context(), id, &req.AttendanceUpdateRequest)
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid attendance status", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "status must be one of: present, absent, late; only an absence can be excused"))
+			return
+		}
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBookingNotConfirmed) {
+			log.Error("booking is not confirmed")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.BOOKING_NOT_CONFIRMED), "attendance can only be recorded for confirmed bookings"))
+			return
+		}
+
+		if errors.Is(err, response.ErrLessonNotStarted) {
+			log.Error("lesson has not started yet")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.Error(string(response.LESSON_NOT_STARTED), "attendance cannot be recorded before the lesson starts"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to update attendance", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to update attendance"))
+			return
+		}
+
+		log.Info("Attendance updated", slog.Any("attendance", attendance))
+		responseOK(w, r, attendance)
+	}
+}
+
+func responseOK(w http.ResponseWriter, r *http.Request, attendance *api.AttendanceResponse) {
+	render.JSON(w, r, Response{
+		Attendance: *attendance,
+	})
+}
@@ -55,6 +55,20 @@ func New(log *slog.Logger, creator AvailabilityTemplateCreator) http.HandlerFunc
 
 		template, err := creator.CreateAvailabilityTemplate(r.Context(), &req.AvailabilityTemplateRequest)
 
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid template schedule", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "dates must be YYYY-MM-DD with end_date not before start_date, times HH:MM with end_time after start_time"))
+			return
+		}
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
@@ -0,0 +1,107 @@
+package create
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type BookingRulesCreator interface {
+	CreateBookingRules(ctx context.Context, req *api.BookingRulesRequest) (*api.BookingRulesResponse, error)
+}
+
+type Request struct {
+	api.BookingRulesRequest
+}
+
+type Response struct {
+	response.Response
+	Rules api.BookingRulesResponse `json:"rules,omitempty"`
+}
+
+func New(log *slog.Logger, creator BookingRulesCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.booking_rules.create.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		log.Info("Request body decoded", slog.Any("request", req))
+
+		if msg := Validate(&req.BookingRulesRequest); msg != "" {
+			log.Error("invalid request", slog.String("reason", msg))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), msg))
+			return
+		}
+
+		rules, err := creator.CreateBookingRules(r.Context(), &req.BookingRulesRequest)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrConflict) {
+			log.Error("booking rules already exist")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.CONFLICT), "booking rules for this teacher and template already exist"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to create booking rules", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to create booking rules"))
+			return
+		}
+
+		log.Info("Booking rules created", slog.Any("rules", rules))
+
+		w.WriteHeader(http.StatusCreated)
+		responseOK(w, r, rules)
+	}
+}
+
+// Validate возвращает текст ошибки валидации или пустую строку
+func Validate(req *api.BookingRulesRequest) string {
+	if req.TeacherID == "" {
+		return "teacher_id is required"
+	}
+	if req.MinNoticeHours != nil && *req.MinNoticeHours < 0 {
+		return "min_notice_hours must not be negative"
+	}
+	if req.MaxAdvanceDays != nil && *req.MaxAdvanceDays < 0 {
+		return "max_advance_days must not be negative"
+	}
+	if req.MaxWeeklyBookings != nil && *req.MaxWeeklyBookings < 0 {
+		return "max_weekly_bookings must not be negative"
+	}
+	return ""
+}
+
+func responseOK(w http.ResponseWriter, r *http.Request, rules *api.BookingRulesResponse) {
+	render.JSON(w, r, Response{
+		Rules: *rules,
+	})
+}
@@ -0,0 +1,106 @@
+package get
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type BookingRulesGetter interface {
+	GetBookingRules(ctx context.Context, id string) (*api.BookingRulesResponse, error)
+	ListBookingRules(ctx context.Context, teacherID *string) ([]*api.BookingRulesResponse, error)
+}
+
+type Response struct {
+	response.Response
+	RulesList []api.BookingRulesResponse `json:"rules_list,omitempty"`
+	Rules     *api.BookingRulesResponse  `json:"rules,omitempty"`
+}
+
+func New(log *slog.Logger, getter BookingRulesGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.booking_rules.get.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+
+		if id != "" {
+			// Get by ID
+			rules, err := getter.GetBookingRules(r.Context(), id)
+
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
+			if errors.Is(err, response.ErrNotFound) {
+				log.Error("resource not found")
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+				return
+			}
+
+			if err != nil {
+				log.Error("Failed to get booking rules", sl.Err(err))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to get booking rules"))
+				return
+			}
+
+			log.Info("Booking rules retrieved", slog.Any("rules", rules))
+			responseOK(w, r, rules)
+			return
+		}
+
+		// List
+		var teacherIDPtr *string
+		if teacherID := r.URL.Query().Get("teacher_id"); teacherID != "" {
+			teacherIDPtr = &teacherID
+		}
+
+		list, err := getter.ListBookingRules(r.Context(), teacherIDPtr)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list booking rules", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list booking rules"))
+			return
+		}
+
+		log.Info("Booking rules retrieved", slog.Int("count", len(list)))
+		listResponse := make([]api.BookingRulesResponse, len(list))
+		for i, rules := range list {
+			listResponse[i] = *rules
+		}
+		render.JSON(w, r, Response{
+			RulesList: listResponse,
+		})
+	}
+}
+
+func responseOK(w http.ResponseWriter, r *http.Request, rules *api.BookingRulesResponse) {
+	render.JSON(w, r, Response{
+		Rules: rules,
+	})
+}
@@ -0,0 +1,105 @@
+package update
+
+import (
+	"rasp-service/api"
+	"rasp-service/internal/http-server/handlers/booking_rules/create"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type BookingRulesUpdater interface {
+	UpdateBookingRules(ctx context.Context, id string, req *api.BookingRulesRequest) (*api.BookingRulesResponse, error)
+}
+
+type Request struct {
+	api.BookingRulesRequest
+}
+
+type Response struct {
+	response.Response
+	Rules api.BookingRulesResponse `json:"rules,omitempty"`
+}
+
+func New(log *slog.Logger, updater BookingRulesUpdater) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.booking_rules.update.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		log.Info("Request body decoded", slog.Any("request", req))
+
+		if msg := create.Validate(&req.BookingRulesRequest); msg != "" {
+			log.Error("invalid request", slog.String("reason", msg))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), msg))
+			return
+		}
+
+		rules, err := updater.UpdateBookingRules(r.Context(), id, &req.BookingRulesRequest)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrConflict) {
+			log.Error("booking rules already exist")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.CONFLICT), "booking rules for this teacher and template already exist"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to update booking rules", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to update booking rules"))
+			return
+		}
+
+		log.Info("Booking rules updated", slog.Any("rules", rules))
+		responseOK(w, r, rules)
+	}
+}
+
+func responseOK(w http.ResponseWriter, r *http.Request, rules *api.BookingRulesResponse) {
+	render.JSON(w, r, Response{
+		Rules: *rules,
+	})
+}
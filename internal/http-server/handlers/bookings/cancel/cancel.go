@@ -42,6 +42,13 @@ func New(log *slog.Logger, canceller BookingCanceller) http.HandlerFunc {
 
 		booking, err := canceller.CancelBooking(r.Context(), id)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
@@ -49,6 +56,13 @@ func New(log *slog.Logger, canceller BookingCanceller) http.HandlerFunc {
 			return
 		}
 
+		if errors.Is(err, response.ErrConflict) {
+			log.Error("booking is not active", sl.Err(err))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.CONFLICT), "booking is already cancelled"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to cancel booking", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
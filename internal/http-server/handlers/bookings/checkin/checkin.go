@@ -0,0 +1,137 @@
+package checkin
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type CheckInner interface {
+	CheckIn(ctx context.Context, bookingID, code string) (*api.AttendanceResponse, error)
+}
+
+type Request struct {
+	api.CheckInRequest
+}
+
+type Response struct {
+	response.Response
+	Attendance api.AttendanceResponse `json:"attendance,omitempty"`
+}
+
+func New(log *slog.Logger, checker CheckInner) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.bookings.checkin.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		if req.Code == "" {
+			log.Error("code is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "code is required"))
+			return
+		}
+
+		attendance, err := checker.CheckIn(r.Context(), id, req.Code)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("booking not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrLocked) {
+			log.Error("too many check-in attempts")
+			w.WriteHeader(http.StatusLocked)
+			render.JSON(w, r, response.Error(string(response.LOCKED), "too many check-in attempts, try again in a few seconds"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBookingNotConfirmed) {
+			log.Error("booking is not confirmed")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.BOOKING_NOT_CONFIRMED), "check-in is only possible for confirmed bookings"))
+			return
+		}
+
+		if errors.Is(err, response.ErrCheckInClosed) {
+			log.Error("check-in is not open")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.Error(string(response.CHECK_IN_CLOSED), "check-in is open from 15 minutes before the lesson until it ends"))
+			return
+		}
+
+		if errors.Is(err, response.ErrInvalidCheckInCode) {
+			log.Error("invalid check-in code")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.Error(string(response.INVALID_CHECK_IN_CODE), "check-in code is invalid or expired"))
+			return
+		}
+
+		var existsErr *response.AttendanceExistsError
+		if errors.As(err, &existsErr) {
+			log.Error("attendance already recorded", slog.String("attendance_id", existsErr.AttendanceID))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.ATTENDANCE_EXISTS), "attendance already recorded for this booking", map[string]any{
+				"attendance_id": existsErr.AttendanceID,
+			}))
+			return
+		}
+
+		if errors.Is(err, response.ErrAttendanceExists) {
+			log.Error("attendance already recorded")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.ATTENDANCE_EXISTS), "attendance already recorded for this booking"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to check in", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to check in"))
+			return
+		}
+
+		log.Info("Student checked in", slog.Any("attendance", attendance))
+		w.WriteHeader(http.StatusCreated)
+		render.JSON(w, r, Response{
+			Attendance: *attendance,
+		})
+	}
+}
@@ -42,6 +42,13 @@ func New(log *slog.Logger, confirmer BookingConfirmer) http.HandlerFunc {
 
 		booking, err := confirmer.ConfirmBooking(r.Context(), id)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
@@ -49,6 +56,20 @@ func New(log *slog.Logger, confirmer BookingConfirmer) http.HandlerFunc {
 			return
 		}
 
+		if errors.Is(err, response.ErrConflict) {
+			log.Error("booking is not active", sl.Err(err))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.CONFLICT), "booking is already cancelled"))
+			return
+		}
+
+		if errors.Is(err, response.ErrTeacherConfirmationRequired) {
+			log.Error("booking requires teacher confirmation")
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.TEACHER_CONFIRMATION_REQUIRED), "this booking must be confirmed by the teacher"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to confirm booking", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
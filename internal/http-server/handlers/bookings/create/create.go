@@ -2,6 +2,7 @@ package create
 
 import (
 	"rasp-service/api"
+	"rasp-service/internal/service"
 	"rasp-service/pkg/response"
 	"rasp-service/pkg/sl"
 	"context"
@@ -15,6 +16,7 @@ import (
 
 type BookingCreator interface {
 	CreateBooking(ctx context.Context, req *api.BookingRequest, idempotencyKey *string) (*api.BookingResponse, error)
+	SuggestAlternativeSlots(ctx context.Context, slotID string, limit int) ([]*api.SlotResponse, error)
 }
 
 type Request struct {
@@ -77,8 +79,70 @@ func New(log *slog.Logger, creator BookingCreator) http.HandlerFunc {
 
 		if errors.Is(err, response.ErrSlotNotAvailable) {
 			log.Error("slot is not available")
+
+			alternatives, altErr := creator.SuggestAlternativeSlots(r.Context(), req.SlotID, service.AlternativesLimit(r.URL.Query().Get("alternatives")))
+			if altErr != nil {
+				log.Error("Failed to suggest alternative slots", sl.Err(altErr))
+				alternatives = []*api.SlotResponse{}
+			}
+
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.SLOT_NOT_AVAILABLE), "slot is not available", map[string]any{
+				"alternatives": alternatives,
+			}))
+			return
+		}
+
+		if errors.Is(err, response.ErrCreditNotAvailable) {
+			log.Error("makeup credit is not available")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.CREDIT_NOT_AVAILABLE), "makeup credit not found, expired, already redeemed or issued by another teacher"))
+			return
+		}
+
+		var restrictedErr *response.StudentRestrictedError
+		if errors.As(err, &restrictedErr) {
+			log.Error("student is restricted", slog.String("restriction_id", restrictedErr.RestrictionID))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.STUDENT_RESTRICTED), "booking is suspended after repeated no-shows or late cancellations", map[string]any{
+				"restriction_id": restrictedErr.RestrictionID,
+				"until":          restrictedErr.Until,
+			}))
+			return
+		}
+
+		var ruleErr *response.RuleViolationError
+		if errors.As(err, &ruleErr) {
+			log.Error("booking rule violated", slog.String("rule", ruleErr.Rule))
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.BOOKING_RULE_VIOLATION), ruleErr.Message, map[string]any{
+				"rule":  ruleErr.Rule,
+				"limit": ruleErr.Limit,
+			}))
+			return
+		}
+
+		var overlapErr *response.BookingOverlapError
+		if errors.As(err, &overlapErr) {
+			log.Error("student already has a booking at this time", slog.String("conflicting_booking_id", overlapErr.BookingID))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.BOOKING_OVERLAP), "student already has a booking at this time", map[string]any{
+				"conflicting_booking_id": overlapErr.BookingID,
+			}))
+			return
+		}
+
+		if errors.Is(err, response.ErrBookingOverlap) {
+			log.Error("student already has a booking at this time")
 			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, response.Error(string(response.SLOT_NOT_AVAILABLE), "slot is not available"))
+			render.JSON(w, r, response.Error(string(response.BOOKING_OVERLAP), "student already has a booking at this time"))
+			return
+		}
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
 			return
 		}
 
@@ -108,4 +172,3 @@ func responseOK(w http.ResponseWriter, r *http.Request, booking *api.BookingResp
 		Booking: *booking,
 	})
 }
-
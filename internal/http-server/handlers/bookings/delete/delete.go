@@ -36,6 +36,13 @@ func New(log *slog.Logger, deleter BookingDeleter) http.HandlerFunc {
 
 		err := deleter.DeleteBooking(r.Context(), id)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
@@ -17,7 +17,7 @@ import (
 
 type BookingGetter interface {
 	GetBooking(ctx context.Context, id string) (*api.BookingResponse, error)
-	ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string) ([]*api.BookingResponse, error)
+	ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string, includeDeleted bool) ([]*api.BookingResponse, error)
 }
 
 type Response struct {
@@ -41,6 +41,13 @@ func New(log *slog.Logger, getter BookingGetter) http.HandlerFunc {
 			// Get by ID
 			booking, err := getter.GetBooking(r.Context(), id)
 
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
 			if errors.Is(err, response.ErrNotFound) {
 				log.Error("resource not found")
 				w.WriteHeader(http.StatusNotFound)
@@ -66,6 +73,7 @@ func New(log *slog.Logger, getter BookingGetter) http.HandlerFunc {
 		fromStr := r.URL.Query().Get("from")
 		toStr := r.URL.Query().Get("to")
 		status := r.URL.Query().Get("status")
+		includeDeleted := r.URL.Query().Get("include_deleted") == "true"
 
 		var studentIDPtr, teacherIDPtr, statusPtr *string
 		if studentID != "" {
@@ -94,7 +102,14 @@ func New(log *slog.Logger, getter BookingGetter) http.HandlerFunc {
 			}
 		}
 
-		bookings, err := getter.ListBookings(r.Context(), studentIDPtr, teacherIDPtr, from, to, statusPtr)
+		bookings, err := getter.ListBookings(r.Context(), studentIDPtr, teacherIDPtr, from, to, statusPtr, includeDeleted)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
 
 		if err != nil {
 			log.Error("Failed to list bookings", sl.Err(err))
@@ -0,0 +1,75 @@
+package history
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type BookingHistoryGetter interface {
+	GetBookingHistory(ctx context.Context, bookingID string) ([]*api.BookingEventResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Events []api.BookingEventResponse `json:"events"`
+}
+
+func New(log *slog.Logger, getter BookingHistoryGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.bookings.history.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		events, err := getter.GetBookingHistory(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to get booking history", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to get booking history"))
+			return
+		}
+
+		log.Info("Booking history retrieved", slog.Int("count", len(events)))
+		eventsResponse := make([]api.BookingEventResponse, len(events))
+		for i, e := range events {
+			eventsResponse[i] = *e
+		}
+		render.JSON(w, r, Response{
+			Events: eventsResponse,
+		})
+	}
+}
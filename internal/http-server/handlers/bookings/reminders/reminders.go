@@ -0,0 +1,75 @@
+package reminders
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type BookingRemindersGetter interface {
+	ListBookingReminders(ctx context.Context, bookingID string) ([]*api.ReminderResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Reminders []api.ReminderResponse `json:"reminders"`
+}
+
+func New(log *slog.Logger, getter BookingRemindersGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.bookings.reminders.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		reminders, err := getter.ListBookingReminders(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list booking reminders", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list booking reminders"))
+			return
+		}
+
+		log.Info("Booking reminders retrieved", slog.Int("count", len(reminders)))
+		remindersResponse := make([]api.ReminderResponse, len(reminders))
+		for i, rem := range reminders {
+			remindersResponse[i] = *rem
+		}
+		render.JSON(w, r, Response{
+			Reminders: remindersResponse,
+		})
+	}
+}
@@ -2,6 +2,7 @@ package reschedule
 
 import (
 	"rasp-service/api"
+	"rasp-service/internal/service"
 	"rasp-service/pkg/response"
 	"rasp-service/pkg/sl"
 	"context"
@@ -14,6 +15,7 @@ import (
 
 type BookingRescheduler interface {
 	RescheduleBooking(ctx context.Context, bookingID string, newSlotId string) (*api.BookingResponse, error)
+	SuggestAlternativeSlots(ctx context.Context, slotID string, limit int) ([]*api.SlotResponse, error)
 }
 
 type Request struct {
@@ -59,6 +61,13 @@ func New(log *slog.Logger, rescheduler BookingRescheduler) http.HandlerFunc {
 
 		booking, err := rescheduler.RescheduleBooking(r.Context(), req.BookingID, req.NewSlotID)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
@@ -66,10 +75,65 @@ func New(log *slog.Logger, rescheduler BookingRescheduler) http.HandlerFunc {
 			return
 		}
 
+		if errors.Is(err, response.ErrConflict) {
+			log.Error("booking is not active", sl.Err(err))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.CONFLICT), "only a pending or confirmed booking can be rescheduled"))
+			return
+		}
+
 		if errors.Is(err, response.ErrSlotNotAvailable) {
 			log.Error("slot is not available")
+
+			alternatives, altErr := rescheduler.SuggestAlternativeSlots(r.Context(), req.NewSlotID, service.AlternativesLimit(r.URL.Query().Get("alternatives")))
+			if altErr != nil {
+				log.Error("Failed to suggest alternative slots", sl.Err(altErr))
+				alternatives = []*api.SlotResponse{}
+			}
+
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.SLOT_NOT_AVAILABLE), "slot is not available", map[string]any{
+				"alternatives": alternatives,
+			}))
+			return
+		}
+
+		var restrictedErr *response.StudentRestrictedError
+		if errors.As(err, &restrictedErr) {
+			log.Error("student is restricted", slog.String("restriction_id", restrictedErr.RestrictionID))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.STUDENT_RESTRICTED), "booking is suspended after repeated no-shows or late cancellations", map[string]any{
+				"restriction_id": restrictedErr.RestrictionID,
+				"until":          restrictedErr.Until,
+			}))
+			return
+		}
+
+		var ruleErr *response.RuleViolationError
+		if errors.As(err, &ruleErr) {
+			log.Error("booking rule violated", slog.String("rule", ruleErr.Rule))
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.BOOKING_RULE_VIOLATION), ruleErr.Message, map[string]any{
+				"rule":  ruleErr.Rule,
+				"limit": ruleErr.Limit,
+			}))
+			return
+		}
+
+		var overlapErr *response.BookingOverlapError
+		if errors.As(err, &overlapErr) {
+			log.Error("student already has a booking at this time", slog.String("conflicting_booking_id", overlapErr.BookingID))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.BOOKING_OVERLAP), "student already has a booking at this time", map[string]any{
+				"conflicting_booking_id": overlapErr.BookingID,
+			}))
+			return
+		}
+
+		if errors.Is(err, response.ErrBookingOverlap) {
+			log.Error("student already has a booking at this time")
 			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, response.Error(string(response.SLOT_NOT_AVAILABLE), "slot is not available"))
+			render.JSON(w, r, response.Error(string(response.BOOKING_OVERLAP), "student already has a booking at this time"))
 			return
 		}
 
@@ -90,4 +154,3 @@ func responseOK(w http.ResponseWriter, r *http.Request, booking *api.BookingResp
 		Booking: *booking,
 	})
 }
-
@@ -0,0 +1,106 @@
+package restore
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type BookingRestorer interface {
+	RestoreBooking(ctx context.Context, bookingID string) (*api.BookingResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Booking api.BookingResponse `json:"booking,omitempty"`
+}
+
+func New(log *slog.Logger, restorer BookingRestorer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.bookings.restore.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		booking, err := restorer.RestoreBooking(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("deleted booking not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "deleted booking not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrLocked) {
+			log.Error("resource is locked")
+			w.WriteHeader(http.StatusLocked)
+			render.JSON(w, r, response.Error(string(response.LOCKED), "resource is locked"))
+			return
+		}
+
+		if errors.Is(err, response.ErrSlotNotAvailable) {
+			log.Error("slot is no longer available")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.SLOT_NOT_AVAILABLE), "slot is no longer available"))
+			return
+		}
+
+		var overlapErr *response.BookingOverlapError
+		if errors.As(err, &overlapErr) {
+			log.Error("student already has a booking at this time", slog.String("conflicting_booking_id", overlapErr.BookingID))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.ErrorWithDetails(string(response.BOOKING_OVERLAP), "student already has a booking at this time", map[string]any{
+				"conflicting_booking_id": overlapErr.BookingID,
+			}))
+			return
+		}
+
+		if errors.Is(err, response.ErrBookingOverlap) {
+			log.Error("student already has a booking at this time")
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error(string(response.BOOKING_OVERLAP), "student already has a booking at this time"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to restore booking", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to restore booking"))
+			return
+		}
+
+		log.Info("Booking restored", slog.Any("booking", booking))
+		responseOK(w, r, booking)
+	}
+}
+
+func responseOK(w http.ResponseWriter, r *http.Request, booking *api.BookingResponse) {
+	render.JSON(w, r, Response{
+		Booking: *booking,
+	})
+}
@@ -0,0 +1,75 @@
+package feed
+
+import (
+	"rasp-service/pkg/ical"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type CalendarFeeder interface {
+	CalendarFeed(ctx context.Context, ownerType, ownerID, token string) (*ical.Calendar, error)
+}
+
+// New отдаёт ICS-ленту владельца ownerType (teacher или student). Расширение .ics
+// срезает URLFormat, поэтому маршрут регистрируется без него.
+func New(log *slog.Logger, feeder CalendarFeeder, ownerType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.calendars.feed.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		if format, _ := r.Context().Value(chimw.URLFormatCtxKey).(string); format != "ics" {
+			log.Error("unsupported calendar format", slog.String("format", format))
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		cal, err := feeder.CalendarFeed(r.Context(), ownerType, id, r.URL.Query().Get("token"))
+
+		if errors.Is(err, response.ErrInvalidCalendarToken) {
+			log.Error("invalid calendar token", slog.String("owner_id", id))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.INVALID_CALENDAR_TOKEN), "calendar token is missing or invalid"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to build calendar", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to build calendar"))
+			return
+		}
+
+		w.Header().Set("Content-Type", ical.ContentType)
+		w.Header().Set("Content-Disposition", `inline; filename="`+ownerType+`.ics"`)
+		w.Header().Set("Cache-Control", "private, max-age=300")
+
+		if err := cal.Encode(w); err != nil {
+			log.Error("Failed to write calendar", sl.Err(err))
+			return
+		}
+
+		log.Info("Calendar served", slog.String("owner_id", id), slog.Int("events", len(cal.Events)))
+	}
+}
@@ -0,0 +1,85 @@
+package token
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type CalendarTokenIssuer interface {
+	IssueCalendarToken(ctx context.Context, ownerType, ownerID string) (*api.CalendarTokenResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Calendar *api.CalendarTokenResponse `json:"calendar,omitempty"`
+}
+
+// New выпускает (или перевыпускает) токен ICS-ленты и возвращает полную ссылку для подписки
+func New(log *slog.Logger, issuer CalendarTokenIssuer, ownerType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.calendars.token.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		calendar, err := issuer.IssueCalendarToken(r.Context(), ownerType, id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid calendar owner", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "invalid calendar owner"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to issue calendar token", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to issue calendar token"))
+			return
+		}
+
+		calendar.URL = baseURL(r) + calendar.URL
+
+		log.Info("Calendar token issued", slog.String("owner_type", ownerType), slog.String("owner_id", id))
+		w.WriteHeader(http.StatusCreated)
+		render.JSON(w, r, Response{Calendar: calendar})
+	}
+}
+
+// baseURL учитывает X-Forwarded-Proto, чтобы за прокси ссылка была https
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host
+}
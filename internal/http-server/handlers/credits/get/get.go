@@ -0,0 +1,66 @@
+package get
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type CreditGetter interface {
+	ListMakeupCredits(ctx context.Context, studentID string, teacherID *string, includeClosed bool) ([]*api.MakeupCreditResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Credits []api.MakeupCreditResponse `json:"credits"`
+}
+
+func New(log *slog.Logger, getter CreditGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.credits.get.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		studentID := chi.URLParam(r, "id")
+
+		var teacherID *string
+		if v := r.URL.Query().Get("teacher_id"); v != "" {
+			teacherID = &v
+		}
+		// all=true добавляет погашенные и просроченные кредиты
+		includeClosed := r.URL.Query().Get("all") == "true"
+
+		credits, err := getter.ListMakeupCredits(r.Context(), studentID, teacherID, includeClosed)
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list makeup credits", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list makeup credits"))
+			return
+		}
+
+		log.Info("Makeup credits retrieved", slog.Int("count", len(credits)))
+		result := make([]api.MakeupCreditResponse, len(credits))
+		for i, credit := range credits {
+			result[i] = *credit
+		}
+		render.JSON(w, r, Response{Credits: result})
+	}
+}
@@ -0,0 +1,114 @@
+package attendance
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+const defaultReportDays = 30
+
+type AttendanceReporter interface {
+	AttendanceReport(ctx context.Context, groupBy string, teacherID, studentID *string, from, to time.Time) (*api.AttendanceReportResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Report *api.AttendanceReportResponse `json:"report,omitempty"`
+}
+
+func New(log *slog.Logger, reporter AttendanceReporter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.reports.attendance.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		q := r.URL.Query()
+
+		groupBy := q.Get("group_by")
+		if groupBy == "" {
+			groupBy = "student"
+		}
+
+		var teacherID, studentID *string
+		if v := q.Get("teacher_id"); v != "" {
+			teacherID = &v
+		}
+		if v := q.Get("student_id"); v != "" {
+			studentID = &v
+		}
+
+		// по умолчанию — последние 30 дней; дата без времени в to включается целиком
+		to := time.Now().UTC()
+		if v := q.Get("to"); v != "" {
+			t, dateOnly, err := parseTime(v)
+			if err != nil {
+				log.Error("invalid to", sl.Err(err))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "to must be RFC3339 or YYYY-MM-DD"))
+				return
+			}
+			if dateOnly {
+				t = t.AddDate(0, 0, 1)
+			}
+			to = t
+		}
+
+		from := to.AddDate(0, 0, -defaultReportDays)
+		if v := q.Get("from"); v != "" {
+			t, _, err := parseTime(v)
+			if err != nil {
+				log.Error("invalid from", sl.Err(err))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "from must be RFC3339 or YYYY-MM-DD"))
+				return
+			}
+			from = t
+		}
+
+		report, err := reporter.AttendanceReport(r.Context(), groupBy, teacherID, studentID, from, to)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid report request", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "group_by must be one of: student, teacher, week, month; from must be before to and the range at most one year"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to build attendance report", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to build attendance report"))
+			return
+		}
+
+		log.Info("Attendance report built", slog.String("group_by", groupBy), slog.Int("groups", len(report.Groups)))
+		render.JSON(w, r, Response{Report: report})
+	}
+}
+
+func parseTime(v string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, false, nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	return t, true, err
+}
@@ -0,0 +1,98 @@
+package get
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type RestrictionGetter interface {
+	GetStudentRestriction(ctx context.Context, id string) (*api.StudentRestrictionResponse, error)
+	ListStudentRestrictions(ctx context.Context, studentID *string, activeOnly bool) ([]*api.StudentRestrictionResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Restrictions []api.StudentRestrictionResponse `json:"restrictions,omitempty"`
+	Restriction  *api.StudentRestrictionResponse  `json:"restriction,omitempty"`
+}
+
+func New(log *slog.Logger, getter RestrictionGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.restrictions.get.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+
+		if id != "" {
+			// Get by ID
+			restriction, err := getter.GetStudentRestriction(r.Context(), id)
+
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
+			if errors.Is(err, response.ErrNotFound) {
+				log.Error("resource not found")
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+				return
+			}
+
+			if err != nil {
+				log.Error("Failed to get restriction", sl.Err(err))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to get restriction"))
+				return
+			}
+
+			log.Info("Restriction retrieved", slog.Any("restriction", restriction))
+			render.JSON(w, r, Response{Restriction: restriction})
+			return
+		}
+
+		// List
+		var studentID *string
+		if v := r.URL.Query().Get("student_id"); v != "" {
+			studentID = &v
+		}
+		activeOnly := r.URL.Query().Get("active") == "true"
+
+		restrictions, err := getter.ListStudentRestrictions(r.Context(), studentID, activeOnly)
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list restrictions", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list restrictions"))
+			return
+		}
+
+		log.Info("Restrictions retrieved", slog.Int("count", len(restrictions)))
+		result := make([]api.StudentRestrictionResponse, len(restrictions))
+		for i, restriction := range restrictions {
+			result[i] = *restriction
+		}
+		render.JSON(w, r, Response{Restrictions: result})
+	}
+}
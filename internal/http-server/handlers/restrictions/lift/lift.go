@@ -0,0 +1,69 @@
+package lift
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type RestrictionLifter interface {
+	LiftStudentRestriction(ctx context.Context, id string) (*api.StudentRestrictionResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Restriction *api.StudentRestrictionResponse `json:"restriction,omitempty"`
+}
+
+func New(log *slog.Logger, lifter RestrictionLifter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.restrictions.lift.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		restriction, err := lifter.LiftStudentRestriction(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("restriction not found or already lifted")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "restriction not found or already lifted"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to lift restriction", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to lift restriction"))
+			return
+		}
+
+		log.Info("Restriction lifted", slog.String("id", id))
+		render.JSON(w, r, Response{Restriction: restriction})
+	}
+}
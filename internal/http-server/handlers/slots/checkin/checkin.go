@@ -0,0 +1,79 @@
+package checkin
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type CheckInCodeGetter interface {
+	GetCheckInCode(ctx context.Context, slotID string) (*api.CheckInCodeResponse, error)
+}
+
+type Response struct {
+	response.Response
+	CheckIn *api.CheckInCodeResponse `json:"check_in,omitempty"`
+}
+
+func New(log *slog.Logger, getter CheckInCodeGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.slots.checkin.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		code, err := getter.GetCheckInCode(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("slot not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrCheckInClosed) {
+			log.Error("check-in is not open")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			render.JSON(w, r, response.Error(string(response.CHECK_IN_CLOSED), "check-in is open from 15 minutes before the lesson until it ends"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to get check-in code", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to get check-in code"))
+			return
+		}
+
+		// код не должен оседать в кешах и логах прокси
+		w.Header().Set("Cache-Control", "no-store")
+
+		log.Info("Check-in code issued", slog.String("slot_id", id), slog.Time("expires_at", code.ExpiresAt))
+		render.JSON(w, r, Response{CheckIn: code})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"rasp-service/pkg/response"
 	"rasp-service/pkg/sl"
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -54,6 +55,27 @@ func New(log *slog.Logger, generator SlotGenerator) http.HandlerFunc {
 
 		jobID, err := generator.GenerateSlots(r.Context(), &req.SlotGenerateRequest)
 
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid generation range", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "from and to must be YYYY-MM-DD or RFC3339"))
+			return
+		}
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("nothing to generate", sl.Err(err))
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "template not found or teacher has no enabled templates for the range"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to generate slots", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
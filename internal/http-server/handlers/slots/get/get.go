@@ -44,6 +44,13 @@ func New(log *slog.Logger, getter SlotGetter) http.HandlerFunc {
 			if idsStr != "" {
 				ids := strings.Split(idsStr, ",")
 				slots, err := getter.GetSlotsByIDs(r.Context(), ids)
+				if errors.Is(err, response.ErrForbidden) {
+					log.Error("access denied", sl.Err(err))
+					w.WriteHeader(http.StatusForbidden)
+					render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+					return
+				}
+
 				if err != nil {
 					log.Error("Failed to get slots by IDs", sl.Err(err))
 					w.WriteHeader(http.StatusInternalServerError)
@@ -65,6 +72,13 @@ func New(log *slog.Logger, getter SlotGetter) http.HandlerFunc {
 			// Get by ID
 			slot, err := getter.GetSlot(r.Context(), id)
 
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
 			if errors.Is(err, response.ErrNotFound) {
 				log.Error("resource not found")
 				w.WriteHeader(http.StatusNotFound)
@@ -154,6 +168,13 @@ func New(log *slog.Logger, getter SlotGetter) http.HandlerFunc {
 
 		slots, err := getter.ListSlots(r.Context(), filters)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to list slots", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,135 @@
+package stream
+
+import (
+	"rasp-service/internal/models"
+	"rasp-service/internal/slotfeed"
+	"rasp-service/pkg/middleware/auth"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// retryMillis — через сколько браузерный EventSource переподключается после обрыва
+const retryMillis = 3000
+
+type SlotFeed interface {
+	Subscribe(ctx context.Context, teacherID, lastEventID string) (<-chan slotfeed.Event, error)
+}
+
+// New отдаёт изменения слотов преподавателя как Server-Sent Events. Пока событий нет,
+// каждые heartbeat уходит комментарий, чтобы прокси не закрывали соединение.
+// Продолжение — по заголовку Last-Event-ID или параметру last_event_id.
+func New(log *slog.Logger, feed SlotFeed, heartbeat time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.slots.stream.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		// поток идёт мимо сервиса, поэтому право ключа проверяем здесь
+		if p, ok := auth.FromContext(r.Context()); ok && !p.Allows(string(models.ScopeSlotsRead)) {
+			log.Error("access denied", slog.String("api_key_id", p.APIKeyID))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		teacherID := r.URL.Query().Get("teacher_id")
+		if teacherID == "" {
+			log.Error("teacher_id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "teacher_id is required"))
+			return
+		}
+
+		lastEventID := r.Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = r.URL.Query().Get("last_event_id")
+		}
+
+		events, err := feed.Subscribe(r.Context(), teacherID, lastEventID)
+		if err != nil {
+			log.Error("Failed to subscribe to slot feed", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to open slot stream"))
+			return
+		}
+
+		// поток живёт дольше ReadTimeout и WriteTimeout сервера: по истечении
+		// ReadTimeout net/http отменил бы контекст запроса и оборвал поток
+		rc := http.NewResponseController(w)
+		if err := rc.SetReadDeadline(time.Time{}); err != nil {
+			log.Debug("Failed to clear read deadline", sl.Err(err))
+		}
+		if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+			log.Debug("Failed to clear write deadline", sl.Err(err))
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		if _, err := fmt.Fprintf(w, "retry: %d\n\n", retryMillis); err != nil {
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			log.Error("Streaming is not supported", sl.Err(err))
+			return
+		}
+
+		log.Info("Slot stream opened", slog.String("teacher_id", teacherID), slog.String("last_event_id", lastEventID))
+
+		ticker := time.NewTicker(heartbeat)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				if err := write(w, ev); err != nil {
+					return
+				}
+			case <-ticker.C:
+				if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			case <-r.Context().Done():
+				log.Info("Slot stream closed", slog.String("teacher_id", teacherID))
+				return
+			}
+
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func write(w http.ResponseWriter, ev slotfeed.Event) error {
+	if ev.ID != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", ev.ID); err != nil {
+			return err
+		}
+	}
+
+	data := ev.Data
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, data)
+	return err
+}
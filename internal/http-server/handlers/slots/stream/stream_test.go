@@ -0,0 +1,98 @@
+package stream
+
+import (
+	"rasp-service/internal/slotfeed"
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeFeed struct {
+	mu  sync.Mutex
+	ctx context.Context
+}
+
+func (f *fakeFeed) Subscribe(ctx context.Context, _, _ string) (<-chan slotfeed.Event, error) {
+	f.mu.Lock()
+	f.ctx = ctx
+	f.mu.Unlock()
+
+	out := make(chan slotfeed.Event)
+	go func() {
+		<-ctx.Done()
+		close(out)
+	}()
+	return out, nil
+}
+
+func (f *fakeFeed) subscribed() context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ctx
+}
+
+func TestStreamOutlivesServerTimeouts(t *testing.T) {
+	const (
+		serverTimeout = 200 * time.Millisecond
+		heartbeat     = 50 * time.Millisecond
+		hold          = 4 * serverTimeout
+	)
+
+	feed := &fakeFeed{}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	srv := httptest.NewUnstartedServer(New(log, feed, heartbeat))
+	srv.Config.ReadTimeout = serverTimeout
+	srv.Config.WriteTimeout = serverTimeout
+	srv.Start()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "?teacher_id=t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		sc := bufio.NewScanner(resp.Body)
+		for sc.Scan() {
+			lines <- sc.Text()
+		}
+	}()
+
+	deadline := time.After(hold)
+	heartbeats := 0
+	for done := false; !done; {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatalf("stream closed after %d heartbeats, before %s", heartbeats, hold)
+			}
+			if strings.HasPrefix(line, ": heartbeat") {
+				heartbeats++
+			}
+		case <-deadline:
+			done = true
+		}
+	}
+
+	if heartbeats < int(hold/heartbeat)/2 {
+		t.Errorf("heartbeats = %d, want about %d", heartbeats, hold/heartbeat)
+	}
+	if ctx := feed.subscribed(); ctx == nil || ctx.Err() != nil {
+		t.Error("subscription context was cancelled while the stream was open")
+	}
+}
@@ -55,6 +55,13 @@ func New(log *slog.Logger, creator TimeBlockCreator) http.HandlerFunc {
 
 		timeBlock, err := creator.CreateTimeBlock(r.Context(), &req.TimeBlockRequest)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
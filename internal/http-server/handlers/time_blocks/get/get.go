@@ -44,6 +44,13 @@ func New(log *slog.Logger, getter TimeBlockGetter) http.HandlerFunc {
 			// Get by ID
 			timeBlock, err := getter.GetTimeBlock(r.Context(), id)
 
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
 			if errors.Is(err, response.ErrNotFound) {
 				log.Error("resource not found")
 				w.WriteHeader(http.StatusNotFound)
@@ -85,6 +92,13 @@ func New(log *slog.Logger, getter TimeBlockGetter) http.HandlerFunc {
 
 		timeBlocks, err := getter.ListTimeBlocks(r.Context(), teacherIDPtr, from, to)
 
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if err != nil {
 			log.Error("Failed to list time blocks", sl.Err(err))
 			w.WriteHeader(http.StatusInternalServerError)
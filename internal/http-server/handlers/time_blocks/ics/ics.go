@@ -0,0 +1,116 @@
+package ics
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// MaxPayloadBytes — предел размера загружаемого ICS
+const MaxPayloadBytes = 5 << 20
+
+type ExternalCalendarImporter interface {
+	ImportExternalCalendar(ctx context.Context, teacherID string, payload io.Reader, loc *time.Location) (*api.TimeBlockImportResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Import *api.TimeBlockImportResponse `json:"import,omitempty"`
+}
+
+// New принимает тело запроса как ICS (text/calendar). ?tz= задаёт часовой пояс
+// для времён без TZID, по умолчанию UTC.
+func New(log *slog.Logger, importer ExternalCalendarImporter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.time_blocks.ics.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		teacherID := r.URL.Query().Get("teacher_id")
+		if teacherID == "" {
+			log.Error("teacher_id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "teacher_id is required"))
+			return
+		}
+
+		loc := time.UTC
+		if tz := r.URL.Query().Get("tz"); tz != "" {
+			l, err := time.LoadLocation(tz)
+			if err != nil {
+				log.Error("invalid tz", slog.String("tz", tz))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "tz must be an IANA time zone"))
+				return
+			}
+			loc = l
+		}
+
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxPayloadBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				log.Error("payload too large")
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "calendar payload is too large"))
+				return
+			}
+			log.Error("Failed to read request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to read request"))
+			return
+		}
+
+		result, err := importer.ImportExternalCalendar(r.Context(), teacherID, bytes.NewReader(payload), loc)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid calendar payload", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "payload is not a valid iCalendar"))
+			return
+		}
+
+		if errors.Is(err, response.ErrLocked) {
+			log.Error("import already in progress")
+			w.WriteHeader(http.StatusLocked)
+			render.JSON(w, r, response.Error(string(response.LOCKED), "resource is locked"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to import calendar", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to import calendar"))
+			return
+		}
+
+		log.Info("External calendar imported",
+			slog.String("teacher_id", teacherID),
+			slog.Int("created", result.Created),
+			slog.Int("updated", result.Updated),
+			slog.Int("deleted", result.Deleted),
+			slog.Int("skipped", len(result.Skipped)),
+		)
+		render.JSON(w, r, Response{Import: result})
+	}
+}
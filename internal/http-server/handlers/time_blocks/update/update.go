@@ -57,6 +57,20 @@ func New(log *slog.Logger, updater TimeBlockUpdater) http.HandlerFunc {
 
 		timeBlock, err := updater.UpdateTimeBlock(r.Context(), id, &req.TimeBlockRequest)
 
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("external time block cannot be edited", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "external time blocks are managed by calendar import"))
+			return
+		}
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
 		if errors.Is(err, response.ErrNotFound) {
 			log.Error("resource not found")
 			w.WriteHeader(http.StatusNotFound)
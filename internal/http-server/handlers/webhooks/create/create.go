@@ -0,0 +1,84 @@
+package create
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// InvalidSubscriptionMessage — ответ на подписку с неверным адресом или типами событий
+const InvalidSubscriptionMessage = "url must be an absolute http(s) URL and event_types must list supported events: " +
+	"booking.created, booking.confirmed, booking.cancelled, booking.rescheduled, attendance.recorded, slot.generated"
+
+type WebhookSubscriptionCreator interface {
+	CreateWebhookSubscription(ctx context.Context, req *api.WebhookSubscriptionRequest) (*api.WebhookSubscriptionResponse, error)
+}
+
+type Request struct {
+	api.WebhookSubscriptionRequest
+}
+
+type Response struct {
+	response.Response
+	Webhook api.WebhookSubscriptionResponse `json:"webhook,omitempty"`
+}
+
+func New(log *slog.Logger, creator WebhookSubscriptionCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.webhooks.create.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		// секрет в лог не пишем
+		log.Info("Request body decoded", slog.String("url", req.URL), slog.Any("event_types", req.EventTypes))
+
+		sub, err := creator.CreateWebhookSubscription(r.Context(), &req.WebhookSubscriptionRequest)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid webhook subscription", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), InvalidSubscriptionMessage))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to create webhook subscription", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to create webhook subscription"))
+			return
+		}
+
+		log.Info("Webhook subscription created", slog.String("id", sub.ID))
+
+		w.WriteHeader(http.StatusCreated)
+		render.JSON(w, r, Response{
+			Webhook: *sub,
+		})
+	}
+}
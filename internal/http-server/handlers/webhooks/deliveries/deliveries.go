@@ -0,0 +1,103 @@
+package deliveries
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type WebhookDeliveryLister interface {
+	ListWebhookDeliveries(ctx context.Context, subscriptionID string, status *string, limit int) ([]*api.WebhookDeliveryResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Deliveries []api.WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// New отдаёт последние доставки подписки; ?status= фильтрует по статусу, ?limit= ограничивает число
+func New(log *slog.Logger, lister WebhookDeliveryLister) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.webhooks.deliveries.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		query := r.URL.Query()
+
+		var status *string
+		if v := query.Get("status"); v != "" {
+			status = &v
+		}
+
+		limit := 0
+		if v := query.Get("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				log.Error("invalid limit", slog.String("limit", v))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "limit must be a positive integer"))
+				return
+			}
+			limit = n
+		}
+
+		list, err := lister.ListWebhookDeliveries(r.Context(), id, status, limit)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid status", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), "status must be one of: pending, delivered, failed"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list webhook deliveries", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list webhook deliveries"))
+			return
+		}
+
+		log.Info("Webhook deliveries retrieved", slog.Int("count", len(list)))
+		listResponse := make([]api.WebhookDeliveryResponse, len(list))
+		for i, d := range list {
+			listResponse[i] = *d
+		}
+		render.JSON(w, r, Response{
+			Deliveries: listResponse,
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package get
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type WebhookSubscriptionGetter interface {
+	GetWebhookSubscription(ctx context.Context, id string) (*api.WebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]*api.WebhookSubscriptionResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Webhooks []api.WebhookSubscriptionResponse `json:"webhooks,omitempty"`
+	Webhook  *api.WebhookSubscriptionResponse  `json:"webhook,omitempty"`
+}
+
+func New(log *slog.Logger, getter WebhookSubscriptionGetter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.webhooks.get.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+
+		if id != "" {
+			// Get by ID
+			sub, err := getter.GetWebhookSubscription(r.Context(), id)
+
+			if errors.Is(err, response.ErrForbidden) {
+				log.Error("access denied", sl.Err(err))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+				return
+			}
+
+			if errors.Is(err, response.ErrNotFound) {
+				log.Error("resource not found")
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+				return
+			}
+
+			if err != nil {
+				log.Error("Failed to get webhook subscription", sl.Err(err))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to get webhook subscription"))
+				return
+			}
+
+			log.Info("Webhook subscription retrieved", slog.String("id", sub.ID))
+			render.JSON(w, r, Response{
+				Webhook: sub,
+			})
+			return
+		}
+
+		// List
+		list, err := getter.ListWebhookSubscriptions(r.Context())
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to list webhook subscriptions", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to list webhook subscriptions"))
+			return
+		}
+
+		log.Info("Webhook subscriptions retrieved", slog.Int("count", len(list)))
+		listResponse := make([]api.WebhookSubscriptionResponse, len(list))
+		for i, sub := range list {
+			listResponse[i] = *sub
+		}
+		render.JSON(w, r, Response{
+			Webhooks: listResponse,
+		})
+	}
+}
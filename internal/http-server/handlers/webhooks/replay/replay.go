@@ -0,0 +1,73 @@
+package replay
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type WebhookDeliveryReplayer interface {
+	ReplayWebhookDelivery(ctx context.Context, id string) (*api.WebhookDeliveryResponse, error)
+}
+
+type Response struct {
+	response.Response
+	Delivery api.WebhookDeliveryResponse `json:"delivery,omitempty"`
+}
+
+func New(log *slog.Logger, replayer WebhookDeliveryReplayer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.webhooks.replay.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		delivery, err := replayer.ReplayWebhookDelivery(r.Context(), id)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to replay webhook delivery", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to replay webhook delivery"))
+			return
+		}
+
+		log.Info("Webhook delivery queued for replay", slog.String("id", id))
+
+		w.WriteHeader(http.StatusAccepted)
+		render.JSON(w, r, Response{
+			Delivery: *delivery,
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package update
+
+import (
+	"rasp-service/api"
+	"rasp-service/internal/http-server/handlers/webhooks/create"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+type WebhookSubscriptionUpdater interface {
+	UpdateWebhookSubscription(ctx context.Context, id string, req *api.WebhookSubscriptionRequest) (*api.WebhookSubscriptionResponse, error)
+}
+
+type Request struct {
+	api.WebhookSubscriptionRequest
+}
+
+type Response struct {
+	response.Response
+	Webhook api.WebhookSubscriptionResponse `json:"webhook,omitempty"`
+}
+
+func New(log *slog.Logger, updater WebhookSubscriptionUpdater) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.webhooks.update.New"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			log.Error("id is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "id is required"))
+			return
+		}
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("Failed to decode request body", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.BAD_REQUEST), "failed to decode request"))
+			return
+		}
+
+		log.Info("Request body decoded", slog.String("url", req.URL), slog.Any("event_types", req.EventTypes))
+
+		sub, err := updater.UpdateWebhookSubscription(r.Context(), id, &req.WebhookSubscriptionRequest)
+
+		if errors.Is(err, response.ErrForbidden) {
+			log.Error("access denied", sl.Err(err))
+			w.WriteHeader(http.StatusForbidden)
+			render.JSON(w, r, response.Error(string(response.FORBIDDEN), "access denied"))
+			return
+		}
+
+		if errors.Is(err, response.ErrNotFound) {
+			log.Error("resource not found")
+			w.WriteHeader(http.StatusNotFound)
+			render.JSON(w, r, response.Error(string(response.NOT_FOUND), "resource not found"))
+			return
+		}
+
+		if errors.Is(err, response.ErrBadRequest) {
+			log.Error("invalid webhook subscription", sl.Err(err))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error(string(response.INVALID_REQUEST), create.InvalidSubscriptionMessage))
+			return
+		}
+
+		if err != nil {
+			log.Error("Failed to update webhook subscription", sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to update webhook subscription"))
+			return
+		}
+
+		log.Info("Webhook subscription updated", slog.String("id", sub.ID))
+		render.JSON(w, r, Response{
+			Webhook: *sub,
+		})
+	}
+}
@@ -58,6 +58,11 @@ func (r *RedisLock) Unlock(ctx context.Context, key string) error {
 	return nil
 }
 
+// Client отдаёт соединение с Redis другим компонентам, чтобы не открывать второе
+func (r *RedisLock) Client() *redis.Client {
+	return r.client
+}
+
 func (r *RedisLock) Close() error {
 	return r.client.Close()
 }
@@ -33,6 +33,8 @@ const (
 	TimeBlockVacation TimeBlockType = "vacation"
 	TimeBlockSick     TimeBlockType = "sick"
 	TimeBlockOther    TimeBlockType = "other"
+	// TimeBlockExternal — занятость, импортированная из внешнего календаря
+	TimeBlockExternal TimeBlockType = "external"
 )
 
 type TimeBlock struct {
@@ -42,6 +44,8 @@ type TimeBlock struct {
 	End       time.Time      `db:"end"`
 	Reason    string         `db:"reason"`
 	Type      TimeBlockType  `db:"type"`
+	// ExternalUID — UID вхождения события внешнего календаря, только для external
+	ExternalUID *string      `db:"external_uid"`
 	CreatedAt time.Time      `db:"created_at"`
 	UpdatedAt time.Time      `db:"updated_at"`
 }
@@ -67,6 +71,19 @@ type Slot struct {
 	UpdatedAt  time.Time  `db:"updated_at"`
 }
 
+// SlotFilters — фильтр списка слотов; пагинация применяется, только если заданы Page и PerPage
+type SlotFilters struct {
+	TeacherID *string
+	From      *time.Time
+	To        *time.Time
+	Duration  *int
+	Status    *string
+	Q         *string
+	Page      *int
+	PerPage   *int
+	Sort      *string
+}
+
 type BookingStatus string
 
 const (
@@ -75,12 +92,54 @@ const (
 	BookingCancelled BookingStatus = "cancelled"
 )
 
+// Active сообщает, что бронь действует: её можно подтвердить, отменить или перенести
+func (s BookingStatus) Active() bool {
+	return s == BookingPending || s == BookingConfirmed
+}
+
 type Booking struct {
-	ID          string        `db:"id"`
-	SlotID      string        `db:"slot_id"`
-	StudentID   string        `db:"student_id"`
-	TeacherID   string        `db:"teacher_id"`
-	Status      BookingStatus `db:"status"`
+	ID                          string        `db:"id"`
+	SlotID                      string        `db:"slot_id"`
+	StudentID                   string        `db:"student_id"`
+	TeacherID                   string        `db:"teacher_id"`
+	Status                      BookingStatus `db:"status"`
+	RequiresTeacherConfirmation bool          `db:"requires_teacher_confirmation"`
+	DeletedAt                   *time.Time    `db:"deleted_at"`
+}
+
+type BookingEventType string
+
+const (
+	BookingEventCreated     BookingEventType = "created"
+	BookingEventConfirmed   BookingEventType = "confirmed"
+	BookingEventCancelled   BookingEventType = "cancelled"
+	BookingEventRescheduled BookingEventType = "rescheduled"
+	BookingEventDeleted     BookingEventType = "deleted"
+	BookingEventRestored    BookingEventType = "restored"
+)
+
+type BookingEvent struct {
+	ID         string           `db:"id"`
+	BookingID  string           `db:"booking_id"`
+	Type       BookingEventType `db:"event_type"`
+	FromStatus *BookingStatus   `db:"from_status"`
+	ToStatus   *BookingStatus   `db:"to_status"`
+	FromSlotID *string          `db:"from_slot_id"`
+	ToSlotID   *string          `db:"to_slot_id"`
+	Actor      string           `db:"actor"`
+	RequestID  string           `db:"request_id"`
+	CreatedAt  time.Time        `db:"created_at"`
+}
+
+type BookingRules struct {
+	ID                string    `db:"id"`
+	TeacherID         string    `db:"teacher_id"`
+	TemplateID        *string   `db:"template_id"`
+	MinNoticeHours    *int      `db:"min_notice_hours"`
+	MaxAdvanceDays    *int      `db:"max_advance_days"`
+	MaxWeeklyBookings *int      `db:"max_weekly_bookings"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
 }
 
 type AttendanceStatus string
@@ -92,10 +151,309 @@ const (
 )
 
 type Attendance struct {
-	ID        string           `db:"id"`
-	BookingID string           `db:"booking_id"`
-	Status    AttendanceStatus `db:"status"`
-	Notes     string           `db:"notes"`
-	CreatedAt time.Time        `db:"created_at"`
-	UpdatedAt time.Time        `db:"updated_at"`
+	ID          string           `db:"id"`
+	BookingID   string           `db:"booking_id"`
+	Status      AttendanceStatus `db:"status"`
+	Notes       string           `db:"notes"`
+	AutoMarked  bool             `db:"auto_marked"`
+	CheckedInAt *time.Time       `db:"checked_in_at"`
+	Excused     bool             `db:"excused"`
+	CreatedAt   time.Time        `db:"created_at"`
+	UpdatedAt   time.Time        `db:"updated_at"`
+}
+
+type AttendanceReportGroup string
+
+const (
+	ReportGroupStudent AttendanceReportGroup = "student"
+	ReportGroupTeacher AttendanceReportGroup = "teacher"
+	ReportGroupWeek    AttendanceReportGroup = "week"
+	ReportGroupMonth   AttendanceReportGroup = "month"
+)
+
+// AttendanceReportFilter — границы отчёта по времени начала слота, [From, To)
+type AttendanceReportFilter struct {
+	GroupBy   AttendanceReportGroup
+	TeacherID *string
+	StudentID *string
+	From      time.Time
+	To        time.Time
+}
+
+// AttendanceStats — счётчики отметок в одной группе отчёта
+type AttendanceStats struct {
+	Key     string
+	Present int
+	Absent  int
+	Late    int
+}
+
+type RestrictionMode string
+
+const (
+	RestrictionReject              RestrictionMode = "reject"
+	RestrictionRequireConfirmation RestrictionMode = "require_confirmation"
+)
+
+// StudentRestriction — ограничение на запись после серии неявок или поздних отмен
+type StudentRestriction struct {
+	ID                string          `db:"id"`
+	StudentID         string          `db:"student_id"`
+	Mode              RestrictionMode `db:"mode"`
+	Absences          int             `db:"absences"`
+	LateCancellations int             `db:"late_cancellations"`
+	StartsAt          time.Time       `db:"starts_at"`
+	EndsAt            time.Time       `db:"ends_at"`
+	LiftedAt          *time.Time      `db:"lifted_at"`
+	LiftedBy          *string         `db:"lifted_by"`
+	CreatedAt         time.Time       `db:"created_at"`
+}
+
+// Active — ограничение не снято и не истекло к моменту now
+func (r *StudentRestriction) Active(now time.Time) bool {
+	return r.LiftedAt == nil && now.Before(r.EndsAt)
+}
+
+type MakeupCreditReason string
+
+const (
+	CreditExcusedAbsence   MakeupCreditReason = "excused_absence"
+	CreditTeacherCancelled MakeupCreditReason = "teacher_cancelled"
+)
+
+// MakeupCredit — право на бесплатную отработку у того же преподавателя
+type MakeupCredit struct {
+	ID                string             `db:"id"`
+	StudentID         string             `db:"student_id"`
+	TeacherID         string             `db:"teacher_id"`
+	SourceBookingID   string             `db:"source_booking_id"`
+	Reason            MakeupCreditReason `db:"reason"`
+	ExpiresAt         time.Time          `db:"expires_at"`
+	RedeemedBookingID *string            `db:"redeemed_booking_id"`
+	RedeemedAt        *time.Time         `db:"redeemed_at"`
+	CreatedAt         time.Time          `db:"created_at"`
+}
+
+type CalendarOwner string
+
+const (
+	CalendarOwnerTeacher CalendarOwner = "teacher"
+	CalendarOwnerStudent CalendarOwner = "student"
+)
+
+// CalendarBooking — бронь со временем её слота для ICS-ленты
+type CalendarBooking struct {
+	Booking
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at"`
+	// Sequence — число переносов, отмен и восстановлений брони (SEQUENCE в VEVENT)
+	Sequence  int       `db:"sequence"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// EventType — тип доменного события, на который можно подписаться вебхуком
+type EventType string
+
+const (
+	EventBookingCreated     EventType = "booking.created"
+	EventBookingConfirmed   EventType = "booking.confirmed"
+	EventBookingCancelled   EventType = "booking.cancelled"
+	EventBookingRescheduled EventType = "booking.rescheduled"
+	EventAttendanceRecorded EventType = "attendance.recorded"
+	EventSlotGenerated      EventType = "slot.generated"
+	EventSlotStatusChanged  EventType = "slot.status_changed"
+)
+
+// EventTypes — все события, на которые можно подписаться
+var EventTypes = []EventType{
+	EventBookingCreated,
+	EventBookingConfirmed,
+	EventBookingCancelled,
+	EventBookingRescheduled,
+	EventAttendanceRecorded,
+	EventSlotGenerated,
+	EventSlotStatusChanged,
+}
+
+// WebhookSubscription — адрес, на который доставляются события выбранных типов
+type WebhookSubscription struct {
+	ID         string      `db:"id"`
+	URL        string      `db:"url"`
+	Secret     string      `db:"secret"`
+	EventTypes []EventType `db:"event_types"`
+	Enabled    bool        `db:"enabled"`
+	CreatedAt  time.Time   `db:"created_at"`
+	UpdatedAt  time.Time   `db:"updated_at"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery — доставка одного события одной подписке
+type WebhookDelivery struct {
+	ID             string                `db:"id"`
+	SubscriptionID string                `db:"subscription_id"`
+	EventID        string                `db:"event_id"`
+	EventType      EventType             `db:"event_type"`
+	Payload        []byte                `db:"payload"`
+	Status         WebhookDeliveryStatus `db:"status"`
+	Attempts       int                   `db:"attempts"`
+	NextAttemptAt  time.Time             `db:"next_attempt_at"`
+	LastError      *string               `db:"last_error"`
+	LastStatusCode *int                  `db:"last_status_code"`
+	DeliveredAt    *time.Time            `db:"delivered_at"`
+	CreatedAt      time.Time             `db:"created_at"`
+	UpdatedAt      time.Time             `db:"updated_at"`
+}
+
+// WebhookDispatch — доставка, взятая в работу, вместе с адресом и секретом подписки
+type WebhookDispatch struct {
+	WebhookDelivery
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+// OutboxEvent — доменное событие, записанное в транзакции изменения и ожидающее публикации
+type OutboxEvent struct {
+	ID            int64      `db:"id"`
+	EventID       string     `db:"event_id"`
+	EventType     EventType  `db:"event_type"`
+	Payload       []byte     `db:"payload"`
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     *string    `db:"last_error"`
+	PublishedAt   *time.Time `db:"published_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+}
+
+// ReminderRecipient — кому адресовано напоминание об уроке
+type ReminderRecipient string
+
+const (
+	ReminderStudent ReminderRecipient = "student"
+	ReminderTeacher ReminderRecipient = "teacher"
+)
+
+type ReminderStatus string
+
+const (
+	ReminderPending   ReminderStatus = "pending"
+	ReminderSent      ReminderStatus = "sent"
+	ReminderFailed    ReminderStatus = "failed"
+	ReminderCancelled ReminderStatus = "cancelled"
+	// ReminderExpired — урок начался раньше, чем напоминание удалось отправить
+	ReminderExpired ReminderStatus = "expired"
+)
+
+// Reminder — напоминание за Offset до начала урока по подтверждённой брони
+type Reminder struct {
+	ID            string            `db:"id"`
+	BookingID     string            `db:"booking_id"`
+	RecipientRole ReminderRecipient `db:"recipient_role"`
+	RecipientID   string            `db:"recipient_id"`
+	Offset        time.Duration     `db:"offset_minutes"`
+	LessonStart   time.Time         `db:"lesson_start"`
+	SendAt        time.Time         `db:"send_at"`
+	Status        ReminderStatus    `db:"status"`
+	Attempts      int               `db:"attempts"`
+	LastError     *string           `db:"last_error"`
+	SentAt        *time.Time        `db:"sent_at"`
+	CreatedAt     time.Time         `db:"created_at"`
+	UpdatedAt     time.Time         `db:"updated_at"`
+}
+
+// ReminderDispatch — взятое в работу напоминание вместе с данными урока для шаблона
+type ReminderDispatch struct {
+	Reminder
+	SlotID    string
+	StudentID string
+	TeacherID string
+	LessonEnd time.Time
+}
+
+// ConsistencyCheck — проверка целостности данных расписания
+type ConsistencyCheck string
+
+const (
+	// CheckBookedSlotWithoutBooking — слот booked, но активной брони на нём нет
+	CheckBookedSlotWithoutBooking ConsistencyCheck = "booked_slot_without_booking"
+	// CheckBookingSlotMismatch — активная бронь, а слот не booked или ссылается на другую бронь
+	CheckBookingSlotMismatch ConsistencyCheck = "booking_slot_mismatch"
+	// CheckBookingPeriodMismatch — период или преподаватель брони разошлись со слотом
+	CheckBookingPeriodMismatch ConsistencyCheck = "booking_period_mismatch"
+	// CheckSlotOverlap — пересекающиеся слоты одного преподавателя
+	CheckSlotOverlap ConsistencyCheck = "slot_overlap"
+	// CheckSlotBlockMismatch — свободный слот под блоком времени или заблокированный без блока
+	CheckSlotBlockMismatch ConsistencyCheck = "slot_block_mismatch"
+	// CheckAttendanceOnCancelledBooking — отметка посещаемости по отменённой брони
+	CheckAttendanceOnCancelledBooking ConsistencyCheck = "attendance_on_cancelled_booking"
+)
+
+// ConsistencyIssue — расхождение, найденное проверкой целостности
+type ConsistencyIssue struct {
+	Check     ConsistencyCheck
+	EntityID  string
+	TeacherID string
+	Detail    string
+}
+
+// APIKeyScope — право API-ключа на группу операций; право записи включает чтение
+type APIKeyScope string
+
+const (
+	ScopeTemplatesRead     APIKeyScope = "templates:read"
+	ScopeTemplatesWrite    APIKeyScope = "templates:write"
+	ScopeTimeBlocksRead    APIKeyScope = "time_blocks:read"
+	ScopeTimeBlocksWrite   APIKeyScope = "time_blocks:write"
+	ScopeSlotsRead         APIKeyScope = "slots:read"
+	ScopeSlotsGenerate     APIKeyScope = "slots:generate"
+	ScopeBookingsRead      APIKeyScope = "bookings:read"
+	ScopeBookingsWrite     APIKeyScope = "bookings:write"
+	ScopeBookingRulesRead  APIKeyScope = "booking_rules:read"
+	ScopeBookingRulesWrite APIKeyScope = "booking_rules:write"
+	ScopeAttendanceRead    APIKeyScope = "attendance:read"
+	ScopeAttendanceWrite   APIKeyScope = "attendance:write"
+	ScopeCalendarsWrite    APIKeyScope = "calendars:write"
+	ScopeCreditsRead       APIKeyScope = "credits:read"
+	ScopeReportsRead       APIKeyScope = "reports:read"
+	ScopeRestrictionsRead  APIKeyScope = "restrictions:read"
+	ScopeRestrictionsWrite APIKeyScope = "restrictions:write"
+	ScopeWebhooksRead      APIKeyScope = "webhooks:read"
+	ScopeWebhooksWrite     APIKeyScope = "webhooks:write"
+)
+
+// APIKeyScopes — все права, которые можно выдать ключу
+var APIKeyScopes = []APIKeyScope{
+	ScopeTemplatesRead, ScopeTemplatesWrite,
+	ScopeTimeBlocksRead, ScopeTimeBlocksWrite,
+	ScopeSlotsRead, ScopeSlotsGenerate,
+	ScopeBookingsRead, ScopeBookingsWrite,
+	ScopeBookingRulesRead, ScopeBookingRulesWrite,
+	ScopeAttendanceRead, ScopeAttendanceWrite,
+	ScopeCalendarsWrite,
+	ScopeCreditsRead,
+	ScopeReportsRead,
+	ScopeRestrictionsRead, ScopeRestrictionsWrite,
+	ScopeWebhooksRead, ScopeWebhooksWrite,
+}
+
+// APIKey — ключ для межсервисных вызовов; сам ключ не хранится, только его хеш
+type APIKey struct {
+	ID         string        `db:"id"`
+	Name       string        `db:"name"`
+	Prefix     string        `db:"prefix"`
+	KeyHash    string        `db:"key_hash"`
+	Scopes     []APIKeyScope `db:"scopes"`
+	TeacherID  *string       `db:"teacher_id"`
+	ExpiresAt  *time.Time    `db:"expires_at"`
+	LastUsedAt *time.Time    `db:"last_used_at"`
+	CreatedBy  *string       `db:"created_by"`
+	RevokedAt  *time.Time    `db:"revoked_at"`
+	RevokedBy  *string       `db:"revoked_by"`
+	CreatedAt  time.Time     `db:"created_at"`
 }
@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	userAgent = "rasp-service-reminders/1.0"
+	// ReminderHeader — id напоминания; получатель может отбрасывать по нему повторы
+	ReminderHeader = "X-Reminder-ID"
+
+	responseLimit = 64 << 10
+)
+
+// HTTP отправляет сообщение POST-запросом с JSON во внешний сервис уведомлений,
+// который сам знает контакты получателей. Успех — любой ответ 2xx.
+type HTTP struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTP(url string, timeout time.Duration) *HTTP {
+	return &HTTP{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+type httpMessage struct {
+	ReminderID    string    `json:"reminder_id"`
+	BookingID     string    `json:"booking_id"`
+	RecipientRole string    `json:"recipient_role"`
+	RecipientID   string    `json:"recipient_id"`
+	Subject       string    `json:"subject"`
+	Body          string    `json:"body"`
+	LessonStart   time.Time `json:"lesson_start"`
+}
+
+func (n *HTTP) Send(ctx context.Context, msg *Message) error {
+	const op = "notifier.HTTP.Send"
+
+	payload, err := json.Marshal(httpMessage(*msg))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(ReminderHeader, msg.ReminderID)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, responseLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s: unexpected status %d", op, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Log только пишет сообщения в лог; для разработки и как запасной вариант
+type Log struct {
+	log *slog.Logger
+}
+
+func NewLog(log *slog.Logger) *Log {
+	return &Log{log: log.With(slog.String("notifier", "log"))}
+}
+
+func (n *Log) Send(_ context.Context, msg *Message) error {
+	n.log.Info("Reminder",
+		slog.String("reminder_id", msg.ReminderID),
+		slog.String("booking_id", msg.BookingID),
+		slog.String("recipient_role", msg.RecipientRole),
+		slog.String("recipient_id", msg.RecipientID),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body),
+	)
+	return nil
+}
@@ -0,0 +1,164 @@
+// Package notifier — отправка напоминаний об уроках: шаблоны сообщений и провайдеры
+// доставки (лог, SMTP, HTTP).
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"rasp-service/internal/models"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	DefaultSubject = `{{if eq .Role "teacher"}}Урок со студентом{{else}}Урок{{end}} {{.Start.Format "02.01.2006 в 15:04"}}`
+	DefaultBody    = `Напоминаем: через {{human .Offset}} начнётся урок.
+
+Начало: {{.Start.Format "02.01.2006 15:04"}}
+Окончание: {{.End.Format "15:04"}}
+{{if eq .Role "teacher"}}Студент: {{.StudentID}}{{else}}Преподаватель: {{.TeacherID}}{{end}}
+Бронь: {{.BookingID}}
+`
+)
+
+// Message — готовое к отправке напоминание
+type Message struct {
+	ReminderID    string
+	BookingID     string
+	RecipientRole string
+	RecipientID   string
+	Subject       string
+	Body          string
+	LessonStart   time.Time
+}
+
+// Sender — провайдер доставки сообщений
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Data — данные шаблонов; времена переведены в часовой пояс рассылки
+type Data struct {
+	Role        string
+	RecipientID string
+	BookingID   string
+	SlotID      string
+	StudentID   string
+	TeacherID   string
+	Start       time.Time
+	End         time.Time
+	Offset      time.Duration
+}
+
+// Reminders собирает сообщение по шаблонам и передаёт его провайдеру
+type Reminders struct {
+	sender  Sender
+	subject *template.Template
+	body    *template.Template
+	loc     *time.Location
+}
+
+// New разбирает шаблоны subject и body (text/template, данные — Data);
+// пустой шаблон заменяется шаблоном по умолчанию
+func New(sender Sender, subject, body string, loc *time.Location) (*Reminders, error) {
+	const op = "notifier.New"
+
+	if subject == "" {
+		subject = DefaultSubject
+	}
+	if body == "" {
+		body = DefaultBody
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	subjectTpl, err := template.New("subject").Funcs(funcs).Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("%s: subject: %w", op, err)
+	}
+	bodyTpl, err := template.New("body").Funcs(funcs).Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: body: %w", op, err)
+	}
+
+	return &Reminders{sender: sender, subject: subjectTpl, body: bodyTpl, loc: loc}, nil
+}
+
+func (n *Reminders) Notify(ctx context.Context, r *models.ReminderDispatch) error {
+	const op = "notifier.Reminders.Notify"
+
+	data := Data{
+		Role:        string(r.RecipientRole),
+		RecipientID: r.RecipientID,
+		BookingID:   r.BookingID,
+		SlotID:      r.SlotID,
+		StudentID:   r.StudentID,
+		TeacherID:   r.TeacherID,
+		Start:       r.LessonStart.In(n.loc),
+		End:         r.LessonEnd.In(n.loc),
+		Offset:      r.Offset,
+	}
+
+	var subject, body bytes.Buffer
+	if err := n.subject.Execute(&subject, data); err != nil {
+		return fmt.Errorf("%s: subject: %w", op, err)
+	}
+	if err := n.body.Execute(&body, data); err != nil {
+		return fmt.Errorf("%s: body: %w", op, err)
+	}
+
+	msg := &Message{
+		ReminderID:    r.ID,
+		BookingID:     r.BookingID,
+		RecipientRole: data.Role,
+		RecipientID:   r.RecipientID,
+		Subject:       strings.TrimSpace(subject.String()),
+		Body:          body.String(),
+		LessonStart:   r.LessonStart,
+	}
+
+	if err := n.sender.Send(ctx, msg); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+var funcs = template.FuncMap{
+	"human": human,
+}
+
+// human — длительность по-русски: «1 д 2 ч», «24 ч», «30 мин»
+func human(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d <= 0 {
+		return "0 мин"
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+
+	// «через 24 ч» привычнее, чем «через 1 д»
+	if days == 1 && hours == 0 && minutes == 0 {
+		return "24 ч"
+	}
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%d д", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%d ч", hours))
+	}
+	if minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%d мин", minutes))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,104 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// SMTPConfig — параметры почтового сервера. Контактов получателей сервис не хранит,
+// поэтому адрес строится шаблоном AddressTemplate из Data, например
+// "{{.RecipientID}}@school.example".
+type SMTPConfig struct {
+	Host            string
+	Port            int
+	Username        string
+	Password        string
+	From            string
+	AddressTemplate string
+}
+
+// SMTP отправляет сообщение письмом в text/plain; UTF-8
+type SMTP struct {
+	cfg     SMTPConfig
+	address *template.Template
+}
+
+func NewSMTP(cfg SMTPConfig) (*SMTP, error) {
+	const op = "notifier.NewSMTP"
+
+	if cfg.Host == "" || cfg.From == "" || cfg.AddressTemplate == "" {
+		return nil, fmt.Errorf("%s: host, from and address template are required", op)
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+
+	address, err := template.New("address").Parse(cfg.AddressTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("%s: address template: %w", op, err)
+	}
+
+	return &SMTP{cfg: cfg, address: address}, nil
+}
+
+func (n *SMTP) Send(ctx context.Context, msg *Message) error {
+	const op = "notifier.SMTP.Send"
+
+	var to bytes.Buffer
+	err := n.address.Execute(&to, Data{Role: msg.RecipientRole, RecipientID: msg.RecipientID, BookingID: msg.BookingID})
+	if err != nil {
+		return fmt.Errorf("%s: address: %w", op, err)
+	}
+	rcpt := strings.TrimSpace(to.String())
+	if rcpt == "" || strings.ContainsAny(rcpt, "\r\n") {
+		return fmt.Errorf("%s: invalid recipient address %q", op, rcpt)
+	}
+
+	var auth smtp.Auth
+	if n.cfg.Username != "" {
+		auth = smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(n.cfg.Host, strconv.Itoa(n.cfg.Port))
+
+	// net/smtp не принимает контекст: отправка идёт в горутине, а отмена ctx
+	// лишь перестаёт её ждать
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(addr, auth, n.cfg.From, []string{rcpt}, n.compose(rcpt, msg))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+func (n *SMTP) compose(rcpt string, msg *Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", rcpt)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Message-ID: <reminder-%s-%d@%s>\r\n", msg.ReminderID, msg.LessonStart.Unix(), n.cfg.Host)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return b.Bytes()
+}
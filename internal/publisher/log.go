@@ -0,0 +1,25 @@
+package publisher
+
+import (
+	"context"
+	"log/slog"
+	"rasp-service/internal/models"
+)
+
+// Log пишет события в лог; удобен локально и как аудит
+type Log struct {
+	log *slog.Logger
+}
+
+func NewLog(log *slog.Logger) *Log {
+	return &Log{log: log.With(slog.String("publisher", "log"))}
+}
+
+func (p *Log) Publish(_ context.Context, event *models.OutboxEvent) error {
+	p.log.Info("Domain event",
+		slog.String("event_id", event.EventID),
+		slog.String("event_type", string(event.EventType)),
+		slog.String("payload", string(event.Payload)),
+	)
+	return nil
+}
@@ -0,0 +1,29 @@
+// Package publisher — реализации service.EventPublisher: лог, вебхуки и Redis Streams.
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rasp-service/internal/models"
+	"rasp-service/internal/service"
+)
+
+// Fanout публикует событие во все publishers. Ошибка любого из них возвращает
+// событие в outbox, и при повторе его получат все, поэтому потребители отбрасывают дубликаты по id.
+type Fanout []service.EventPublisher
+
+func (f Fanout) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	var errs []error
+	for _, p := range f {
+		if err := p.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("publisher.Fanout: %w", err)
+	}
+
+	return nil
+}
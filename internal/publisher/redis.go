@@ -0,0 +1,47 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/tenant"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStream добавляет события в Redis Stream (XADD) с полями event_id, event_type, payload
+// и tenant_id — поток общий для всех арендаторов.
+// Длина потока приблизительно ограничивается maxLen. Соединение с Redis общее
+// с блокировками и закрывается их владельцем.
+type RedisStream struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+func NewRedisStream(client *redis.Client, stream string, maxLen int64) *RedisStream {
+	return &RedisStream{client: client, stream: stream, maxLen: maxLen}
+}
+
+func (p *RedisStream) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	const op = "publisher.RedisStream.Publish"
+
+	tenantID, _ := tenant.FromContext(ctx)
+
+	err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]any{
+			"event_id":   event.EventID,
+			"event_type": string(event.EventType),
+			"payload":    string(event.Payload),
+			"tenant_id":  tenantID,
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
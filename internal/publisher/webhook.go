@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"rasp-service/internal/models"
+)
+
+type WebhookQueue interface {
+	EnqueueWebhookDeliveries(ctx context.Context, tx *sql.Tx, eventID string, eventType models.EventType, payload []byte) (int, error)
+}
+
+// Webhook раскладывает событие в доставки подписчикам; отправляет их воркер вебхуков.
+// Повторная публикация того же события новых доставок не создаёт.
+type Webhook struct {
+	queue WebhookQueue
+}
+
+func NewWebhook(queue WebhookQueue) *Webhook {
+	return &Webhook{queue: queue}
+}
+
+func (p *Webhook) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	const op = "publisher.Webhook.Publish"
+
+	if _, err := p.queue.EnqueueWebhookDeliveries(ctx, nil, event.EventID, event.EventType, event.Payload); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/auth"
+	"rasp-service/pkg/response"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Access
+//
+// Пользователь запроса берётся из контекста (auth.FromContext). Вызов без него
+// считается системным и ничем не ограничен: фоновые воркеры, raspctl в режиме
+// базы, сервер с выключенной аутентификацией. Админу доступно всё, студенту —
+// только свои брони, преподавателю — свои шаблоны, блокировки, слоты, брони на
+// них и посещаемость по ним. API-ключ действует как админ или, если задан
+// teacher_id, как этот преподаватель, но только в пределах своих прав (scopes).
+
+// restricted возвращает пользователя, на которого действуют ограничения, или nil
+func restricted(ctx context.Context) *auth.Principal {
+	p, ok := auth.FromContext(ctx)
+	if !ok || p.IsAdmin() {
+		return nil
+	}
+	return p
+}
+
+func forbidden(format string, args ...any) error {
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), response.ErrForbidden)
+}
+
+// requireAdmin пропускает только админа и системные вызовы
+func requireAdmin(ctx context.Context) error {
+	if p := restricted(ctx); p != nil {
+		return forbidden("%s %s is not an admin", p.Role, p.Subject)
+	}
+	return nil
+}
+
+// requireScope проверяет право API-ключа на группу операций
+func requireScope(ctx context.Context, scope models.APIKeyScope) error {
+	p, ok := auth.FromContext(ctx)
+	if !ok || p.Allows(string(scope)) {
+		return nil
+	}
+	return forbidden("api key %s has no scope %s", p.APIKeyID, scope)
+}
+
+// requireAdminUser пропускает админа с токеном и системные вызовы: API-ключи
+// не управляют ключами, даже если выданы без ограничения по преподавателю
+func requireAdminUser(ctx context.Context) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	if p, ok := auth.FromContext(ctx); ok && p.IsAPIKey() {
+		return forbidden("api key %s cannot manage api keys", p.APIKeyID)
+	}
+	return nil
+}
+
+// requireTeacher пропускает преподавателя teacherID
+func requireTeacher(ctx context.Context, teacherID string) error {
+	p := restricted(ctx)
+	if p == nil || (p.Role == auth.RoleTeacher && p.Subject == teacherID) {
+		return nil
+	}
+	return forbidden("%s %s cannot manage teacher %s", p.Role, p.Subject, teacherID)
+}
+
+// requireStudent пропускает студента studentID
+func requireStudent(ctx context.Context, studentID string) error {
+	p := restricted(ctx)
+	if p == nil || (p.Role == auth.RoleStudent && p.Subject == studentID) {
+		return nil
+	}
+	return forbidden("%s %s cannot act for student %s", p.Role, p.Subject, studentID)
+}
+
+// requireBookingParty пропускает студента и преподавателя брони
+func requireBookingParty(ctx context.Context, booking *models.Booking) error {
+	p := restricted(ctx)
+	if p == nil {
+		return nil
+	}
+	if (p.Role == auth.RoleStudent && p.Subject == booking.StudentID) ||
+		(p.Role == auth.RoleTeacher && p.Subject == booking.TeacherID) {
+		return nil
+	}
+	return forbidden("%s %s is not a party of booking %s", p.Role, p.Subject, booking.ID)
+}
+
+// actsAsTeacher сообщает, что пользователь действует со стороны преподавателя:
+// это преподаватель (в том числе по его API-ключу) или админ. Системные вызовы
+// без пользователя стороной не считаются.
+func actsAsTeacher(ctx context.Context) bool {
+	p, ok := auth.FromContext(ctx)
+	return ok && (p.IsAdmin() || p.Role == auth.RoleTeacher)
+}
+
+// scopeTeacher сужает список до своего преподавателя: чужой teacher_id запрещён,
+// пустой подставляется. Студентам такие списки недоступны.
+func scopeTeacher(ctx context.Context, teacherID *string) (*string, error) {
+	p := restricted(ctx)
+	if p == nil {
+		return teacherID, nil
+	}
+	if p.Role != auth.RoleTeacher || (teacherID != nil && *teacherID != p.Subject) {
+		return nil, forbidden("%s %s cannot list other teachers' data", p.Role, p.Subject)
+	}
+	return &p.Subject, nil
+}
+
+// scopeParty сужает список броней или посещаемости до своих: студенту
+// подставляется student_id, преподавателю — teacher_id
+func scopeParty(ctx context.Context, studentID, teacherID *string) (*string, *string, error) {
+	p := restricted(ctx)
+	if p == nil {
+		return studentID, teacherID, nil
+	}
+
+	switch p.Role {
+	case auth.RoleStudent:
+		if studentID != nil && *studentID != p.Subject {
+			return nil, nil, forbidden("student %s cannot list other students' data", p.Subject)
+		}
+		return &p.Subject, teacherID, nil
+	case auth.RoleTeacher:
+		if teacherID != nil && *teacherID != p.Subject {
+			return nil, nil, forbidden("teacher %s cannot list other teachers' data", p.Subject)
+		}
+		return studentID, &p.Subject, nil
+	}
+
+	return nil, nil, forbidden("%s %s cannot list bookings", p.Role, p.Subject)
+}
+
+// bookingForAccess читает бронь, в том числе удалённую, чтобы проверить доступ к её истории
+func (s *Service) bookingForAccess(ctx context.Context, bookingID string) (*models.Booking, error) {
+	booking, err := s.store.GetBooking(ctx, bookingID)
+	if errors.Is(err, response.ErrNotFound) {
+		booking, err = s.store.GetDeletedBooking(ctx, nil, bookingID)
+	}
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, response.ErrNotFound
+		}
+		return nil, err
+	}
+	return booking, nil
+}
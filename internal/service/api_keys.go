@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/middleware/auth"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/response"
+	"strings"
+	"time"
+)
+
+const (
+	// apiKeyPrefix отличает API-ключи от прочих секретов в логах и конфигурации
+	apiKeyPrefix = "rk_"
+	// apiKeyShownPrefix — сколько первых символов ключа хранится открыто для узнавания в списке
+	apiKeyShownPrefix = len(apiKeyPrefix) + 8
+)
+
+// API Keys
+
+// CreateAPIKey выпускает ключ для межсервисных вызовов. Ключ возвращается только
+// здесь — в базе хранится его хеш.
+func (s *Service) CreateAPIKey(ctx context.Context, req *api.APIKeyRequest) (*api.APIKeyResponse, error) {
+	const op = "service.CreateAPIKey"
+
+	if err := requireAdminUser(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	scopes, err := validateAPIKey(req, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	secret := apiKeyPrefix + hex.EncodeToString(buf)
+
+	createdBy := actor.FromContext(ctx)
+	key := &models.APIKey{
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    secret[:apiKeyShownPrefix],
+		KeyHash:   hashAPIKey(secret),
+		Scopes:    scopes,
+		TeacherID: req.TeacherID,
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: &createdBy,
+	}
+
+	id, err := s.store.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.store.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp := toAPIKeyResponse(created)
+	resp.Key = secret
+
+	return resp, nil
+}
+
+func (s *Service) GetAPIKey(ctx context.Context, id string) (*api.APIKeyResponse, error) {
+	const op = "service.GetAPIKey"
+
+	if err := requireAdminUser(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key, err := s.store.GetAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toAPIKeyResponse(key), nil
+}
+
+func (s *Service) ListAPIKeys(ctx context.Context) ([]*api.APIKeyResponse, error) {
+	const op = "service.ListAPIKeys"
+
+	if err := requireAdminUser(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys, err := s.store.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, toAPIKeyResponse(key))
+	}
+
+	return result, nil
+}
+
+// RevokeAPIKey отзывает ключ: следующий запрос с ним получит 401. Запись
+// остаётся для аудита; повторный отзыв — ErrNotFound.
+func (s *Service) RevokeAPIKey(ctx context.Context, id string) (*api.APIKeyResponse, error) {
+	const op = "service.RevokeAPIKey"
+
+	if err := requireAdminUser(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.store.RevokeAPIKey(ctx, id, actor.FromContext(ctx)); err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetAPIKey(ctx, id)
+}
+
+// AuthenticateAPIKey превращает ключ в пользователя запроса. Ключ с teacher_id
+// действует как этот преподаватель, без него — как админ; в обоих случаях только
+// в пределах своих прав. Ключ ищется среди ключей арендатора запроса и действует
+// только в нём. Неизвестный, отозванный или истёкший ключ — ErrUnauthorized.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, secret string) (*auth.Principal, error) {
+	const op = "service.AuthenticateAPIKey"
+
+	if !strings.HasPrefix(secret, apiKeyPrefix) {
+		return nil, fmt.Errorf("%s: malformed key: %w", op, response.ErrUnauthorized)
+	}
+
+	key, err := s.store.UseAPIKey(ctx, hashAPIKey(secret), time.Now())
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: unknown, revoked or expired key: %w", op, response.ErrUnauthorized)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	p := &auth.Principal{
+		Subject:  "api-key:" + key.ID,
+		Role:     auth.RoleAdmin,
+		APIKeyID: key.ID,
+		Scopes:   make([]string, len(key.Scopes)),
+	}
+	if id, ok := tenant.FromContext(ctx); ok {
+		p.Tenant = id
+	}
+	for i, scope := range key.Scopes {
+		p.Scopes[i] = string(scope)
+	}
+	if key.TeacherID != nil {
+		p.Subject = *key.TeacherID
+		p.Role = auth.RoleTeacher
+	}
+
+	return p, nil
+}
+
+// validateAPIKey проверяет имя, права и срок действия; возвращает права без повторов
+func validateAPIKey(req *api.APIKeyRequest, now time.Time) ([]models.APIKeyScope, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("name is required: %w", response.ErrBadRequest)
+	}
+	if req.TeacherID != nil && *req.TeacherID == "" {
+		return nil, fmt.Errorf("teacher_id must not be empty: %w", response.ErrBadRequest)
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("expires_at must be in the future: %w", response.ErrBadRequest)
+	}
+	if len(req.Scopes) == 0 {
+		return nil, fmt.Errorf("at least one scope is required: %w", response.ErrBadRequest)
+	}
+
+	known := make(map[models.APIKeyScope]bool, len(models.APIKeyScopes))
+	for _, scope := range models.APIKeyScopes {
+		known[scope] = true
+	}
+
+	seen := make(map[models.APIKeyScope]bool, len(req.Scopes))
+	scopes := make([]models.APIKeyScope, 0, len(req.Scopes))
+	for _, raw := range req.Scopes {
+		scope := models.APIKeyScope(raw)
+		if !known[scope] {
+			return nil, fmt.Errorf("unknown scope %q: %w", raw, response.ErrBadRequest)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
+}
+
+func toAPIKeyResponse(key *models.APIKey) *api.APIKeyResponse {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return &api.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		TeacherID:  key.TeacherID,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedBy:  key.CreatedBy,
+		RevokedAt:  key.RevokedAt,
+		RevokedBy:  key.RevokedBy,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const MaxBulkAttendanceItems = 200
+
+// BulkAttendance отмечает посещаемость пачкой. Сначала проверяются все элементы:
+// если хотя бы один невалиден, ничего не пишется и возвращается ErrBulkValidation
+// вместе с результатами по каждому элементу. Иначе все отметки (и при
+// mark_rest_present — остальные брони преподавателя за день) пишутся в одной транзакции.
+// Существующие отметки по переданным броням перезаписываются.
+func (s *Service) BulkAttendance(ctx context.Context, req *api.AttendanceBulkRequest) (*api.AttendanceBulkResponse, error) {
+	const op = "service.BulkAttendance"
+
+	if err := requireScope(ctx, models.ScopeAttendanceWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(req.Items) > MaxBulkAttendanceItems {
+		return nil, fmt.Errorf("%s: too many items (max %d): %w", op, MaxBulkAttendanceItems, response.ErrBadRequest)
+	}
+
+	var dayStart, dayEnd time.Time
+	if req.Date != "" {
+		d, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid date: %w", op, response.ErrBadRequest)
+		}
+		dayStart, dayEnd = d, d.AddDate(0, 0, 1)
+	}
+
+	if req.MarkRestPresent && (req.TeacherID == "" || req.Date == "") {
+		return nil, fmt.Errorf("%s: teacher_id and date are required for mark_rest_present: %w", op, response.ErrBadRequest)
+	}
+
+	if req.TeacherID != "" {
+		if err := requireTeacher(ctx, req.TeacherID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	now := time.Now()
+	results := make([]api.AttendanceBulkItemResult, len(req.Items))
+	statuses := make([]models.AttendanceStatus, len(req.Items))
+	bookings := make([]*models.Booking, len(req.Items))
+	bookingIDs := make([]string, 0, len(req.Items))
+	seen := make(map[string]struct{}, len(req.Items))
+	invalid := false
+
+	for i, item := range req.Items {
+		results[i] = api.AttendanceBulkItemResult{BookingID: item.BookingID}
+
+		booking, itemErr := s.validateBulkAttendanceItem(ctx, req, item, seen, now)
+		if itemErr != nil {
+			results[i].Error = itemErr
+			invalid = true
+			continue
+		}
+
+		seen[item.BookingID] = struct{}{}
+		statuses[i] = models.AttendanceStatus(item.Status)
+		bookings[i] = booking
+		bookingIDs = append(bookingIDs, item.BookingID)
+	}
+
+	resp := &api.AttendanceBulkResponse{
+		Results:       results,
+		MarkedPresent: []api.AttendanceResponse{},
+	}
+
+	if invalid {
+		return resp, fmt.Errorf("%s: %w", op, response.ErrBulkValidation)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for i, item := range req.Items {
+		id, created, err := s.store.UpsertAttendance(ctx, tx, &models.Attendance{
+			BookingID: item.BookingID,
+			Status:    statuses[i],
+			Notes:     item.Notes,
+			Excused:   item.Excused,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: booking %s: %w", op, item.BookingID, err)
+		}
+
+		if err := s.syncExcusedAbsenceCredit(ctx, tx, bookings[i], statuses[i], item.Excused); err != nil {
+			return nil, fmt.Errorf("%s: booking %s: %w", op, item.BookingID, err)
+		}
+
+		results[i].AttendanceID = id
+		results[i].Status = string(statuses[i])
+		results[i].Created = created
+
+		err = s.publishAttendanceEvent(ctx, tx, &api.AttendanceResponse{
+			ID:        id,
+			BookingID: item.BookingID,
+			Status:    string(statuses[i]),
+			Notes:     item.Notes,
+			Excused:   item.Excused,
+		}, bookings[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: booking %s: %w", op, item.BookingID, err)
+		}
+	}
+
+	if req.MarkRestPresent {
+		marked, err := s.store.MarkRemainingPresent(ctx, tx, req.TeacherID, dayStart, dayEnd, now, bookingIDs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, attendance := range marked {
+			recorded := api.AttendanceResponse{
+				ID:          attendance.ID,
+				BookingID:   attendance.BookingID,
+				Status:      string(attendance.Status),
+				Notes:       attendance.Notes,
+				AutoMarked:  attendance.AutoMarked,
+				CheckedInAt: attendance.CheckedInAt,
+				Excused:     attendance.Excused,
+			}
+			resp.MarkedPresent = append(resp.MarkedPresent, recorded)
+
+			booking, err := s.store.GetBooking(ctx, attendance.BookingID)
+			if err != nil {
+				return nil, fmt.Errorf("%s: booking %s: %w", op, attendance.BookingID, err)
+			}
+			if err := s.publishAttendanceEvent(ctx, tx, &recorded, booking); err != nil {
+				return nil, fmt.Errorf("%s: booking %s: %w", op, attendance.BookingID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+// validateBulkAttendanceItem возвращает бронь элемента либо описание его ошибки
+func (s *Service) validateBulkAttendanceItem(ctx context.Context, req *api.AttendanceBulkRequest, item api.AttendanceRequest, seen map[string]struct{}, now time.Time) (*models.Booking, *api.AttendanceBulkItemError) {
+	if item.BookingID == "" {
+		return nil, bulkItemError(response.INVALID_REQUEST, "booking_id is required")
+	}
+
+	if _, dup := seen[item.BookingID]; dup {
+		return nil, bulkItemError(response.INVALID_REQUEST, "duplicate booking_id in request")
+	}
+
+	status, err := parseAttendanceStatus(item.Status)
+	if err != nil {
+		return nil, bulkItemError(response.INVALID_REQUEST, "status must be one of: present, absent, late")
+	}
+
+	if validateExcused(status, item.Excused) != nil {
+		return nil, bulkItemError(response.INVALID_REQUEST, "only an absence can be excused")
+	}
+
+	booking, err := s.validateAttendanceBooking(ctx, item.BookingID, now)
+	switch {
+	case errors.Is(err, response.ErrNotFound):
+		return nil, bulkItemError(response.NOT_FOUND, "booking not found")
+	case errors.Is(err, response.ErrForbidden):
+		return nil, bulkItemError(response.FORBIDDEN, "booking belongs to another teacher")
+	case errors.Is(err, response.ErrBookingNotConfirmed):
+		return nil, bulkItemError(response.BOOKING_NOT_CONFIRMED, "attendance can only be recorded for confirmed bookings")
+	case errors.Is(err, response.ErrLessonNotStarted):
+		return nil, bulkItemError(response.LESSON_NOT_STARTED, "attendance cannot be recorded before the lesson starts")
+	case err != nil:
+		return nil, bulkItemError(response.FAILED_REQUEST, "failed to validate booking")
+	}
+
+	if req.TeacherID != "" && booking.TeacherID != req.TeacherID {
+		return nil, bulkItemError(response.INVALID_REQUEST, "booking belongs to another teacher")
+	}
+
+	return booking, nil
+}
+
+func bulkItemError(code response.ErrCode, msg string) *api.AttendanceBulkItemError {
+	return &api.AttendanceBulkItemError{Code: string(code), Message: msg}
+}
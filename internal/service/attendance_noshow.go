@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const DefaultNoShowBatchSize = 100
+
+// MarkNoShows проставляет absent с auto_marked=true подтверждённым броням,
+// чей слот закончился больше grace назад, а отметки так и нет.
+// Возвращает количество созданных отметок.
+func (s *Service) MarkNoShows(ctx context.Context, grace time.Duration, limit int) (int, error) {
+	const op = "service.MarkNoShows"
+
+	if limit <= 0 {
+		limit = DefaultNoShowBatchSize
+	}
+
+	bookings, err := s.store.ListUnmarkedEndedBookings(ctx, time.Now().Add(-grace), limit)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	marked := 0
+	for _, booking := range bookings {
+		ok, err := s.markNoShow(ctx, booking)
+		if err != nil {
+			return marked, fmt.Errorf("%s: booking %s: %w", op, booking.ID, err)
+		}
+		if ok {
+			marked++
+		}
+	}
+
+	return marked, nil
+}
+
+// markNoShow в одной транзакции пишет неявку и событие attendance.recorded.
+// false — отметка уже есть.
+func (s *Service) markNoShow(ctx context.Context, booking *models.Booking) (bool, error) {
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	id, err := s.store.CreateAttendance(ctx, tx, &models.Attendance{
+		BookingID:  booking.ID,
+		Status:     models.AttendanceAbsent,
+		AutoMarked: true,
+	})
+	if err != nil {
+		// преподаватель успел отметить сам
+		if errors.Is(err, response.ErrConflict) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if _, err := s.recordedAttendance(ctx, tx, id, booking); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/response"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// Booking Events
+
+// GetBookingHistory возвращает все изменения брони в хронологическом порядке
+func (s *Service) GetBookingHistory(ctx context.Context, bookingID string) ([]*api.BookingEventResponse, error) {
+	const op = "service.GetBookingHistory"
+
+	if err := requireScope(ctx, models.ScopeBookingsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if restricted(ctx) != nil {
+		booking, err := s.bookingForAccess(ctx, bookingID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := requireBookingParty(ctx, booking); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	events, err := s.store.ListBookingEvents(ctx, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// удалённая бронь может остаться только в истории
+	if len(events) == 0 {
+		if _, err := s.store.GetBooking(ctx, bookingID); err != nil {
+			if errors.Is(err, response.ErrNotFound) {
+				return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	result := make([]*api.BookingEventResponse, 0, len(events))
+	for _, event := range events {
+		result = append(result, toBookingEventResponse(event))
+	}
+
+	return result, nil
+}
+
+// recordBookingEvent пишет событие в той же транзакции, что и само изменение брони.
+// Инициатор и request ID берутся из контекста запроса.
+func (s *Service) recordBookingEvent(ctx context.Context, tx *sql.Tx, event *models.BookingEvent) error {
+	event.Actor = actor.FromContext(ctx)
+	event.RequestID = middleware.GetReqID(ctx)
+
+	if err := s.store.CreateBookingEvent(ctx, tx, event); err != nil {
+		return fmt.Errorf("record booking event: %w", err)
+	}
+
+	return nil
+}
+
+func statusPtr(status models.BookingStatus) *models.BookingStatus {
+	return &status
+}
+
+func toBookingEventResponse(event *models.BookingEvent) *api.BookingEventResponse {
+	resp := &api.BookingEventResponse{
+		ID:         event.ID,
+		BookingID:  event.BookingID,
+		Type:       string(event.Type),
+		FromSlotID: event.FromSlotID,
+		ToSlotID:   event.ToSlotID,
+		Actor:      event.Actor,
+		RequestID:  event.RequestID,
+		CreatedAt:  event.CreatedAt,
+	}
+	if event.FromStatus != nil {
+		st := string(*event.FromStatus)
+		resp.FromStatus = &st
+	}
+	if event.ToStatus != nil {
+		st := string(*event.ToStatus)
+		resp.ToStatus = &st
+	}
+
+	return resp
+}
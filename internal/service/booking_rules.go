@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const (
+	RuleMinNotice   = "min_notice"
+	RuleMaxAdvance  = "max_advance"
+	RuleWeeklyQuota = "weekly_quota"
+)
+
+// Booking Rules
+
+func (s *Service) CreateBookingRules(ctx context.Context, req *api.BookingRulesRequest) (*api.BookingRulesResponse, error) {
+	const op = "service.CreateBookingRules"
+
+	if err := requireScope(ctx, models.ScopeBookingRulesWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, req.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules := &models.BookingRules{
+		TeacherID:         req.TeacherID,
+		TemplateID:        req.TemplateID,
+		MinNoticeHours:    req.MinNoticeHours,
+		MaxAdvanceDays:    req.MaxAdvanceDays,
+		MaxWeeklyBookings: req.MaxWeeklyBookings,
+	}
+
+	id, err := s.store.CreateBookingRules(ctx, rules)
+	if err != nil {
+		if errors.Is(err, response.ErrConflict) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrConflict)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetBookingRules(ctx, id)
+}
+
+func (s *Service) GetBookingRules(ctx context.Context, id string) (*api.BookingRulesResponse, error) {
+	const op = "service.GetBookingRules"
+
+	if err := requireScope(ctx, models.ScopeBookingRulesRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules, err := s.store.GetBookingRules(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toBookingRulesResponse(rules), nil
+}
+
+func (s *Service) ListBookingRules(ctx context.Context, teacherID *string) ([]*api.BookingRulesResponse, error) {
+	const op = "service.ListBookingRules"
+
+	if err := requireScope(ctx, models.ScopeBookingRulesRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules, err := s.store.ListBookingRules(ctx, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.BookingRulesResponse, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, toBookingRulesResponse(r))
+	}
+
+	return result, nil
+}
+
+func (s *Service) UpdateBookingRules(ctx context.Context, id string, req *api.BookingRulesRequest) (*api.BookingRulesResponse, error) {
+	const op = "service.UpdateBookingRules"
+
+	if err := requireScope(ctx, models.ScopeBookingRulesWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules, err := s.store.GetBookingRules(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, rules.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := requireTeacher(ctx, req.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules.TeacherID = req.TeacherID
+	rules.TemplateID = req.TemplateID
+	rules.MinNoticeHours = req.MinNoticeHours
+	rules.MaxAdvanceDays = req.MaxAdvanceDays
+	rules.MaxWeeklyBookings = req.MaxWeeklyBookings
+
+	err = s.store.UpdateBookingRules(ctx, rules)
+	if err != nil {
+		if errors.Is(err, response.ErrConflict) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrConflict)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetBookingRules(ctx, id)
+}
+
+func (s *Service) DeleteBookingRules(ctx context.Context, id string) error {
+	const op = "service.DeleteBookingRules"
+
+	if err := requireScope(ctx, models.ScopeBookingRulesWrite); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules, err := s.store.GetBookingRules(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, rules.TeacherID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.store.DeleteBookingRules(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkBookingRules применяет к слоту правила шаблона или преподавателя.
+// Отсутствие правил означает, что ограничений нет; excludeBookingID не учитывается в недельной квоте.
+func (s *Service) checkBookingRules(ctx context.Context, tx *sql.Tx, studentID string, slot *models.Slot, now time.Time, excludeBookingID *string) error {
+	rules, err := s.store.GetEffectiveBookingRules(ctx, tx, slot.TeacherID, slot.TemplateID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get booking rules: %w", err)
+	}
+
+	if rules.MinNoticeHours != nil {
+		notice := time.Duration(*rules.MinNoticeHours) * time.Hour
+		if slot.Start.Sub(now) < notice {
+			return &response.RuleViolationError{
+				Rule:    RuleMinNotice,
+				Limit:   *rules.MinNoticeHours,
+				Message: fmt.Sprintf("slot must be booked at least %d hours in advance", *rules.MinNoticeHours),
+			}
+		}
+	}
+
+	if rules.MaxAdvanceDays != nil {
+		if slot.Start.After(now.AddDate(0, 0, *rules.MaxAdvanceDays)) {
+			return &response.RuleViolationError{
+				Rule:    RuleMaxAdvance,
+				Limit:   *rules.MaxAdvanceDays,
+				Message: fmt.Sprintf("slot cannot be booked more than %d days in advance", *rules.MaxAdvanceDays),
+			}
+		}
+	}
+
+	if rules.MaxWeeklyBookings != nil {
+		// блокировка слота не мешает параллельной брони другого слота пройти ту же квоту
+		if err := s.store.LockStudentBookings(ctx, tx, studentID); err != nil {
+			return fmt.Errorf("lock student bookings: %w", err)
+		}
+
+		weekStart := startOfWeek(slot.Start)
+		count, err := s.store.CountStudentBookings(ctx, tx, studentID, slot.TeacherID, weekStart, weekStart.AddDate(0, 0, 7), excludeBookingID)
+		if err != nil {
+			return fmt.Errorf("count weekly bookings: %w", err)
+		}
+		if count >= *rules.MaxWeeklyBookings {
+			return &response.RuleViolationError{
+				Rule:    RuleWeeklyQuota,
+				Limit:   *rules.MaxWeeklyBookings,
+				Message: fmt.Sprintf("student already has %d active bookings with this teacher this week", count),
+			}
+		}
+	}
+
+	return nil
+}
+
+// startOfWeek возвращает полночь понедельника недели, в которую попадает t
+func startOfWeek(t time.Time) time.Time {
+	day := truncateToDate(t, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7 // 0 = Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+func toBookingRulesResponse(rules *models.BookingRules) *api.BookingRulesResponse {
+	return &api.BookingRulesResponse{
+		ID:                rules.ID,
+		TeacherID:         rules.TeacherID,
+		TemplateID:        rules.TemplateID,
+		MinNoticeHours:    rules.MinNoticeHours,
+		MaxAdvanceDays:    rules.MaxAdvanceDays,
+		MaxWeeklyBookings: rules.MaxWeeklyBookings,
+	}
+}
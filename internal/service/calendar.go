@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/ical"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const (
+	// CalendarPastWindow — насколько назад лента показывает уроки
+	CalendarPastWindow = 30 * 24 * time.Hour
+	// CalendarFutureWindow — насколько вперёд лента показывает уроки и блоки
+	CalendarFutureWindow = 180 * 24 * time.Hour
+
+	calendarProdID    = "-//rasp-service//Schedule//EN"
+	calendarUIDDomain = "rasp-service"
+)
+
+// Calendar Feeds
+
+// IssueCalendarToken выпускает новый токен ICS-ленты; прежняя ссылка перестаёт работать.
+// Токен возвращается только здесь — в базе хранится его хеш. Ссылка несёт арендатора.
+func (s *Service) IssueCalendarToken(ctx context.Context, ownerType, ownerID string) (*api.CalendarTokenResponse, error) {
+	const op = "service.IssueCalendarToken"
+
+	if err := requireScope(ctx, models.ScopeCalendarsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	owner, err := parseCalendarOwner(ownerType)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("%s: owner id is required: %w", op, response.ErrBadRequest)
+	}
+
+	if owner == models.CalendarOwnerTeacher {
+		err = requireTeacher(ctx, ownerID)
+	} else {
+		err = requireStudent(ctx, ownerID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	token := hex.EncodeToString(buf)
+
+	if err := s.store.SetCalendarToken(ctx, owner, ownerID, hashCalendarToken(token)); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// календари не передают заголовков, поэтому арендатор — в самой ссылке
+	tenantID, _ := tenant.FromContext(ctx)
+
+	return &api.CalendarTokenResponse{
+		OwnerType: string(owner),
+		OwnerID:   ownerID,
+		Token:     token,
+		URL: fmt.Sprintf("/calendars/%ss/%s.ics?token=%s&%s=%s",
+			owner, url.PathEscape(ownerID), token, tenant.Query, url.QueryEscape(tenantID)),
+	}, nil
+}
+
+// CalendarFeed собирает ICS-ленту владельца: брони со слотами (включая отменённые)
+// и, для преподавателя, его блоки времени
+func (s *Service) CalendarFeed(ctx context.Context, ownerType, ownerID, token string) (*ical.Calendar, error) {
+	const op = "service.CalendarFeed"
+
+	owner, err := parseCalendarOwner(ownerType)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkCalendarToken(ctx, owner, ownerID, token); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	from, to := now.Add(-CalendarPastWindow), now.Add(CalendarFutureWindow)
+
+	var studentID, teacherID *string
+	if owner == models.CalendarOwnerTeacher {
+		teacherID = &ownerID
+	} else {
+		studentID = &ownerID
+	}
+
+	bookings, err := s.store.ListCalendarBookings(ctx, studentID, teacherID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cal := &ical.Calendar{
+		ProdID: calendarProdID,
+		Name:   fmt.Sprintf("Schedule: %s %s", owner, ownerID),
+		Events: make([]ical.Event, 0, len(bookings)),
+	}
+
+	for _, booking := range bookings {
+		cal.Events = append(cal.Events, bookingEvent(booking, owner))
+	}
+
+	if owner == models.CalendarOwnerTeacher {
+		blocks, err := s.store.ListTimeBlocks(ctx, teacherID, &from, &to)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, block := range blocks {
+			cal.Events = append(cal.Events, timeBlockEvent(block))
+		}
+	}
+
+	return cal, nil
+}
+
+func (s *Service) checkCalendarToken(ctx context.Context, owner models.CalendarOwner, ownerID, token string) error {
+	if token == "" {
+		return response.ErrInvalidCalendarToken
+	}
+
+	stored, err := s.store.GetCalendarTokenHash(ctx, owner, ownerID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return response.ErrInvalidCalendarToken
+		}
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(hashCalendarToken(token))) != 1 {
+		return response.ErrInvalidCalendarToken
+	}
+
+	return nil
+}
+
+// bookingEvent строит VEVENT брони. UID привязан к брони, а не к слоту, поэтому
+// перенос обновляет то же событие; SEQUENCE растёт с каждым переносом и отменой.
+func bookingEvent(booking *models.CalendarBooking, owner models.CalendarOwner) ical.Event {
+	summary := "Lesson with teacher " + booking.TeacherID
+	if owner == models.CalendarOwnerTeacher {
+		summary = "Lesson with student " + booking.StudentID
+	}
+
+	status := ical.StatusTentative
+	switch booking.Status {
+	case models.BookingConfirmed:
+		status = ical.StatusConfirmed
+	case models.BookingCancelled:
+		status = ical.StatusCancelled
+	}
+
+	return ical.Event{
+		UID:          fmt.Sprintf("booking-%s@%s", booking.ID, calendarUIDDomain),
+		Sequence:     booking.Sequence,
+		Stamp:        booking.UpdatedAt,
+		LastModified: booking.UpdatedAt,
+		Start:        booking.StartsAt,
+		End:          booking.EndsAt,
+		Summary:      summary,
+		Description:  fmt.Sprintf("Booking %s, status: %s", booking.ID, booking.Status),
+		Status:       status,
+		Transparency: ical.Opaque,
+	}
+}
+
+func timeBlockEvent(block *models.TimeBlock) ical.Event {
+	summary := "Unavailable: " + string(block.Type)
+	if block.Reason != "" {
+		summary += " (" + block.Reason + ")"
+	}
+
+	return ical.Event{
+		UID:          fmt.Sprintf("block-%s@%s", block.ID, calendarUIDDomain),
+		Stamp:        block.UpdatedAt,
+		LastModified: block.UpdatedAt,
+		Start:        block.Start,
+		End:          block.End,
+		Summary:      summary,
+		Status:       ical.StatusConfirmed,
+		Transparency: ical.Opaque,
+		Categories:   []string{string(block.Type)},
+	}
+}
+
+func parseCalendarOwner(ownerType string) (models.CalendarOwner, error) {
+	switch owner := models.CalendarOwner(ownerType); owner {
+	case models.CalendarOwnerTeacher, models.CalendarOwnerStudent:
+		return owner, nil
+	default:
+		return "", fmt.Errorf("unknown calendar owner %q: %w", ownerType, response.ErrBadRequest)
+	}
+}
+
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/totp"
+	"time"
+)
+
+const (
+	// CheckInCodeStep — время жизни одного кода
+	CheckInCodeStep = 30 * time.Second
+	// CheckInCodeSkew — сколько соседних кодов принимаем, чтобы не терять отметки на границе шага
+	CheckInCodeSkew = 1
+	// CheckInOpensBefore — за сколько до начала урока открывается самоотметка
+	CheckInOpensBefore = 15 * time.Minute
+	// CheckInLateAfter — после этого времени от начала урока отметка ставится как late
+	CheckInLateAfter = 5 * time.Minute
+	// CheckInAttemptInterval — не чаще одной попытки на бронь, чтобы код нельзя было подобрать
+	CheckInAttemptInterval = 2 * time.Second
+)
+
+// Check-in
+
+// GetCheckInCode возвращает текущий код самоотметки для слота. Секрет слота
+// создаётся при первом запросе; код доступен только пока открыта самоотметка.
+func (s *Service) GetCheckInCode(ctx context.Context, slotID string) (*api.CheckInCodeResponse, error) {
+	const op = "service.GetCheckInCode"
+
+	if err := requireScope(ctx, models.ScopeAttendanceWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slot, err := s.store.GetSlot(ctx, slotID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, slot.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	if !checkInOpen(slot, now) {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrCheckInClosed)
+	}
+
+	secret, err := s.slotCheckInSecret(ctx, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	code, err := totp.Generate(secret, now, CheckInCodeStep)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &api.CheckInCodeResponse{
+		SlotID:      slotID,
+		Code:        code,
+		ExpiresAt:   totp.ExpiresAt(now, CheckInCodeStep),
+		StepSeconds: int(CheckInCodeStep / time.Second),
+	}, nil
+}
+
+// CheckIn отмечает студента по коду: present, если он пришёл не позже
+// CheckInLateAfter от начала урока, иначе late. Время отметки сохраняется.
+func (s *Service) CheckIn(ctx context.Context, bookingID, code string) (*api.AttendanceResponse, error) {
+	const op = "service.CheckIn"
+
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// лок не снимаем: он и есть ограничение частоты попыток
+	locked, err := s.locker.Lock(ctx, fmt.Sprintf("checkin:%s", bookingID), CheckInAttemptInterval)
+	if err != nil {
+		return nil, fmt.Errorf("%s: lock error: %w", op, err)
+	}
+	if !locked {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrLocked)
+	}
+
+	booking, err := s.store.GetBooking(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireStudent(ctx, booking.StudentID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if booking.Status != models.BookingConfirmed {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrBookingNotConfirmed)
+	}
+
+	slot, err := s.store.GetSlot(ctx, booking.SlotID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get slot: %w", op, err)
+	}
+
+	now := time.Now()
+	if !checkInOpen(slot, now) {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrCheckInClosed)
+	}
+
+	secret, err := s.slotCheckInSecret(ctx, slot.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !totp.Validate(secret, code, now, CheckInCodeStep, CheckInCodeSkew) {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrInvalidCheckInCode)
+	}
+
+	status := models.AttendancePresent
+	if now.After(slot.Start.Add(CheckInLateAfter)) {
+		status = models.AttendanceLate
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	id, err := s.store.CreateAttendance(ctx, tx, &models.Attendance{
+		BookingID:   bookingID,
+		Status:      status,
+		CheckedInAt: &now,
+	})
+	if err != nil {
+		if errors.Is(err, response.ErrConflict) {
+			existing, getErr := s.store.GetAttendanceByBooking(ctx, bookingID)
+			if getErr != nil {
+				return nil, fmt.Errorf("%s: %w", op, response.ErrAttendanceExists)
+			}
+			return nil, fmt.Errorf("%s: %w", op, &response.AttendanceExistsError{AttendanceID: existing.ID})
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := s.recordedAttendance(ctx, tx, id, booking)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) slotCheckInSecret(ctx context.Context, slotID string) (string, error) {
+	candidate, err := totp.NewSecret()
+	if err != nil {
+		return "", err
+	}
+
+	return s.store.EnsureSlotCheckInSecret(ctx, slotID, candidate)
+}
+
+func checkInOpen(slot *models.Slot, now time.Time) bool {
+	return !now.Before(slot.Start.Add(-CheckInOpensBefore)) && !now.After(slot.End)
+}
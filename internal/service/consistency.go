@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+)
+
+// DefaultConsistencyLimit — сколько расхождений каждой проверки возвращать по умолчанию
+const DefaultConsistencyLimit = 100
+
+// Consistency
+
+// CheckConsistency ищет расхождения между слотами, бронями, блоками времени и
+// посещаемостью. Только сообщает о них, ничего не исправляя.
+func (s *Service) CheckConsistency(ctx context.Context, limit int) ([]*models.ConsistencyIssue, error) {
+	const op = "service.CheckConsistency"
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if limit < 0 {
+		return nil, fmt.Errorf("%s: limit must not be negative: %w", op, response.ErrBadRequest)
+	}
+	if limit == 0 {
+		limit = DefaultConsistencyLimit
+	}
+
+	issues, err := s.store.FindConsistencyIssues(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+// DefaultMakeupCreditTTL — срок жизни кредита на отработку, если он не задан в конфиге
+const DefaultMakeupCreditTTL = 30 * 24 * time.Hour
+
+func WithMakeupCreditTTL(ttl time.Duration) Option {
+	return func(s *Service) {
+		s.creditTTL = ttl
+	}
+}
+
+// Makeup Credits
+
+// ListMakeupCredits возвращает кредиты студента; по умолчанию только открытые
+func (s *Service) ListMakeupCredits(ctx context.Context, studentID string, teacherID *string, includeClosed bool) ([]*api.MakeupCreditResponse, error) {
+	const op = "service.ListMakeupCredits"
+
+	if err := requireScope(ctx, models.ScopeCreditsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	scopedStudent, teacherID, err := scopeParty(ctx, &studentID, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	studentID = *scopedStudent
+
+	var openAt *time.Time
+	if !includeClosed {
+		now := time.Now()
+		openAt = &now
+	}
+
+	credits, err := s.store.ListMakeupCredits(ctx, studentID, teacherID, openAt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.MakeupCreditResponse, 0, len(credits))
+	for _, c := range credits {
+		result = append(result, &api.MakeupCreditResponse{
+			ID:                c.ID,
+			StudentID:         c.StudentID,
+			TeacherID:         c.TeacherID,
+			SourceBookingID:   c.SourceBookingID,
+			Reason:            string(c.Reason),
+			ExpiresAt:         c.ExpiresAt,
+			RedeemedBookingID: c.RedeemedBookingID,
+			RedeemedAt:        c.RedeemedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// issueMakeupCredit выдаёт студенту брони кредит к её преподавателю
+func (s *Service) issueMakeupCredit(ctx context.Context, tx *sql.Tx, booking *models.Booking, reason models.MakeupCreditReason) error {
+	ttl := s.creditTTL
+	if ttl <= 0 {
+		ttl = DefaultMakeupCreditTTL
+	}
+
+	return s.store.IssueMakeupCredit(ctx, tx, &models.MakeupCredit{
+		StudentID:       booking.StudentID,
+		TeacherID:       booking.TeacherID,
+		SourceBookingID: booking.ID,
+		Reason:          reason,
+		ExpiresAt:       time.Now().Add(ttl),
+	})
+}
+
+// syncExcusedAbsenceCredit приводит кредит в соответствие с отметкой: уважительная
+// неявка даёт кредит, любая другая отметка забирает непогашенный
+func (s *Service) syncExcusedAbsenceCredit(ctx context.Context, tx *sql.Tx, booking *models.Booking, status models.AttendanceStatus, excused bool) error {
+	if status == models.AttendanceAbsent && excused {
+		return s.issueMakeupCredit(ctx, tx, booking, models.CreditExcusedAbsence)
+	}
+	return s.store.RevokeMakeupCredit(ctx, tx, booking.ID, models.CreditExcusedAbsence)
+}
+
+func validateExcused(status models.AttendanceStatus, excused bool) error {
+	if excused && status != models.AttendanceAbsent {
+		return fmt.Errorf("only an absence can be excused: %w", response.ErrBadRequest)
+	}
+	return nil
+}
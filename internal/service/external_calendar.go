@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/ical"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const (
+	// ExternalImportHorizon — насколько вперёд раскрываются повторяющиеся события внешнего календаря
+	ExternalImportHorizon = 180 * 24 * time.Hour
+	// MaxExternalOccurrences — предел блоков из одного импорта
+	MaxExternalOccurrences = 5000
+
+	externalImportLockTTL = 30 * time.Second
+	externalUIDLayout     = "20060102T150405Z"
+)
+
+// External Calendars
+
+// ImportExternalCalendar синхронизирует занятость преподавателя из ICS: каждое вхождение
+// события становится блоком external. Ключ блока — UID события, а для повторяющихся —
+// UID и исходное время вхождения, поэтому повторный импорт того же файла ничего не меняет.
+// Внешние блоки в окне синхронизации, которых больше нет в календаре, удаляются; блоки
+// событий, пропущенных из-за MaxExternalOccurrences, остаются как были.
+// Времена без часового пояса трактуются в loc.
+func (s *Service) ImportExternalCalendar(ctx context.Context, teacherID string, payload io.Reader, loc *time.Location) (*api.TimeBlockImportResponse, error) {
+	const op = "service.ImportExternalCalendar"
+
+	if err := requireScope(ctx, models.ScopeTimeBlocksWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if teacherID == "" {
+		return nil, fmt.Errorf("%s: teacher_id is required: %w", op, response.ErrBadRequest)
+	}
+
+	if err := requireTeacher(ctx, teacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cal, invalid, err := ical.Parse(payload, loc)
+	if err != nil {
+		if errors.Is(err, ical.ErrNotCalendar) {
+			return nil, fmt.Errorf("%s: %w: %w", op, err, response.ErrBadRequest)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tenantID, _ := tenant.FromContext(ctx)
+	lockKey := fmt.Sprintf("time_blocks:import:%s:%s", tenantID, teacherID)
+	locked, err := s.locker.Lock(ctx, lockKey, externalImportLockTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: lock error: %w", op, err)
+	}
+	if !locked {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrLocked)
+	}
+	defer func() {
+		_ = s.locker.Unlock(ctx, lockKey)
+	}()
+
+	now := time.Now()
+	from, to := now, now.Add(ExternalImportHorizon)
+
+	resp := &api.TimeBlockImportResponse{
+		TeacherID: teacherID,
+		From:      from,
+		To:        to,
+		Skipped:   []api.TimeBlockImportSkipped{},
+	}
+	for _, ev := range invalid {
+		resp.Skipped = append(resp.Skipped, api.TimeBlockImportSkipped{UID: ev.UID, Reason: ev.Err.Error()})
+	}
+
+	blocks, skipped, truncated := externalBlocks(cal.Events, teacherID, from, to)
+	resp.Skipped = append(resp.Skipped, skipped...)
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	keep := make([]string, 0, len(blocks))
+	refreshTo := to
+	for _, block := range blocks {
+		created, err := s.store.UpsertExternalTimeBlock(ctx, tx, block)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if created {
+			resp.Created++
+		} else {
+			resp.Updated++
+		}
+
+		keep = append(keep, *block.ExternalUID)
+		if block.End.After(refreshTo) {
+			refreshTo = block.End
+		}
+	}
+
+	resp.Deleted, err = s.store.DeleteStaleExternalTimeBlocks(ctx, tx, teacherID, keep, truncated, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.refreshBlockedSlots(ctx, tx, teacherID, from, refreshTo); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+// externalBlocks раскрывает события в блоки окна [from, to). Отменённые и прозрачные
+// (TRANSP:TRANSPARENT — «свободен») события не блокируют время; изменённые вхождения
+// (RECURRENCE-ID) заменяют соответствующие вхождения основного события.
+// truncated — UID событий, не раскрытых из-за MaxExternalOccurrences: их прежние блоки
+// нельзя считать устаревшими.
+func externalBlocks(events []ical.Event, teacherID string, from, to time.Time) ([]*models.TimeBlock, []api.TimeBlockImportSkipped, []string) {
+	var blocks []*models.TimeBlock
+	var skipped []api.TimeBlockImportSkipped
+	var truncated []string
+	seen := make(map[string]struct{})
+
+	overridden := make(map[string]struct{})
+	for _, ev := range events {
+		if !ev.RecurrenceID.IsZero() {
+			overridden[occurrenceUID(ev.UID, ev.RecurrenceID)] = struct{}{}
+		}
+	}
+
+	add := func(ev *ical.Event, uid string, start time.Time) {
+		if _, dup := seen[uid]; dup {
+			return
+		}
+		seen[uid] = struct{}{}
+
+		reason := ev.Summary
+		if reason == "" {
+			reason = "External calendar"
+		}
+
+		blocks = append(blocks, &models.TimeBlock{
+			TeacherID:   teacherID,
+			Start:       start,
+			End:         start.Add(ev.End.Sub(ev.Start)),
+			Reason:      reason,
+			Type:        models.TimeBlockExternal,
+			ExternalUID: &uid,
+		})
+	}
+
+	for i := range events {
+		ev := &events[i]
+
+		if ev.Status == ical.StatusCancelled || ev.Transparency == ical.Transparent {
+			continue
+		}
+		if !ev.End.After(ev.Start) {
+			skipped = append(skipped, api.TimeBlockImportSkipped{UID: ev.UID, Reason: "event has no duration"})
+			continue
+		}
+
+		if !ev.RecurrenceID.IsZero() {
+			if ev.Start.Before(to) && ev.End.After(from) {
+				add(ev, occurrenceUID(ev.UID, ev.RecurrenceID), ev.Start)
+			}
+			continue
+		}
+
+		occurrences, err := ev.Occurrences(from, to, MaxExternalOccurrences-len(blocks))
+		if err != nil {
+			skipped = append(skipped, api.TimeBlockImportSkipped{UID: ev.UID, Reason: err.Error()})
+			if errors.Is(err, ical.ErrTooManyOccurrences) {
+				truncated = append(truncated, ev.UID)
+			}
+			continue
+		}
+
+		for _, start := range occurrences {
+			uid := ev.UID
+			if ev.Rule != nil {
+				uid = occurrenceUID(ev.UID, start)
+				if _, ok := overridden[uid]; ok {
+					continue
+				}
+			}
+			add(ev, uid, start)
+		}
+	}
+
+	return blocks, skipped, truncated
+}
+
+func occurrenceUID(uid string, start time.Time) string {
+	return uid + "/" + start.UTC().Format(externalUIDLayout)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"rasp-service/pkg/ical"
+	"testing"
+	"time"
+)
+
+func mustRule(t *testing.T, rrule string) *ical.Recurrence {
+	t.Helper()
+	rule, err := ical.ParseRecurrence(rrule, time.UTC)
+	if err != nil {
+		t.Fatalf("ParseRecurrence(%q): %v", rrule, err)
+	}
+	return rule
+}
+
+func TestExternalBlocks(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	from, to := start.Add(-time.Hour), time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)
+	moved := start.AddDate(0, 0, 7)
+
+	events := []ical.Event{
+		{UID: "weekly", Start: start, End: start.Add(time.Hour), Summary: "Lecture", Rule: mustRule(t, "FREQ=WEEKLY;COUNT=3")},
+		// второе вхождение перенесено на два часа позже
+		{UID: "weekly", Start: moved.Add(2 * time.Hour), End: moved.Add(3 * time.Hour), RecurrenceID: moved},
+		{UID: "cancelled", Start: start, End: start.Add(time.Hour), Status: ical.StatusCancelled},
+		{UID: "free", Start: start, End: start.Add(time.Hour), Transparency: ical.Transparent},
+		{UID: "instant", Start: start, End: start},
+		// ежедневное событие на 16 лет не помещается в MaxExternalOccurrences
+		{UID: "daily", Start: start, End: start.Add(time.Hour), Rule: mustRule(t, "FREQ=DAILY")},
+	}
+
+	blocks, skipped, truncated := externalBlocks(events, "teacher-1", from, to)
+
+	want := map[string]time.Time{
+		occurrenceUID("weekly", start):                   start,
+		occurrenceUID("weekly", moved):                   moved.Add(2 * time.Hour),
+		occurrenceUID("weekly", start.AddDate(0, 0, 14)): start.AddDate(0, 0, 14),
+	}
+	if len(blocks) != len(want) {
+		t.Fatalf("got %d blocks, want %d", len(blocks), len(want))
+	}
+	for _, b := range blocks {
+		wantStart, ok := want[*b.ExternalUID]
+		if !ok {
+			t.Fatalf("unexpected block %s", *b.ExternalUID)
+		}
+		if !b.Start.Equal(wantStart) || b.End.Sub(b.Start) != time.Hour {
+			t.Errorf("block %s = [%v, %v), want start %v", *b.ExternalUID, b.Start, b.End, wantStart)
+		}
+		if b.TeacherID != "teacher-1" {
+			t.Errorf("block %s teacher = %s", *b.ExternalUID, b.TeacherID)
+		}
+	}
+
+	skippedUIDs := map[string]bool{}
+	for _, s := range skipped {
+		skippedUIDs[s.UID] = true
+	}
+	if len(skipped) != 2 || !skippedUIDs["instant"] || !skippedUIDs["daily"] {
+		t.Errorf("skipped = %+v, want instant and daily", skipped)
+	}
+
+	if len(truncated) != 1 || truncated[0] != "daily" {
+		t.Errorf("truncated = %v, want [daily]", truncated)
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/webhook"
+	"time"
+)
+
+const (
+	DefaultOutboxBatchSize   = 100
+	DefaultOutboxBackoffBase = 5 * time.Second
+	DefaultOutboxBackoffMax  = 10 * time.Minute
+)
+
+// EventPublisher — получатель событий из outbox (лог, вебхуки, Redis Streams).
+// Доставка «хотя бы один раз»: после сбоя событие публикуется повторно
+// с тем же id, поэтому потребители должны отбрасывать дубликаты.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *models.OutboxEvent) error
+}
+
+func WithEventPublisher(publisher EventPublisher) Option {
+	return func(s *Service) {
+		s.publisher = publisher
+	}
+}
+
+// Outbox
+
+// RelayOutbox публикует до limit событий из outbox в порядке записи. События берутся
+// FOR UPDATE SKIP LOCKED в одной транзакции, поэтому несколько релеев не публикуют одно
+// событие одновременно, а при падении релея до коммита события останутся неопубликованными.
+// Неудачная публикация откладывает событие с экспоненциальной задержкой, не задерживая остальные.
+// Возвращает число взятых событий.
+func (s *Service) RelayOutbox(ctx context.Context, limit int) (int, error) {
+	const op = "service.RelayOutbox"
+
+	if s.publisher == nil {
+		return 0, fmt.Errorf("%s: no event publisher configured", op)
+	}
+	if limit <= 0 {
+		limit = DefaultOutboxBatchSize
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	events, err := s.store.ClaimOutboxEvents(ctx, tx, time.Now(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	published := make([]int64, 0, len(events))
+	for _, event := range events {
+		if err := s.publisher.Publish(ctx, event); err != nil {
+			delay := webhook.Backoff(event.Attempts+1, DefaultOutboxBackoffBase, DefaultOutboxBackoffMax)
+			if err := s.store.RescheduleOutboxEvent(ctx, tx, event.ID, time.Now().Add(delay), err.Error()); err != nil {
+				return 0, fmt.Errorf("%s: %w", op, err)
+			}
+			continue
+		}
+		published = append(published, event.ID)
+	}
+
+	if err := s.store.MarkOutboxPublished(ctx, tx, published, time.Now()); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return len(events), nil
+}
+
+// PurgeOutbox удаляет события, опубликованные больше retention назад
+func (s *Service) PurgeOutbox(ctx context.Context, retention time.Duration) (int, error) {
+	const op = "service.PurgeOutbox"
+
+	n, err := s.store.PurgeOutbox(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
+
+// publishEvent записывает событие в outbox. С tx событие появится только вместе
+// с изменением, которое его породило; отправит его релей (RelayOutbox).
+func (s *Service) publishEvent(ctx context.Context, tx *sql.Tx, eventType models.EventType, data any) error {
+	id, err := newEventID()
+	if err != nil {
+		return fmt.Errorf("publish %s: %w", eventType, err)
+	}
+
+	payload, err := json.Marshal(api.WebhookEvent{
+		ID:        id,
+		Type:      string(eventType),
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("publish %s: %w", eventType, err)
+	}
+
+	err = s.store.CreateOutboxEvent(ctx, tx, &models.OutboxEvent{
+		EventID:   id,
+		EventType: eventType,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("publish %s: %w", eventType, err)
+	}
+
+	return nil
+}
+
+// publishBookingEvent публикует состояние брони после изменения; previous — состояние до него
+func (s *Service) publishBookingEvent(ctx context.Context, tx *sql.Tx, eventType models.EventType, booking, previous *models.Booking) error {
+	data := api.BookingEventData{
+		BookingResponse: api.BookingResponse{
+			ID:                          booking.ID,
+			SlotID:                      booking.SlotID,
+			StudentID:                   booking.StudentID,
+			TeacherID:                   booking.TeacherID,
+			Status:                      string(booking.Status),
+			RequiresTeacherConfirmation: booking.RequiresTeacherConfirmation,
+		},
+		Actor: actor.FromContext(ctx),
+	}
+	if previous != nil {
+		if previous.Status != booking.Status {
+			status := string(previous.Status)
+			data.PreviousStatus = &status
+		}
+		if previous.SlotID != booking.SlotID {
+			data.PreviousSlotID = &previous.SlotID
+		}
+	}
+
+	return s.publishEvent(ctx, tx, eventType, data)
+}
+
+func (s *Service) publishAttendanceEvent(ctx context.Context, tx *sql.Tx, attendance *api.AttendanceResponse, booking *models.Booking) error {
+	return s.publishEvent(ctx, tx, models.EventAttendanceRecorded, api.AttendanceEventData{
+		AttendanceResponse: *attendance,
+		SlotID:             booking.SlotID,
+		StudentID:          booking.StudentID,
+		TeacherID:          booking.TeacherID,
+		Actor:              actor.FromContext(ctx),
+	})
+}
+
+// publishSlotEvent публикует смену статуса слота; previous — статус до изменения
+func (s *Service) publishSlotEvent(ctx context.Context, tx *sql.Tx, slot *models.Slot, previous models.SlotStatus) error {
+	if slot.Status == previous {
+		return nil
+	}
+
+	return s.publishEvent(ctx, tx, models.EventSlotStatusChanged, api.SlotEventData{
+		SlotResponse: api.SlotResponse{
+			ID:         slot.ID,
+			Start:      slot.Start,
+			End:        slot.End,
+			TeacherID:  slot.TeacherID,
+			Status:     string(slot.Status),
+			BookingID:  slot.BookingID,
+			TemplateID: slot.TemplateID,
+		},
+		PreviousStatus: string(previous),
+	})
+}
+
+// refreshBlockedSlots пересчитывает blocked-статусы слотов под блоками времени и публикует изменения
+func (s *Service) refreshBlockedSlots(ctx context.Context, tx *sql.Tx, teacherID string, from, to time.Time) error {
+	slots, err := s.store.RefreshBlockedSlots(ctx, tx, teacherID, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		// RefreshBlockedSlots переключает только free <-> blocked
+		previous := models.SlotFree
+		if slot.Status == models.SlotFree {
+			previous = models.SlotBlocked
+		}
+		if err := s.publishSlotEvent(ctx, tx, slot, previous); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newEventID возвращает случайный UUID v4
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+// PenaltyPolicy — когда и как ограничивать запись студента после неявок и поздних отмен.
+// Threshold нарушений за скользящее окно Window включает ограничение на Cooldown.
+type PenaltyPolicy struct {
+	Enabled          bool
+	Threshold        int
+	Window           time.Duration
+	Cooldown         time.Duration
+	LateCancelNotice time.Duration
+	Mode             models.RestrictionMode
+}
+
+func WithPenaltyPolicy(policy PenaltyPolicy) Option {
+	return func(s *Service) {
+		s.penalties = policy
+	}
+}
+
+// Student Restrictions
+
+func (s *Service) ListStudentRestrictions(ctx context.Context, studentID *string, activeOnly bool) ([]*api.StudentRestrictionResponse, error) {
+	const op = "service.ListStudentRestrictions"
+
+	if err := requireScope(ctx, models.ScopeRestrictionsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	var activeAt *time.Time
+	if activeOnly {
+		activeAt = &now
+	}
+
+	restrictions, err := s.store.ListStudentRestrictions(ctx, studentID, activeAt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.StudentRestrictionResponse, 0, len(restrictions))
+	for _, r := range restrictions {
+		result = append(result, toStudentRestrictionResponse(r, now))
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetStudentRestriction(ctx context.Context, id string) (*api.StudentRestrictionResponse, error) {
+	const op = "service.GetStudentRestriction"
+
+	if err := requireScope(ctx, models.ScopeRestrictionsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	restriction, err := s.store.GetStudentRestriction(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toStudentRestrictionResponse(restriction, time.Now()), nil
+}
+
+// LiftStudentRestriction досрочно снимает ограничение. Нарушения до момента
+// наложения снятого ограничения больше не учитываются.
+func (s *Service) LiftStudentRestriction(ctx context.Context, id string) (*api.StudentRestrictionResponse, error) {
+	const op = "service.LiftStudentRestriction"
+
+	if err := requireScope(ctx, models.ScopeRestrictionsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.store.LiftStudentRestriction(ctx, id, actor.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetStudentRestriction(ctx, id)
+}
+
+// checkStudentRestriction проверяет действующее ограничение студента и, если
+// нарушений набралось на порог, накладывает новое. Возвращает true, если бронь
+// должен подтвердить преподаватель; в режиме reject — StudentRestrictedError.
+func (s *Service) checkStudentRestriction(ctx context.Context, tx *sql.Tx, studentID string, now time.Time) (bool, error) {
+	policy := s.penalties
+	if !policy.Enabled || policy.Threshold <= 0 {
+		return false, nil
+	}
+
+	latest, err := s.store.GetLatestStudentRestriction(ctx, tx, studentID)
+	if err != nil && !errors.Is(err, response.ErrNotFound) {
+		return false, fmt.Errorf("get restriction: %w", err)
+	}
+
+	if latest != nil && latest.Active(now) {
+		return restrictionOutcome(latest)
+	}
+
+	// нарушения, за которые уже наказали, повторно не считаем
+	since := now.Add(-policy.Window)
+	if latest != nil && latest.StartsAt.After(since) {
+		since = latest.StartsAt
+	}
+
+	absences, lateCancellations, err := s.store.CountStudentOffenses(ctx, tx, studentID, since, now, policy.LateCancelNotice)
+	if err != nil {
+		return false, fmt.Errorf("count offenses: %w", err)
+	}
+
+	if absences+lateCancellations < policy.Threshold {
+		return false, nil
+	}
+
+	restriction := &models.StudentRestriction{
+		StudentID:         studentID,
+		Mode:              policy.Mode,
+		Absences:          absences,
+		LateCancellations: lateCancellations,
+		StartsAt:          now,
+		EndsAt:            now.Add(policy.Cooldown),
+	}
+
+	// пишем вне транзакции брони: при reject она откатится, а ограничение должно остаться
+	restriction.ID, err = s.store.CreateStudentRestriction(ctx, nil, restriction)
+	if err != nil {
+		return false, fmt.Errorf("create restriction: %w", err)
+	}
+
+	return restrictionOutcome(restriction)
+}
+
+func restrictionOutcome(r *models.StudentRestriction) (bool, error) {
+	if r.Mode == models.RestrictionRequireConfirmation {
+		return true, nil
+	}
+	return false, &response.StudentRestrictedError{RestrictionID: r.ID, Until: r.EndsAt}
+}
+
+func toStudentRestrictionResponse(r *models.StudentRestriction, now time.Time) *api.StudentRestrictionResponse {
+	return &api.StudentRestrictionResponse{
+		ID:                r.ID,
+		StudentID:         r.StudentID,
+		Mode:              string(r.Mode),
+		Absences:          r.Absences,
+		LateCancellations: r.LateCancellations,
+		StartsAt:          r.StartsAt,
+		EndsAt:            r.EndsAt,
+		Active:            r.Active(now),
+		LiftedAt:          r.LiftedAt,
+		LiftedBy:          r.LiftedBy,
+	}
+}
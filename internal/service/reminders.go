@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const (
+	DefaultReminderMaxAttempts = 5
+	DefaultReminderRetryDelay  = 5 * time.Minute
+	DefaultReminderTimeout     = 30 * time.Second
+	DefaultReminderBatchSize   = 100
+)
+
+// Notifier доставляет напоминание получателю
+type Notifier interface {
+	Notify(ctx context.Context, reminder *models.ReminderDispatch) error
+}
+
+// ReminderPolicy — за сколько до начала подтверждённого урока и кому напоминать.
+// Неудачная отправка повторяется через RetryDelay, но не больше MaxAttempts раз.
+type ReminderPolicy struct {
+	Offsets     []time.Duration
+	Recipients  []models.ReminderRecipient
+	MaxAttempts int
+	RetryDelay  time.Duration
+	// Timeout — предел одной отправки
+	Timeout time.Duration
+}
+
+// WithReminders включает напоминания; без notifier или смещений они не планируются
+func WithReminders(policy ReminderPolicy, notifier Notifier) Option {
+	return func(s *Service) {
+		s.reminders = policy
+		s.notifier = notifier
+	}
+}
+
+// reminderPolicy дополняет незаданные поля политики значениями по умолчанию
+func (s *Service) reminderPolicy() ReminderPolicy {
+	p := s.reminders
+	if len(p.Recipients) == 0 {
+		p.Recipients = []models.ReminderRecipient{models.ReminderStudent, models.ReminderTeacher}
+	}
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultReminderMaxAttempts
+	}
+	if p.RetryDelay <= 0 {
+		p.RetryDelay = DefaultReminderRetryDelay
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultReminderTimeout
+	}
+	return p
+}
+
+// Reminders
+
+// scheduleReminders планирует (или переводит на новое время слота) напоминания по
+// подтверждённой брони. Вызывается в транзакции, изменившей бронь.
+func (s *Service) scheduleReminders(ctx context.Context, tx *sql.Tx, bookingID string) error {
+	if s.notifier == nil || len(s.reminders.Offsets) == 0 {
+		return nil
+	}
+
+	policy := s.reminderPolicy()
+
+	minutes := make([]int, 0, len(policy.Offsets))
+	for _, offset := range policy.Offsets {
+		if m := int(offset / time.Minute); m > 0 {
+			minutes = append(minutes, m)
+		}
+	}
+	roles := make([]string, 0, len(policy.Recipients))
+	for _, role := range policy.Recipients {
+		roles = append(roles, string(role))
+	}
+
+	if _, err := s.store.ScheduleReminders(ctx, tx, bookingID, minutes, roles, time.Now()); err != nil {
+		return fmt.Errorf("schedule reminders: %w", err)
+	}
+
+	return nil
+}
+
+// cancelReminders отменяет неотправленные напоминания по брони
+func (s *Service) cancelReminders(ctx context.Context, tx *sql.Tx, bookingID string) error {
+	if _, err := s.store.CancelReminders(ctx, tx, bookingID); err != nil {
+		return fmt.Errorf("cancel reminders: %w", err)
+	}
+
+	return nil
+}
+
+// rescheduleReminders переводит напоминания брони на время нового слота: смещения,
+// время которых уже прошло, отменяются, остальные снова ждут отправки
+func (s *Service) rescheduleReminders(ctx context.Context, tx *sql.Tx, booking *models.Booking) error {
+	if err := s.cancelReminders(ctx, tx, booking.ID); err != nil {
+		return err
+	}
+	if booking.Status != models.BookingConfirmed {
+		return nil
+	}
+
+	return s.scheduleReminders(ctx, tx, booking.ID)
+}
+
+// SendReminders отправляет до limit напоминаний, время которых наступило. Перед отправкой
+// напоминание записывается в журнал; если запись уже есть, оно уходило раньше (например,
+// процесс упал, не успев отметить отправку) и повторно не отправляется. Поэтому после
+// сбоя напоминание скорее потеряется, чем придёт дважды.
+// Возвращает число взятых в работу напоминаний.
+func (s *Service) SendReminders(ctx context.Context, limit int) (int, error) {
+	const op = "service.SendReminders"
+
+	if s.notifier == nil {
+		return 0, fmt.Errorf("%s: no notifier configured", op)
+	}
+	if limit <= 0 {
+		limit = DefaultReminderBatchSize
+	}
+
+	policy := s.reminderPolicy()
+	now := time.Now()
+
+	if _, err := s.store.ExpireReminders(ctx, now); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// аренды хватает на последовательную отправку всей пачки
+	leaseUntil := now.Add(policy.Timeout*time.Duration(limit) + time.Minute)
+
+	batch, err := s.store.ClaimReminders(ctx, now, leaseUntil, limit)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i, r := range batch {
+		if ctx.Err() != nil {
+			// невзятые в работу вернутся в очередь после аренды
+			return i, nil
+		}
+
+		if err := s.attemptReminder(ctx, r, policy); err != nil {
+			return i, fmt.Errorf("%s: reminder %s: %w", op, r.ID, err)
+		}
+
+		if err := s.store.SaveReminderAttempt(ctx, &r.Reminder); err != nil {
+			return i, fmt.Errorf("%s: reminder %s: %w", op, r.ID, err)
+		}
+	}
+
+	return len(batch), nil
+}
+
+// attemptReminder выполняет одну попытку и записывает её итог в r. Ошибка
+// возвращается только при сбое журнала — тогда отправлять нельзя.
+func (s *Service) attemptReminder(ctx context.Context, r *models.ReminderDispatch, policy ReminderPolicy) error {
+	now := time.Now()
+	planned := r.LessonStart.Add(-r.Offset)
+
+	logged, err := s.store.LogReminderDelivery(ctx, &r.Reminder)
+	if err != nil {
+		return err
+	}
+	if !logged {
+		r.Status = models.ReminderSent
+		r.SendAt = planned
+		if r.SentAt == nil {
+			r.SentAt = &now
+		}
+		return nil
+	}
+
+	r.Attempts++
+
+	sendCtx, cancel := context.WithTimeout(ctx, policy.Timeout)
+	err = s.notifier.Notify(sendCtx, r)
+	cancel()
+
+	if err == nil {
+		r.Status = models.ReminderSent
+		r.SendAt = planned
+		r.SentAt = &now
+		r.LastError = nil
+		return nil
+	}
+
+	if delErr := s.store.DeleteReminderDelivery(ctx, &r.Reminder); delErr != nil {
+		return errors.Join(err, delErr)
+	}
+
+	msg := err.Error()
+	r.LastError = &msg
+
+	next := now.Add(policy.RetryDelay)
+	if r.Attempts >= policy.MaxAttempts || !next.Before(r.LessonStart) {
+		r.Status = models.ReminderFailed
+		r.SendAt = planned
+		return nil
+	}
+
+	r.Status = models.ReminderPending
+	r.SendAt = next
+	return nil
+}
+
+// ListBookingReminders возвращает напоминания по брони
+func (s *Service) ListBookingReminders(ctx context.Context, bookingID string) ([]*api.ReminderResponse, error) {
+	const op = "service.ListBookingReminders"
+
+	if err := requireScope(ctx, models.ScopeBookingsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking, err := s.store.GetBooking(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireBookingParty(ctx, booking); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	reminders, err := s.store.ListReminders(ctx, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.ReminderResponse, 0, len(reminders))
+	for _, r := range reminders {
+		result = append(result, &api.ReminderResponse{
+			ID:            r.ID,
+			BookingID:     r.BookingID,
+			RecipientRole: string(r.RecipientRole),
+			RecipientID:   r.RecipientID,
+			OffsetMinutes: int(r.Offset / time.Minute),
+			LessonStart:   r.LessonStart,
+			SendAt:        r.SendAt,
+			Status:        string(r.Status),
+			Attempts:      r.Attempts,
+			LastError:     r.LastError,
+			SentAt:        r.SentAt,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"time"
+)
+
+const MaxReportRange = 366 * 24 * time.Hour
+
+// Reports
+
+// AttendanceReport строит сводку посещаемости за [from, to) по времени начала слота.
+// Опоздание считается посещением: attendance_rate = (present + late) / total.
+// Тренд опозданий идёт помесячно при group_by=month и понедельно в остальных случаях.
+func (s *Service) AttendanceReport(ctx context.Context, groupBy string, teacherID, studentID *string, from, to time.Time) (*api.AttendanceReportResponse, error) {
+	const op = "service.AttendanceReport"
+
+	if err := requireScope(ctx, models.ScopeReportsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	studentID, teacherID, err := scopeParty(ctx, studentID, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	group := models.AttendanceReportGroup(groupBy)
+	switch group {
+	case models.ReportGroupStudent, models.ReportGroupTeacher, models.ReportGroupWeek, models.ReportGroupMonth:
+	default:
+		return nil, fmt.Errorf("%s: invalid group_by %q: %w", op, groupBy, response.ErrBadRequest)
+	}
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%s: from must be before to: %w", op, response.ErrBadRequest)
+	}
+	if to.Sub(from) > MaxReportRange {
+		return nil, fmt.Errorf("%s: range is too long: %w", op, response.ErrBadRequest)
+	}
+
+	filter := models.AttendanceReportFilter{
+		GroupBy:   group,
+		TeacherID: teacherID,
+		StudentID: studentID,
+		From:      from,
+		To:        to,
+	}
+
+	stats, err := s.store.AttendanceReport(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	trendGroup := models.ReportGroupWeek
+	if group == models.ReportGroupMonth {
+		trendGroup = models.ReportGroupMonth
+	}
+
+	trend := stats
+	if group != trendGroup {
+		filter.GroupBy = trendGroup
+		trend, err = s.store.AttendanceReport(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("%s: trend: %w", op, err)
+		}
+	}
+
+	report := &api.AttendanceReportResponse{
+		GroupBy:       groupBy,
+		From:          from,
+		To:            to,
+		Groups:        make([]api.AttendanceStatsResponse, 0, len(stats)),
+		LatenessTrend: make([]api.AttendanceStatsResponse, 0, len(trend)),
+	}
+
+	totals := models.AttendanceStats{Key: "total"}
+	for _, st := range stats {
+		report.Groups = append(report.Groups, toAttendanceStatsResponse(st))
+		totals.Present += st.Present
+		totals.Absent += st.Absent
+		totals.Late += st.Late
+	}
+	report.Totals = toAttendanceStatsResponse(&totals)
+
+	for _, st := range trend {
+		report.LatenessTrend = append(report.LatenessTrend, toAttendanceStatsResponse(st))
+	}
+
+	return report, nil
+}
+
+func toAttendanceStatsResponse(st *models.AttendanceStats) api.AttendanceStatsResponse {
+	total := st.Present + st.Absent + st.Late
+
+	resp := api.AttendanceStatsResponse{
+		Key:     st.Key,
+		Present: st.Present,
+		Absent:  st.Absent,
+		Late:    st.Late,
+		Total:   total,
+	}
+
+	if total > 0 {
+		resp.AttendanceRate = ratio(st.Present+st.Late, total)
+		resp.LateRate = ratio(st.Late, total)
+	}
+
+	return resp
+}
+
+// ratio округляет долю до 4 знаков
+func ratio(part, total int) float64 {
+	return math.Round(float64(part)/float64(total)*10000) / 10000
+}
@@ -8,6 +8,8 @@ import (
 	"rasp-service/api"
 	"rasp-service/internal/lock"
 	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/middleware/auth"
 	"rasp-service/pkg/response"
 	"strconv"
 	"strings"
@@ -17,10 +19,22 @@ import (
 type Service struct {
 	store Store
 	locker lock.Locker
+	penalties PenaltyPolicy
+	creditTTL time.Duration
+	webhooks WebhookPolicy
+	publisher EventPublisher
+	reminders ReminderPolicy
+	notifier Notifier
 }
 
-func NewService(store Store, locker lock.Locker) *Service {
-	return &Service{store: store, locker: locker}
+type Option func(*Service)
+
+func NewService(store Store, locker lock.Locker, opts ...Option) *Service {
+	s := &Service{store: store, locker: locker}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 type Store interface {
@@ -29,6 +43,7 @@ type Store interface {
 	// Availability Templates
 	CreateAvailabilityTemplate(ctx context.Context, template *models.AvailabilityTemplate) (string, error)
 	GetAvailabilityTemplate(ctx context.Context, id string) (*models.AvailabilityTplSlot, error)
+	ListAvailabilityTemplates(ctx context.Context, teacherID string) ([]*models.AvailabilityTplSlot, error)
 	UpdateAvailabilityTemplate(ctx context.Context, template *models.AvailabilityTplSlot) error
 	DeleteAvailabilityTemplate(ctx context.Context, id string) error
 
@@ -38,64 +53,141 @@ type Store interface {
 	ListTimeBlocks(ctx context.Context, teacherID *string, from, to *time.Time) ([]*models.TimeBlock, error)
 	UpdateTimeBlock(ctx context.Context, block *models.TimeBlock) error
 	DeleteTimeBlock(ctx context.Context, id string) error
+	UpsertExternalTimeBlock(ctx context.Context, tx *sql.Tx, block *models.TimeBlock) (bool, error)
+	DeleteStaleExternalTimeBlocks(ctx context.Context, tx *sql.Tx, teacherID string, keepUIDs, keepEvents []string, from, to time.Time) (int, error)
+	RefreshBlockedSlots(ctx context.Context, tx *sql.Tx, teacherID string, from, to time.Time) ([]*models.Slot, error)
 
 	// Slots
 	GetSlot(ctx context.Context, id string) (*models.Slot, error)
 	GetSlotsByIDs(ctx context.Context, ids []string) ([]*models.Slot, error)
 	ListSlots(ctx context.Context, filters interface{}) ([]*models.Slot, error)
 	CreateSlot(ctx context.Context, tx *sql.Tx, slot *models.Slot) (string, error)
-	UpdateSlotStatus(ctx context.Context, slotID string, status models.SlotStatus, bookingID *string) error
-	GetSlotForBooking(ctx context.Context, slotID string) (*models.Slot, error)
+	UpdateSlotStatus(ctx context.Context, tx *sql.Tx, slotID string, status models.SlotStatus, bookingID *string) (*models.Slot, error)
+	GetSlotForBooking(ctx context.Context, tx *sql.Tx, slotID string) (*models.Slot, error)
+	ListAlternativeSlots(ctx context.Context, slotID string, limit int) ([]*models.Slot, error)
+	EnsureSlotCheckInSecret(ctx context.Context, slotID, secret string) (string, error)
 
 	// Bookings
 	CreateBooking(ctx context.Context, tx *sql.Tx, booking *models.Booking) (string, error)
 	GetBooking(ctx context.Context, id string) (*models.Booking, error)
-	ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string) ([]*models.Booking, error)
-	UpdateBookingStatus(ctx context.Context, bookingID string, status models.BookingStatus) error
-	RescheduleBooking(ctx context.Context, tx *sql.Tx, bookingID, newSlotID string) error
-	DeleteBooking(ctx context.Context, bookingID string) error
+	GetBookingForUpdate(ctx context.Context, tx *sql.Tx, id string) (*models.Booking, error)
+	ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string, includeDeleted bool) ([]*models.Booking, error)
+	UpdateBookingStatus(ctx context.Context, tx *sql.Tx, bookingID string, status models.BookingStatus) error
+	RescheduleBooking(ctx context.Context, tx *sql.Tx, bookingID, oldSlotID, newSlotID string, requireConfirmation bool) (*models.Slot, *models.Slot, error)
+	DeleteBooking(ctx context.Context, tx *sql.Tx, bookingID string) error
+	GetDeletedBooking(ctx context.Context, tx *sql.Tx, id string) (*models.Booking, error)
+	RestoreBooking(ctx context.Context, tx *sql.Tx, bookingID string) error
+	FindOverlappingBooking(ctx context.Context, tx *sql.Tx, studentID, slotID string, excludeBookingID *string) (string, error)
+	CountStudentBookings(ctx context.Context, tx *sql.Tx, studentID, teacherID string, from, to time.Time, excludeBookingID *string) (int, error)
+	LockStudentBookings(ctx context.Context, tx *sql.Tx, studentID string) error
+
+	// Booking Events
+	CreateBookingEvent(ctx context.Context, tx *sql.Tx, event *models.BookingEvent) error
+	ListBookingEvents(ctx context.Context, bookingID string) ([]*models.BookingEvent, error)
+
+	// Booking Rules
+	CreateBookingRules(ctx context.Context, rules *models.BookingRules) (string, error)
+	GetBookingRules(ctx context.Context, id string) (*models.BookingRules, error)
+	GetEffectiveBookingRules(ctx context.Context, tx *sql.Tx, teacherID string, templateID *string) (*models.BookingRules, error)
+	ListBookingRules(ctx context.Context, teacherID *string) ([]*models.BookingRules, error)
+	UpdateBookingRules(ctx context.Context, rules *models.BookingRules) error
+	DeleteBookingRules(ctx context.Context, id string) error
+
+	// Student Restrictions
+	CreateStudentRestriction(ctx context.Context, tx *sql.Tx, restriction *models.StudentRestriction) (string, error)
+	GetStudentRestriction(ctx context.Context, id string) (*models.StudentRestriction, error)
+	GetLatestStudentRestriction(ctx context.Context, tx *sql.Tx, studentID string) (*models.StudentRestriction, error)
+	ListStudentRestrictions(ctx context.Context, studentID *string, activeAt *time.Time) ([]*models.StudentRestriction, error)
+	LiftStudentRestriction(ctx context.Context, id, liftedBy string) error
+	CountStudentOffenses(ctx context.Context, tx *sql.Tx, studentID string, since, until time.Time, lateCancelNotice time.Duration) (int, int, error)
+
+	// Makeup Credits
+	IssueMakeupCredit(ctx context.Context, tx *sql.Tx, credit *models.MakeupCredit) error
+	RevokeMakeupCredit(ctx context.Context, tx *sql.Tx, sourceBookingID string, reason models.MakeupCreditReason) error
+	RedeemMakeupCredit(ctx context.Context, tx *sql.Tx, creditID, studentID, teacherID, bookingID string, now time.Time) error
+	ListMakeupCredits(ctx context.Context, studentID string, teacherID *string, openAt *time.Time) ([]*models.MakeupCredit, error)
+
+	// Calendar Feeds
+	SetCalendarToken(ctx context.Context, ownerType models.CalendarOwner, ownerID, tokenHash string) error
+	GetCalendarTokenHash(ctx context.Context, ownerType models.CalendarOwner, ownerID string) (string, error)
+	ListCalendarBookings(ctx context.Context, studentID, teacherID *string, from, to time.Time) ([]*models.CalendarBooking, error)
+
+	// Webhooks
+	CreateWebhookSubscription(ctx context.Context, sub *models.WebhookSubscription) (string, error)
+	GetWebhookSubscription(ctx context.Context, id string) (*models.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	UpdateWebhookSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.WebhookDispatch, error)
+	SaveWebhookAttempt(ctx context.Context, delivery *models.WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, subscriptionID string, status *string, limit int) ([]*models.WebhookDelivery, error)
+	ReplayWebhookDelivery(ctx context.Context, id string, now time.Time) error
+
+	// API Keys
+	CreateAPIKey(ctx context.Context, key *models.APIKey) (string, error)
+	GetAPIKey(ctx context.Context, id string) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*models.APIKey, error)
+	UseAPIKey(ctx context.Context, keyHash string, now time.Time) (*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id, revokedBy string) error
+
+	// Outbox
+	CreateOutboxEvent(ctx context.Context, tx *sql.Tx, event *models.OutboxEvent) error
+	ClaimOutboxEvents(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*models.OutboxEvent, error)
+	MarkOutboxPublished(ctx context.Context, tx *sql.Tx, ids []int64, at time.Time) error
+	RescheduleOutboxEvent(ctx context.Context, tx *sql.Tx, id int64, nextAttemptAt time.Time, lastError string) error
+	PurgeOutbox(ctx context.Context, before time.Time) (int, error)
+
+	// Reminders
+	ScheduleReminders(ctx context.Context, tx *sql.Tx, bookingID string, offsetMinutes []int, roles []string, now time.Time) (int, error)
+	CancelReminders(ctx context.Context, tx *sql.Tx, bookingID string) (int, error)
+	ExpireReminders(ctx context.Context, now time.Time) (int, error)
+	ClaimReminders(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.ReminderDispatch, error)
+	LogReminderDelivery(ctx context.Context, reminder *models.Reminder) (bool, error)
+	DeleteReminderDelivery(ctx context.Context, reminder *models.Reminder) error
+	SaveReminderAttempt(ctx context.Context, reminder *models.Reminder) error
+	ListReminders(ctx context.Context, bookingID string) ([]*models.Reminder, error)
 
 	// Attendance
-	CreateAttendance(ctx context.Context, attendance *models.Attendance) (string, error)
-	GetAttendance(ctx context.Context, id string) (*models.Attendance, error)
+	CreateAttendance(ctx context.Context, tx *sql.Tx, attendance *models.Attendance) (string, error)
+	UpsertAttendance(ctx context.Context, tx *sql.Tx, attendance *models.Attendance) (string, bool, error)
+	GetAttendanceByBooking(ctx context.Context, bookingID string) (*models.Attendance, error)
+	UpdateAttendance(ctx context.Context, tx *sql.Tx, attendance *models.Attendance) error
+	DeleteAttendance(ctx context.Context, tx *sql.Tx, id string) error
+	ListUnmarkedEndedBookings(ctx context.Context, endedBefore time.Time, limit int) ([]*models.Booking, error)
+	MarkRemainingPresent(ctx context.Context, tx *sql.Tx, teacherID string, from, to, now time.Time, excludeBookingIDs []string) ([]*models.Attendance, error)
+	GetAttendance(ctx context.Context, tx *sql.Tx, id string) (*models.Attendance, error)
 	ListAttendance(ctx context.Context, teacherID *string, from, to *time.Time) ([]*models.Attendance, error)
-}
+	AttendanceReport(ctx context.Context, filter models.AttendanceReportFilter) ([]*models.AttendanceStats, error)
 
-type SlotFilters struct {
-	TeacherID *string
-	From      *time.Time
-	To        *time.Time
-	Duration  *int
-	Status    *string
-	Q         *string
-	Page      *int
-	PerPage   *int
-	Sort      *string
+	// Consistency
+	FindConsistencyIssues(ctx context.Context, limit int) ([]*models.ConsistencyIssue, error)
 }
 
+const (
+	DefaultAlternativeSlots = 3
+	MaxAlternativeSlots     = 20
+)
+
+// SlotFilters — тот же тип, что разбирает хранилище
+type SlotFilters = models.SlotFilters
+
 // Availability Templates
 
 func (s *Service) CreateAvailabilityTemplate(ctx context.Context, req *api.AvailabilityTemplateRequest) (*api.AvailabilityTemplateResponse, error) {
 	const op = "service.CreateAvailabilityTemplate"
 
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
-	if err != nil {
-		return nil, fmt.Errorf("%s: invalid start_date: %w", op, err)
-	}
-
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
-	if err != nil {
-		return nil, fmt.Errorf("%s: invalid end_date: %w", op, err)
+	if err := requireScope(ctx, models.ScopeTemplatesWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	startTime, err := time.Parse("15:04", req.Recurrence.StartTime)
-	if err != nil {
-		return nil, fmt.Errorf("%s: invalid start_time: %w", op, err)
+	if err := requireTeacher(ctx, req.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	endTime, err := time.Parse("15:04", req.Recurrence.EndTime)
+	startDate, endDate, startTime, endTime, err := parseTemplateSchedule(req)
 	if err != nil {
-		return nil, fmt.Errorf("%s: invalid end_time: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	template := &models.AvailabilityTemplate{
@@ -117,9 +209,39 @@ func (s *Service) CreateAvailabilityTemplate(ctx context.Context, req *api.Avail
 	return s.GetAvailabilityTemplate(ctx, id)
 }
 
+// parseTemplateSchedule разбирает даты и время шаблона. Ошибка оборачивает
+// response.ErrBadRequest: неверный формат, end_date раньше start_date или
+// end_time не позже start_time.
+func parseTemplateSchedule(req *api.AvailabilityTemplateRequest) (startDate, endDate, startTime, endTime time.Time, err error) {
+	if startDate, err = time.Parse("2006-01-02", req.StartDate); err != nil {
+		return startDate, endDate, startTime, endTime, fmt.Errorf("invalid start_date %q: %w", req.StartDate, response.ErrBadRequest)
+	}
+	if endDate, err = time.Parse("2006-01-02", req.EndDate); err != nil {
+		return startDate, endDate, startTime, endTime, fmt.Errorf("invalid end_date %q: %w", req.EndDate, response.ErrBadRequest)
+	}
+	if endDate.Before(startDate) {
+		return startDate, endDate, startTime, endTime, fmt.Errorf("end_date is before start_date: %w", response.ErrBadRequest)
+	}
+	if startTime, err = time.Parse("15:04", req.Recurrence.StartTime); err != nil {
+		return startDate, endDate, startTime, endTime, fmt.Errorf("invalid start_time %q: %w", req.Recurrence.StartTime, response.ErrBadRequest)
+	}
+	if endTime, err = time.Parse("15:04", req.Recurrence.EndTime); err != nil {
+		return startDate, endDate, startTime, endTime, fmt.Errorf("invalid end_time %q: %w", req.Recurrence.EndTime, response.ErrBadRequest)
+	}
+	if !endTime.After(startTime) {
+		return startDate, endDate, startTime, endTime, fmt.Errorf("end_time is not after start_time: %w", response.ErrBadRequest)
+	}
+
+	return startDate, endDate, startTime, endTime, nil
+}
+
 func (s *Service) GetAvailabilityTemplate(ctx context.Context, id string) (*api.AvailabilityTemplateResponse, error) {
 	const op = "service.GetAvailabilityTemplate"
 
+	if err := requireScope(ctx, models.ScopeTemplatesRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	template, err := s.store.GetAvailabilityTemplate(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -128,6 +250,10 @@ func (s *Service) GetAvailabilityTemplate(ctx context.Context, id string) (*api.
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireTeacher(ctx, template.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	startTime := template.RecurrenceStartTime
 	endTime :=  template.RecurrenceEndTime
 
@@ -149,6 +275,10 @@ func (s *Service) GetAvailabilityTemplate(ctx context.Context, id string) (*api.
 func (s *Service) UpdateAvailabilityTemplate(ctx context.Context, id string, req *api.AvailabilityTemplateRequest) (*api.AvailabilityTemplateResponse, error) {
 	const op = "service.UpdateAvailabilityTemplate"
 
+	if err := requireScope(ctx, models.ScopeTemplatesWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	template, err := s.store.GetAvailabilityTemplate(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -157,24 +287,17 @@ func (s *Service) UpdateAvailabilityTemplate(ctx context.Context, id string, req
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
-	if err != nil {
-		return nil, fmt.Errorf("%s: invalid start_date: %w", op, err)
-	}
-
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
-	if err != nil {
-		return nil, fmt.Errorf("%s: invalid end_date: %w", op, err)
+	if err := requireTeacher(ctx, template.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-
-	startTime, err := time.Parse("15:04", req.Recurrence.StartTime)
-	if err != nil {
-		return nil, fmt.Errorf("%s: invalid start_time: %w", op, err)
+	// сменить владельца шаблона может только админ
+	if err := requireTeacher(ctx, req.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	endTime, err := time.Parse("15:04", req.Recurrence.EndTime)
+	startDate, endDate, startTime, endTime, err := parseTemplateSchedule(req)
 	if err != nil {
-		return nil, fmt.Errorf("%s: invalid end_time: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	template.TeacherID = req.TeacherID
@@ -197,7 +320,23 @@ func (s *Service) UpdateAvailabilityTemplate(ctx context.Context, id string, req
 func (s *Service) DeleteAvailabilityTemplate(ctx context.Context, id string) error {
 	const op = "service.DeleteAvailabilityTemplate"
 
-	err := s.store.DeleteAvailabilityTemplate(ctx, id)
+	if err := requireScope(ctx, models.ScopeTemplatesWrite); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	template, err := s.store.GetAvailabilityTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, template.TeacherID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.store.DeleteAvailabilityTemplate(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
 			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
@@ -213,6 +352,14 @@ func (s *Service) DeleteAvailabilityTemplate(ctx context.Context, id string) err
 func (s *Service) CreateTimeBlock(ctx context.Context, req *api.TimeBlockRequest) (*api.TimeBlockResponse, error) {
 	const op = "service.CreateTimeBlock"
 
+	if err := requireScope(ctx, models.ScopeTimeBlocksWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, req.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	start, err := time.Parse(time.RFC3339, req.Start)
 	if err != nil {
 		return nil, fmt.Errorf("%s: invalid start: %w", op, err)
@@ -241,12 +388,20 @@ func (s *Service) CreateTimeBlock(ctx context.Context, req *api.TimeBlockRequest
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := s.refreshBlockedSlots(ctx, nil, block.TeacherID, block.Start, block.End); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return s.GetTimeBlock(ctx, id)
 }
 
 func (s *Service) GetTimeBlock(ctx context.Context, id string) (*api.TimeBlockResponse, error) {
 	const op = "service.GetTimeBlock"
 
+	if err := requireScope(ctx, models.ScopeTimeBlocksRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	block, err := s.store.GetTimeBlock(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -255,19 +410,33 @@ func (s *Service) GetTimeBlock(ctx context.Context, id string) (*api.TimeBlockRe
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireTeacher(ctx, block.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &api.TimeBlockResponse{
 		ID:        block.ID,
 		TeacherID: block.TeacherID,
 		Start:     block.Start,
 		End:       block.End,
-		Reason:    block.Reason,
-		Type:      string(block.Type),
+		Reason:      block.Reason,
+		Type:        string(block.Type),
+		ExternalUID: block.ExternalUID,
 	}, nil
 }
 
 func (s *Service) ListTimeBlocks(ctx context.Context, teacherID *string, from, to *time.Time) ([]*api.TimeBlockResponse, error) {
 	const op = "service.ListTimeBlocks"
 
+	if err := requireScope(ctx, models.ScopeTimeBlocksRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teacherID, err := scopeTeacher(ctx, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	blocks, err := s.store.ListTimeBlocks(ctx, teacherID, from, to)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -280,8 +449,9 @@ func (s *Service) ListTimeBlocks(ctx context.Context, teacherID *string, from, t
 			TeacherID: block.TeacherID,
 			Start:     block.Start,
 			End:       block.End,
-			Reason:    block.Reason,
-			Type:      string(block.Type),
+			Reason:      block.Reason,
+			Type:        string(block.Type),
+			ExternalUID: block.ExternalUID,
 		})
 	}
 
@@ -291,6 +461,10 @@ func (s *Service) ListTimeBlocks(ctx context.Context, teacherID *string, from, t
 func (s *Service) UpdateTimeBlock(ctx context.Context, id string, req *api.TimeBlockRequest) (*api.TimeBlockResponse, error) {
 	const op = "service.UpdateTimeBlock"
 
+	if err := requireScope(ctx, models.ScopeTimeBlocksWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	block, err := s.store.GetTimeBlock(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -299,6 +473,13 @@ func (s *Service) UpdateTimeBlock(ctx context.Context, id string, req *api.TimeB
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireTeacher(ctx, block.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := requireTeacher(ctx, req.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	start, err := time.Parse(time.RFC3339, req.Start)
 	if err != nil {
 		return nil, fmt.Errorf("%s: invalid start: %w", op, err)
@@ -314,6 +495,13 @@ func (s *Service) UpdateTimeBlock(ctx context.Context, id string, req *api.TimeB
 		return nil, fmt.Errorf("%s: invalid type", op)
 	}
 
+	// внешние блоки перезаписывает синхронизация, править их вручную бессмысленно
+	if block.Type == models.TimeBlockExternal {
+		return nil, fmt.Errorf("%s: external blocks are managed by calendar import: %w", op, response.ErrBadRequest)
+	}
+
+	prev := *block
+
 	block.TeacherID = req.TeacherID
 	block.Start = start
 	block.End = end
@@ -325,13 +513,37 @@ func (s *Service) UpdateTimeBlock(ctx context.Context, id string, req *api.TimeB
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// освобождаем слоты под старым положением блока и блокируем под новым
+	if err := s.refreshBlockedSlots(ctx, nil, prev.TeacherID, prev.Start, prev.End); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.refreshBlockedSlots(ctx, nil, block.TeacherID, block.Start, block.End); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return s.GetTimeBlock(ctx, id)
 }
 
 func (s *Service) DeleteTimeBlock(ctx context.Context, id string) error {
 	const op = "service.DeleteTimeBlock"
 
-	err := s.store.DeleteTimeBlock(ctx, id)
+	if err := requireScope(ctx, models.ScopeTimeBlocksWrite); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	block, err := s.store.GetTimeBlock(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, block.TeacherID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.store.DeleteTimeBlock(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
 			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
@@ -339,6 +551,10 @@ func (s *Service) DeleteTimeBlock(ctx context.Context, id string) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := s.refreshBlockedSlots(ctx, nil, block.TeacherID, block.Start, block.End); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -347,6 +563,10 @@ func (s *Service) DeleteTimeBlock(ctx context.Context, id string) error {
 func (s *Service) GetSlot(ctx context.Context, id string) (*api.SlotResponse, error) {
 	const op = "service.GetSlot"
 
+	if err := requireScope(ctx, models.ScopeSlotsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	slot, err := s.store.GetSlot(ctx, id)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -369,8 +589,10 @@ func (s *Service) GetSlot(ctx context.Context, id string) (*api.SlotResponse, er
 func (s *Service) ListSlots(ctx context.Context, filters *SlotFilters) ([]*api.SlotResponse, error) {
 	const op = "service.ListSlots"
 
-	// Convert service.SlotFilters to interface{} for storage layer
-	// Storage will handle the conversion
+	if err := requireScope(ctx, models.ScopeSlotsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	slots, err := s.store.ListSlots(ctx, filters)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -395,6 +617,10 @@ func (s *Service) ListSlots(ctx context.Context, filters *SlotFilters) ([]*api.S
 func (s *Service) GetSlotsByIDs(ctx context.Context, ids []string) ([]*api.SlotResponse, error) {
 	const op = "service.GetSlotsByIDs"
 
+	if err := requireScope(ctx, models.ScopeSlotsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	slots, err := s.store.GetSlotsByIDs(ctx, ids)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -416,15 +642,71 @@ func (s *Service) GetSlotsByIDs(ctx context.Context, ids []string) ([]*api.SlotR
 	return result, nil
 }
 
+// AlternativesLimit разбирает параметр ?alternatives=N: пустое или неверное значение —
+// DefaultAlternativeSlots, 0 отключает подбор. Сверху limit ограничивает SuggestAlternativeSlots.
+func AlternativesLimit(value string) int {
+	if value == "" {
+		return DefaultAlternativeSlots
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return DefaultAlternativeSlots
+	}
+
+	return limit
+}
+
+// SuggestAlternativeSlots подбирает ближайшие по времени свободные слоты того же преподавателя
+// и той же длительности, что и недоступный слот.
+func (s *Service) SuggestAlternativeSlots(ctx context.Context, slotID string, limit int) ([]*api.SlotResponse, error) {
+	const op = "service.SuggestAlternativeSlots"
+
+	if err := requireScope(ctx, models.ScopeSlotsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if limit <= 0 {
+		return []*api.SlotResponse{}, nil
+	}
+	if limit > MaxAlternativeSlots {
+		limit = MaxAlternativeSlots
+	}
+
+	slots, err := s.store.ListAlternativeSlots(ctx, slotID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.SlotResponse, 0, len(slots))
+	for _, slot := range slots {
+		result = append(result, &api.SlotResponse{
+			ID:         slot.ID,
+			Start:      slot.Start,
+			End:        slot.End,
+			TeacherID:  slot.TeacherID,
+			Status:     string(slot.Status),
+			BookingID:  slot.BookingID,
+			TemplateID: slot.TemplateID,
+		})
+	}
+
+	return result, nil
+}
+
 // пример — требуется адаптация под вашу реализацию store/tx types
 func (s *Service) GenerateSlots(ctx context.Context, req *api.SlotGenerateRequest) (string, error) {
 	const op = "service.GenerateSlots"
 
-	from, err := time.Parse(time.RFC3339, req.From)
+	if err := requireScope(ctx, models.ScopeSlotsGenerate); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	from, err := parseGenerateBound(req.From)
 	if err != nil {
 		return "", fmt.Errorf("%s: invalid from: %w", op, err)
 	}
-	to, err := time.Parse(time.RFC3339, req.To)
+	to, err := parseGenerateBound(req.To)
 	if err != nil {
 		return "", fmt.Errorf("%s: invalid to: %w", op, err)
 	}
@@ -432,14 +714,81 @@ func (s *Service) GenerateSlots(ctx context.Context, req *api.SlotGenerateReques
 		return "", fmt.Errorf("%s: to is before from", op)
 	}
 
+	jobID := fmt.Sprintf("job-%d", time.Now().Unix())
+
+	// без template_id генерируем по всем включённым шаблонам преподавателя
+	if req.TemplateID == nil {
+		if req.TeacherID == nil {
+			return "", fmt.Errorf("%s: template_id or teacher_id is required: %w", op, response.ErrBadRequest)
+		}
+
+		if err := requireTeacher(ctx, *req.TeacherID); err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		templates, err := s.store.ListAvailabilityTemplates(ctx, *req.TeacherID)
+		if err != nil {
+			return "", fmt.Errorf("%s: list templates: %w", op, err)
+		}
+
+		generated := 0
+		for _, tpl := range templates {
+			if !tpl.Enabled {
+				continue
+			}
+			err := s.generateTemplateSlots(ctx, tpl, from, to, jobID)
+			if errors.Is(err, errNothingToGenerate) {
+				continue
+			}
+			if err != nil {
+				return "", fmt.Errorf("%s: template %s: %w", op, tpl.ID, err)
+			}
+			generated++
+		}
+		if generated == 0 {
+			return "", fmt.Errorf("%s: no enabled templates of teacher %s cover the range: %w", op, *req.TeacherID, response.ErrNotFound)
+		}
+
+		return jobID, nil
+	}
+
 	tpl, err := s.store.GetAvailabilityTemplate(ctx, *req.TemplateID)
 	if err != nil {
 		return "", fmt.Errorf("%s: get template: %w", op, err)
 	}
+	if err := requireTeacher(ctx, tpl.TeacherID); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
 	if !tpl.Enabled {
 		return "", fmt.Errorf("%s: template disabled", op)
 	}
 
+	if err := s.generateTemplateSlots(ctx, tpl, from, to, jobID); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return jobID, nil
+}
+
+// parseGenerateBound разбирает границу генерации: RFC3339 или YYYY-MM-DD (полночь UTC).
+// День to входит в генерацию в обоих случаях.
+func parseGenerateBound(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither RFC3339 nor YYYY-MM-DD: %w", v, response.ErrBadRequest)
+	}
+	return t, nil
+}
+
+// errNothingToGenerate — диапазон генерации не пересекается с датами шаблона
+var errNothingToGenerate = errors.New("no dates to generate after intersecting template bounds")
+
+// generateTemplateSlots создаёт слоты шаблона в пересечении [from, to] с его датами
+// одной транзакцией и публикует slot.generated
+func (s *Service) generateTemplateSlots(ctx context.Context, tpl *models.AvailabilityTplSlot, from, to time.Time, jobID string) error {
 	// пересечение диапазонов: используем date-поляну шаблона
 	// tpl.StartDate / tpl.EndDate — это DATE, полагаем, что время ноль.
 	// делаем genFrom/genTo в том же location, что и "from"
@@ -457,7 +806,7 @@ func (s *Service) GenerateSlots(ctx context.Context, req *api.SlotGenerateReques
 		genTo = tplEnd
 	}
 	if genFrom.After(genTo) {
-		return "", fmt.Errorf("%s: no dates to generate after intersecting template bounds", op)
+		return errNothingToGenerate
 	}
 
 	// подготовим карту допустимых дней недели
@@ -478,18 +827,20 @@ func (s *Service) GenerateSlots(ctx context.Context, req *api.SlotGenerateReques
 	// duration
 	slotDur := time.Duration(tpl.SlotDurationMinutes) * time.Minute
 	if slotDur <= 0 {
-		return "", fmt.Errorf("%s: invalid slot duration: %d", op, tpl.SlotDurationMinutes)
+		return fmt.Errorf("invalid slot duration: %d", tpl.SlotDurationMinutes)
 	}
 
 	// начинаем транзакцию и гарантированный откат, если не закоммитим
 	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
-		return "", fmt.Errorf("%s: begin tx: %w", op, err)
+		return fmt.Errorf("begin tx: %w", err)
 	}
 	defer func() {
 		_ = tx.Rollback()
 	}()
 
+	created := 0
+
 	// перебор по дням (genFrom..genTo) включительно
 	for d := truncateToDate(genFrom, loc); !d.After(truncateToDate(genTo, loc)); d = d.AddDate(0, 0, 1) {
 		wd := int(d.Weekday()) // 0=Sunday
@@ -515,18 +866,36 @@ func (s *Service) GenerateSlots(ctx context.Context, req *api.SlotGenerateReques
 				TemplateID: &tpl.ID,
 			}
 			if _, err := s.store.CreateSlot(ctx, tx, slot); err != nil {
-				return "", fmt.Errorf("%s: create slot: %w", op, err)
+				return fmt.Errorf("create slot: %w", err)
 			}
+			created++
 		}
 	}
 
+	// слоты под блоками времени создаём сразу заблокированными
+	// новые слоты объявляет событие slot.generated, отдельные смены статуса не публикуются
+	if _, err := s.store.RefreshBlockedSlots(ctx, tx, tpl.TeacherID, genFrom, genTo); err != nil {
+		return err
+	}
+
+	err = s.publishEvent(ctx, tx, models.EventSlotGenerated, api.SlotGeneratedEventData{
+		JobID:      jobID,
+		TemplateID: tpl.ID,
+		TeacherID:  tpl.TeacherID,
+		From:       genFrom,
+		To:         genTo,
+		Created:    created,
+	})
+	if err != nil {
+		return err
+	}
+
 	// коммит
 	if err := tx.Commit(); err != nil {
-		return "", fmt.Errorf("%s: commit: %w", op, err)
+		return fmt.Errorf("commit: %w", err)
 	}
 
-	jobID := fmt.Sprintf("job-%d", time.Now().Unix())
-	return jobID, nil
+	return nil
 }
 
 // truncateToDate возвращает дату с нулевым временем в указанной локации
@@ -583,6 +952,14 @@ func parseWeekdayFlexible(s string) (time.Weekday, bool) {
 func (s *Service) CreateBooking(ctx context.Context, req *api.BookingRequest, idempotencyKey *string) (*api.BookingResponse, error) {
 	const op = "service.CreateBooking"
 
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireStudent(ctx, req.StudentID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	lockKey := fmt.Sprintf("slot:%s", req.SlotID)
 	
 	locked, err := s.locker.Lock(ctx, lockKey, 10*time.Second)
@@ -608,7 +985,7 @@ func (s *Service) CreateBooking(ctx context.Context, req *api.BookingRequest, id
 		}
 	}()
 
-	slot, err := s.store.GetSlotForBooking(ctx, req.SlotID)
+	slot, err := s.store.GetSlotForBooking(ctx, tx, req.SlotID)
 	if err != nil {
 		_ = tx.Rollback()
     	if errors.Is(err, response.ErrNotFound) {
@@ -620,19 +997,72 @@ func (s *Service) CreateBooking(ctx context.Context, req *api.BookingRequest, id
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := s.checkBookingRules(ctx, tx, req.StudentID, slot, time.Now(), nil); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	requiresConfirmation, err := s.checkStudentRestriction(ctx, tx, req.StudentID, time.Now())
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkStudentOverlap(ctx, tx, req.StudentID, req.SlotID, nil); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	booking := &models.Booking{
-		SlotID:    req.SlotID,
-		StudentID: req.StudentID,
-		TeacherID: slot.TeacherID,
-		Status:    models.BookingPending,
+		SlotID:                      req.SlotID,
+		StudentID:                   req.StudentID,
+		TeacherID:                   slot.TeacherID,
+		Status:                      models.BookingPending,
+		RequiresTeacherConfirmation: requiresConfirmation,
 	}
 
 	bookingID, err := s.store.CreateBooking(ctx, tx, booking)
     if err != nil {
 		_ = tx.Rollback()
+		if errors.Is(err, response.ErrBookingOverlap) {
+			return nil, fmt.Errorf("%s: %w", op, s.overlapError(ctx, req.StudentID, req.SlotID, nil))
+		}
 		return nil, fmt.Errorf("%s: create booking: %w", op, err)
 	}
 
+	if req.CreditID != nil {
+		err = s.store.RedeemMakeupCredit(ctx, tx, *req.CreditID, req.StudentID, slot.TeacherID, bookingID, time.Now())
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = s.recordBookingEvent(ctx, tx, &models.BookingEvent{
+		BookingID: bookingID,
+		Type:      models.BookingEventCreated,
+		ToStatus:  statusPtr(models.BookingPending),
+		ToSlotID:  &req.SlotID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking.ID = bookingID
+	if err := s.publishBookingEvent(ctx, tx, models.EventBookingCreated, booking, nil); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booked := *slot
+	booked.Status = models.SlotBooked
+	booked.BookingID = &bookingID
+	if err := s.publishSlotEvent(ctx, tx, &booked, slot.Status); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("%s: commit: %w", op, err)
 	}
@@ -643,6 +1073,10 @@ func (s *Service) CreateBooking(ctx context.Context, req *api.BookingRequest, id
 func (s *Service) GetBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
 	const op = "service.GetBooking"
 
+	if err := requireScope(ctx, models.ScopeBookingsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	booking, err := s.store.GetBooking(ctx, id)
     if err != nil {
         if errors.Is(err, response.ErrNotFound) {
@@ -651,19 +1085,33 @@ func (s *Service) GetBooking(ctx context.Context, id string) (*api.BookingRespon
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireBookingParty(ctx, booking); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &api.BookingResponse{
-		ID:        booking.ID,
-		SlotID:    booking.SlotID,
-		StudentID: booking.StudentID,
-		TeacherID: booking.TeacherID,
-		Status:    string(booking.Status),
+		ID:                          booking.ID,
+		SlotID:                      booking.SlotID,
+		StudentID:                   booking.StudentID,
+		TeacherID:                   booking.TeacherID,
+		Status:                      string(booking.Status),
+		RequiresTeacherConfirmation: booking.RequiresTeacherConfirmation,
 	}, nil
 }
 
-func (s *Service) ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string) ([]*api.BookingResponse, error) {
+func (s *Service) ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string, includeDeleted bool) ([]*api.BookingResponse, error) {
 	const op = "service.ListBookings"
 
-	bookings, err := s.store.ListBookings(ctx, studentID, teacherID, from, to, status)
+	if err := requireScope(ctx, models.ScopeBookingsRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	studentID, teacherID, err := scopeParty(ctx, studentID, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	bookings, err := s.store.ListBookings(ctx, studentID, teacherID, from, to, status, includeDeleted)
 	if err != nil {
         return nil, fmt.Errorf("%s: %w", op, err)
     }
@@ -671,11 +1119,13 @@ func (s *Service) ListBookings(ctx context.Context, studentID, teacherID *string
 	result := make([]*api.BookingResponse, 0, len(bookings))
 	for _, booking := range bookings {
 		result = append(result, &api.BookingResponse{
-			ID:        booking.ID,
-			SlotID:    booking.SlotID,
-			StudentID: booking.StudentID,
-			TeacherID: booking.TeacherID,
-			Status:    string(booking.Status),
+			ID:                          booking.ID,
+			SlotID:                      booking.SlotID,
+			StudentID:                   booking.StudentID,
+			TeacherID:                   booking.TeacherID,
+			Status:                      string(booking.Status),
+			RequiresTeacherConfirmation: booking.RequiresTeacherConfirmation,
+			DeletedAt:                   booking.DeletedAt,
 		})
 	}
 
@@ -685,6 +1135,10 @@ func (s *Service) ListBookings(ctx context.Context, studentID, teacherID *string
 func (s *Service) CancelBooking(ctx context.Context, bookingID string) (*api.BookingResponse, error) {
 	const op = "service.CancelBooking"
 
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	booking, err := s.store.GetBooking(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -693,6 +1147,10 @@ func (s *Service) CancelBooking(ctx context.Context, bookingID string) (*api.Boo
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireBookingParty(ctx, booking); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
     tx, err := s.store.BeginTx(ctx)
 	if err != nil {
         return nil, fmt.Errorf("%s: begin tx: %w", op, err)
@@ -705,44 +1163,148 @@ func (s *Service) CancelBooking(ctx context.Context, bookingID string) (*api.Boo
 		}
 	}()
 
-	err = s.store.UpdateBookingStatus(ctx, bookingID, models.BookingCancelled)
+	err = s.store.UpdateBookingStatus(ctx, tx, bookingID, models.BookingCancelled)
 	if err != nil {
             _ = tx.Rollback()
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Free the slot
-	err = s.store.UpdateSlotStatus(ctx, booking.SlotID, models.SlotFree, nil)
+	freed, err := s.store.UpdateSlotStatus(ctx, tx, booking.SlotID, models.SlotFree, nil)
 	if err != nil {
         _ = tx.Rollback()
 		return nil, fmt.Errorf("%s: %w", op, err)
     }
 
-    if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	if err := s.publishSlotEvent(ctx, tx, freed, models.SlotBooked); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return s.GetBooking(ctx, bookingID)
-}
-
-func (s *Service) ConfirmBooking(ctx context.Context, bookingID string) (*api.BookingResponse, error) {
-	const op = "service.ConfirmBooking"
+	err = s.recordBookingEvent(ctx, tx, &models.BookingEvent{
+		BookingID:  bookingID,
+		Type:       models.BookingEventCancelled,
+		FromStatus: statusPtr(booking.Status),
+		ToStatus:   statusPtr(models.BookingCancelled),
+		FromSlotID: &booking.SlotID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// отмена преподавателем или админом даёт студенту право на отработку;
+	// чужую бронь преподаватель отменить не может, это проверил requireBookingParty
+	if actsAsTeacher(ctx) {
+		if err := s.issueMakeupCredit(ctx, tx, booking, models.CreditTeacherCancelled); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := s.cancelReminders(ctx, tx, bookingID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cancelled := *booking
+	cancelled.Status = models.BookingCancelled
+	if err := s.publishBookingEvent(ctx, tx, models.EventBookingCancelled, &cancelled, booking); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+    if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return s.GetBooking(ctx, bookingID)
+}
+
+func (s *Service) ConfirmBooking(ctx context.Context, bookingID string) (*api.BookingResponse, error) {
+	const op = "service.ConfirmBooking"
+
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-	err := s.store.UpdateBookingStatus(ctx, bookingID, models.BookingConfirmed)
+	booking, err := s.store.GetBooking(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireTeacher(ctx, booking.TeacherID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// бронь студента под ограничением подтверждает только сам преподаватель
+	if booking.RequiresTeacherConfirmation && actor.FromContext(ctx) != booking.TeacherID {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrTeacherConfirmationRequired)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	err = s.store.UpdateBookingStatus(ctx, tx, bookingID, models.BookingConfirmed)
     if err != nil {
+		_ = tx.Rollback()
         if errors.Is(err, response.ErrNotFound) {
             return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
         }
         return nil, fmt.Errorf("%s: %w", op, err)
     }
 
+	err = s.recordBookingEvent(ctx, tx, &models.BookingEvent{
+		BookingID:  bookingID,
+		Type:       models.BookingEventConfirmed,
+		FromStatus: statusPtr(booking.Status),
+		ToStatus:   statusPtr(models.BookingConfirmed),
+		ToSlotID:   &booking.SlotID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	confirmed := *booking
+	confirmed.Status = models.BookingConfirmed
+	if err := s.publishBookingEvent(ctx, tx, models.EventBookingConfirmed, &confirmed, booking); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.scheduleReminders(ctx, tx, bookingID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
 	return s.GetBooking(ctx, bookingID)
 }
 
 func (s *Service) RescheduleBooking(ctx context.Context, bookingID string, newSlotId string) (*api.BookingResponse, error) {
 	const op = "service.RescheduleBooking"
 
-	_, err := s.store.GetBooking(ctx, bookingID)
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking, err := s.store.GetBooking(ctx, bookingID)
     if err != nil {
         if errors.Is(err, response.ErrNotFound) {
             return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
@@ -750,6 +1312,14 @@ func (s *Service) RescheduleBooking(ctx context.Context, bookingID string, newSl
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireBookingParty(ctx, booking); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !booking.Status.Active() {
+		return nil, fmt.Errorf("%s: booking is %s: %w", op, booking.Status, response.ErrConflict)
+	}
+
 	newSlot, err := s.store.GetSlot(ctx, newSlotId)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -758,8 +1328,11 @@ func (s *Service) RescheduleBooking(ctx context.Context, bookingID string, newSl
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if newSlot.Status != models.SlotFree {
-		return nil, fmt.Errorf("%s: %w", op, response.ErrSlotNotAvailable)
+	// преподаватель переносит брони только на свои слоты
+	if p := restricted(ctx); p != nil && p.Role == auth.RoleTeacher {
+		if err := requireTeacher(ctx, newSlot.TeacherID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	tx, err := s.store.BeginTx(ctx)
@@ -774,12 +1347,106 @@ func (s *Service) RescheduleBooking(ctx context.Context, bookingID string, newSl
 		}
 	}()
 
-	err = s.store.RescheduleBooking(ctx, tx, bookingID, newSlotId)
+	// параллельный перенос или отмена той же брони ждут здесь; исходный слот и статус
+	// для переноса и журнала берутся из заблокированной строки, а не из чтения выше
+	booking, err = s.store.GetBookingForUpdate(ctx, tx, bookingID)
 	if err != nil {
 		_ = tx.Rollback()
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireBookingParty(ctx, booking); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !booking.Status.Active() {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: booking is %s: %w", op, booking.Status, response.ErrConflict)
+	}
+
+	// новый слот проверяется по тем же правилам, что и при создании брони
+	slot, err := s.store.GetSlotForBooking(ctx, tx, newSlotId)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: new slot not found: %w", op, response.ErrNotFound)
+		}
+		if errors.Is(err, response.ErrSlotNotAvailable) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrSlotNotAvailable)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkBookingRules(ctx, tx, booking.StudentID, slot, time.Now(), &bookingID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	requiresConfirmation, err := s.checkStudentRestriction(ctx, tx, booking.StudentID, time.Now())
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkStudentOverlap(ctx, tx, booking.StudentID, newSlotId, &bookingID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	freed, booked, err := s.store.RescheduleBooking(ctx, tx, bookingID, booking.SlotID, newSlotId, requiresConfirmation)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, response.ErrBookingOverlap) {
+			return nil, fmt.Errorf("%s: %w", op, s.overlapError(ctx, booking.StudentID, newSlotId, &bookingID))
+		}
         return nil, fmt.Errorf("%s: %w", op, err)
     }
 
+	if err := s.publishSlotEvent(ctx, tx, freed, models.SlotBooked); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.publishSlotEvent(ctx, tx, booked, slot.Status); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// ограниченному студенту перенесённую бронь снова подтверждает преподаватель
+	rescheduled := *booking
+	rescheduled.SlotID = newSlotId
+	rescheduled.TeacherID = slot.TeacherID
+	if requiresConfirmation {
+		rescheduled.Status = models.BookingPending
+		rescheduled.RequiresTeacherConfirmation = true
+	}
+
+	err = s.recordBookingEvent(ctx, tx, &models.BookingEvent{
+		BookingID:  bookingID,
+		Type:       models.BookingEventRescheduled,
+		FromStatus: statusPtr(booking.Status),
+		ToStatus:   statusPtr(rescheduled.Status),
+		FromSlotID: &booking.SlotID,
+		ToSlotID:   &newSlotId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.publishBookingEvent(ctx, tx, models.EventBookingRescheduled, &rescheduled, booking); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.rescheduleReminders(ctx, tx, &rescheduled); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("%s: commit: %w", op, err)
 	}
@@ -787,9 +1454,37 @@ func (s *Service) RescheduleBooking(ctx context.Context, bookingID string, newSl
 	return s.GetBooking(ctx, bookingID)
 }
 
+// checkStudentOverlap не даёт студенту занять два пересекающихся по времени слота.
+// Ограничение bookings_student_no_overlap в БД страхует от гонок между проверкой и вставкой.
+func (s *Service) checkStudentOverlap(ctx context.Context, tx *sql.Tx, studentID, slotID string, excludeBookingID *string) error {
+	conflictID, err := s.store.FindOverlappingBooking(ctx, tx, studentID, slotID, excludeBookingID)
+	if err != nil {
+		return fmt.Errorf("check overlap: %w", err)
+	}
+	if conflictID != "" {
+		return &response.BookingOverlapError{BookingID: conflictID}
+	}
+
+	return nil
+}
+
+// overlapError дополняет нарушение ограничения идентификатором конфликтующей брони
+func (s *Service) overlapError(ctx context.Context, studentID, slotID string, excludeBookingID *string) error {
+	conflictID, err := s.store.FindOverlappingBooking(ctx, nil, studentID, slotID, excludeBookingID)
+	if err != nil || conflictID == "" {
+		return response.ErrBookingOverlap
+	}
+
+	return &response.BookingOverlapError{BookingID: conflictID}
+}
+
 func (s *Service) DeleteBooking(ctx context.Context, bookingID string) error {
 	const op = "service.DeleteBooking"
 
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	booking, err := s.store.GetBooking(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, response.ErrNotFound) {
@@ -798,6 +1493,10 @@ func (s *Service) DeleteBooking(ctx context.Context, bookingID string) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := requireBookingParty(ctx, booking); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	tx, err := s.store.BeginTx(ctx)
 	if err != nil {
 		return fmt.Errorf("%s: begin tx: %w", op, err)
@@ -810,14 +1509,37 @@ func (s *Service) DeleteBooking(ctx context.Context, bookingID string) error {
 		}
 	}()
 
-	err = s.store.DeleteBooking(ctx, bookingID)
+	err = s.store.DeleteBooking(ctx, tx, bookingID)
 	if err != nil {
 		_ = tx.Rollback()
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Free the slot
-	err = s.store.UpdateSlotStatus(ctx, booking.SlotID, models.SlotFree, nil)
+	// Free the slot; a cancelled booking has already released it
+	if booking.Status != models.BookingCancelled {
+		freed, err := s.store.UpdateSlotStatus(ctx, tx, booking.SlotID, models.SlotFree, nil)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := s.publishSlotEvent(ctx, tx, freed, models.SlotBooked); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := s.cancelReminders(ctx, tx, bookingID); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.recordBookingEvent(ctx, tx, &models.BookingEvent{
+		BookingID:  bookingID,
+		Type:       models.BookingEventDeleted,
+		FromStatus: statusPtr(booking.Status),
+		FromSlotID: &booking.SlotID,
+	})
 	if err != nil {
 		_ = tx.Rollback()
 		return fmt.Errorf("%s: %w", op, err)
@@ -830,34 +1552,429 @@ func (s *Service) DeleteBooking(ctx context.Context, bookingID string) error {
 	return nil
 }
 
+// RestoreBooking отменяет мягкое удаление. Активная бронь снова занимает свой слот,
+// только если он всё ещё свободен; отменённая восстанавливается без слота.
+func (s *Service) RestoreBooking(ctx context.Context, bookingID string) (*api.BookingResponse, error) {
+	const op = "service.RestoreBooking"
+
+	if err := requireScope(ctx, models.ScopeBookingsWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	booking, err := s.store.GetDeletedBooking(ctx, tx, bookingID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireBookingParty(ctx, booking); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if booking.Status != models.BookingCancelled {
+		lockKey := fmt.Sprintf("slot:%s", booking.SlotID)
+
+		locked, err := s.locker.Lock(ctx, lockKey, 10*time.Second)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: lock error: %w", op, err)
+		}
+		if !locked {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, response.ErrLocked)
+		}
+		defer func() {
+			_ = s.locker.Unlock(ctx, lockKey)
+		}()
+
+		slot, err := s.store.GetSlotForBooking(ctx, tx, booking.SlotID)
+		if err != nil {
+			_ = tx.Rollback()
+			if errors.Is(err, response.ErrNotFound) || errors.Is(err, response.ErrSlotNotAvailable) {
+				return nil, fmt.Errorf("%s: %w", op, response.ErrSlotNotAvailable)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := s.checkStudentOverlap(ctx, tx, booking.StudentID, booking.SlotID, &bookingID); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		booked, err := s.store.UpdateSlotStatus(ctx, tx, booking.SlotID, models.SlotBooked, &bookingID)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := s.publishSlotEvent(ctx, tx, booked, slot.Status); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = s.store.RestoreBooking(ctx, tx, bookingID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, response.ErrBookingOverlap) {
+			return nil, fmt.Errorf("%s: %w", op, s.overlapError(ctx, booking.StudentID, booking.SlotID, &bookingID))
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if booking.Status == models.BookingConfirmed {
+		if err := s.scheduleReminders(ctx, tx, bookingID); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = s.recordBookingEvent(ctx, tx, &models.BookingEvent{
+		BookingID:  bookingID,
+		Type:       models.BookingEventRestored,
+		FromStatus: statusPtr(booking.Status),
+		ToStatus:   statusPtr(booking.Status),
+		ToSlotID:   &booking.SlotID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return s.GetBooking(ctx, bookingID)
+}
+
 // Attendance
 
 func (s *Service) CreateAttendance(ctx context.Context, req *api.AttendanceRequest) (*api.AttendanceResponse, error) {
 	const op = "service.CreateAttendance"
 
-	status := models.AttendanceStatus(req.Status)
-	if status != models.AttendancePresent && status != models.AttendanceAbsent && status != models.AttendanceLate {
-		return nil, fmt.Errorf("%s: invalid status", op)
+	if err := requireScope(ctx, models.ScopeAttendanceWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	status, err := parseAttendanceStatus(req.Status)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := validateExcused(status, req.Excused); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking, err := s.validateAttendanceBooking(ctx, req.BookingID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	attendance := &models.Attendance{
 		BookingID: req.BookingID,
 		Status:    status,
 		Notes:     req.Notes,
+		Excused:   req.Excused,
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	id, err := s.store.CreateAttendance(ctx, tx, attendance)
+	if err != nil {
+		if errors.Is(err, response.ErrConflict) {
+			existing, getErr := s.store.GetAttendanceByBooking(ctx, req.BookingID)
+			if getErr != nil {
+				return nil, fmt.Errorf("%s: %w", op, response.ErrAttendanceExists)
+			}
+			return nil, fmt.Errorf("%s: %w", op, &response.AttendanceExistsError{AttendanceID: existing.ID})
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.syncExcusedAbsenceCredit(ctx, tx, booking, status, req.Excused); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := s.recordedAttendance(ctx, tx, id, booking)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+// UpsertAttendance создаёт отметку или перезаписывает существующую для той же брони.
+// created сообщает, была ли запись создана.
+func (s *Service) UpsertAttendance(ctx context.Context, req *api.AttendanceRequest) (*api.AttendanceResponse, bool, error) {
+	const op = "service.UpsertAttendance"
+
+	if err := requireScope(ctx, models.ScopeAttendanceWrite); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	status, err := parseAttendanceStatus(req.Status)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := validateExcused(status, req.Excused); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking, err := s.validateAttendanceBooking(ctx, req.BookingID, time.Now())
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attendance := &models.Attendance{
+		BookingID: req.BookingID,
+		Status:    status,
+		Notes:     req.Notes,
+		Excused:   req.Excused,
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	id, created, err := s.store.UpsertAttendance(ctx, tx, attendance)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.syncExcusedAbsenceCredit(ctx, tx, booking, status, req.Excused); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := s.recordedAttendance(ctx, tx, id, booking)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return resp, created, nil
+}
+
+func (s *Service) UpdateAttendance(ctx context.Context, id string, req *api.AttendanceUpdateRequest) (*api.AttendanceResponse, error) {
+	return s.PatchAttendance(ctx, id, &api.AttendancePatchRequest{
+		Status:  &req.Status,
+		Notes:   &req.Notes,
+		Excused: &req.Excused,
+	})
+}
+
+// PatchAttendance меняет только переданные поля
+func (s *Service) PatchAttendance(ctx context.Context, id string, req *api.AttendancePatchRequest) (*api.AttendanceResponse, error) {
+	const op = "service.PatchAttendance"
+
+	if err := requireScope(ctx, models.ScopeAttendanceWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attendance, err := s.store.GetAttendance(ctx, nil, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if req.Status != nil {
+		status, err := parseAttendanceStatus(*req.Status)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		attendance.Status = status
+	}
+	if req.Notes != nil {
+		attendance.Notes = *req.Notes
+	}
+	if req.Excused != nil {
+		attendance.Excused = *req.Excused
+	} else if attendance.Status != models.AttendanceAbsent {
+		// смена статуса с absent снимает уважительную причину
+		attendance.Excused = false
+	}
+	// правка преподавателем снимает признак автоотметки
+	attendance.AutoMarked = false
+
+	if err := validateExcused(attendance.Status, attendance.Excused); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	id, err := s.store.CreateAttendance(ctx, attendance)
+	booking, err := s.validateAttendanceBooking(ctx, attendance.BookingID, time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return s.GetAttendance(ctx, id)
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.store.UpdateAttendance(ctx, tx, attendance)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.syncExcusedAbsenceCredit(ctx, tx, booking, attendance.Status, attendance.Excused); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := s.recordedAttendance(ctx, tx, id, booking)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) DeleteAttendance(ctx context.Context, id string) error {
+	const op = "service.DeleteAttendance"
+
+	if err := requireScope(ctx, models.ScopeAttendanceWrite); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	attendance, err := s.store.GetAttendance(ctx, nil, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if restricted(ctx) != nil {
+		booking, err := s.bookingForAccess(ctx, attendance.BookingID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := requireTeacher(ctx, booking.TeacherID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.store.DeleteAttendance(ctx, tx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// без отметки нет и уважительной неявки
+	if err := s.store.RevokeMakeupCredit(ctx, tx, attendance.BookingID, models.CreditExcusedAbsence); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// validateAttendanceBooking разрешает отмечать посещаемость только по подтверждённой брони
+// и только после начала занятия
+func (s *Service) validateAttendanceBooking(ctx context.Context, bookingID string, now time.Time) (*models.Booking, error) {
+	booking, err := s.store.GetBooking(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, response.ErrNotFound
+		}
+		return nil, fmt.Errorf("get booking: %w", err)
+	}
+
+	// отмечает посещаемость преподаватель урока
+	if err := requireTeacher(ctx, booking.TeacherID); err != nil {
+		return nil, err
+	}
+
+	if booking.Status != models.BookingConfirmed {
+		return nil, response.ErrBookingNotConfirmed
+	}
+
+	slot, err := s.store.GetSlot(ctx, booking.SlotID)
+	if err != nil {
+		return nil, fmt.Errorf("get slot: %w", err)
+	}
+
+	if slot.Start.After(now) {
+		return nil, response.ErrLessonNotStarted
+	}
+
+	return booking, nil
+}
+
+func parseAttendanceStatus(value string) (models.AttendanceStatus, error) {
+	status := models.AttendanceStatus(value)
+	if status != models.AttendancePresent && status != models.AttendanceAbsent && status != models.AttendanceLate {
+		return "", fmt.Errorf("invalid status %q: %w", value, response.ErrBadRequest)
+	}
+
+	return status, nil
 }
 
 func (s *Service) GetAttendance(ctx context.Context, id string) (*api.AttendanceResponse, error) {
 	const op = "service.GetAttendance"
 
-	attendance, err := s.store.GetAttendance(ctx, id)
+	if err := requireScope(ctx, models.ScopeAttendanceRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attendance, err := s.store.GetAttendance(ctx, nil, id)
     if err != nil {
         if errors.Is(err, response.ErrNotFound) {
             return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
@@ -865,17 +1982,59 @@ func (s *Service) GetAttendance(ctx context.Context, id string) (*api.Attendance
         return nil, fmt.Errorf("%s: %w", op, err)
     }
 
+	if restricted(ctx) != nil {
+		booking, err := s.bookingForAccess(ctx, attendance.BookingID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := requireBookingParty(ctx, booking); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return attendanceResponse(attendance), nil
+}
+
+// recordedAttendance читает только что записанную в tx отметку и в той же tx
+// публикует attendance.recorded
+func (s *Service) recordedAttendance(ctx context.Context, tx *sql.Tx, id string, booking *models.Booking) (*api.AttendanceResponse, error) {
+	attendance, err := s.store.GetAttendance(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get attendance: %w", err)
+	}
+
+	resp := attendanceResponse(attendance)
+	if err := s.publishAttendanceEvent(ctx, tx, resp, booking); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func attendanceResponse(attendance *models.Attendance) *api.AttendanceResponse {
 	return &api.AttendanceResponse{
-		ID:        attendance.ID,
-		BookingID: attendance.BookingID,
-		Status:    string(attendance.Status),
-		Notes:     attendance.Notes,
-	}, nil
+		ID:          attendance.ID,
+		BookingID:   attendance.BookingID,
+		Status:      string(attendance.Status),
+		Notes:       attendance.Notes,
+		AutoMarked:  attendance.AutoMarked,
+		CheckedInAt: attendance.CheckedInAt,
+		Excused:     attendance.Excused,
+	}
 }
 
 func (s *Service) ListAttendance(ctx context.Context, teacherID *string, from, to *time.Time) ([]*api.AttendanceResponse, error) {
 	const op = "service.ListAttendance"
 
+	if err := requireScope(ctx, models.ScopeAttendanceRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teacherID, err := scopeTeacher(ctx, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	attendances, err := s.store.ListAttendance(ctx, teacherID, from, to)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -884,10 +2043,13 @@ func (s *Service) ListAttendance(ctx context.Context, teacherID *string, from, t
 	result := make([]*api.AttendanceResponse, 0, len(attendances))
 	for _, attendance := range attendances {
 		result = append(result, &api.AttendanceResponse{
-			ID:        attendance.ID,
-			BookingID: attendance.BookingID,
-			Status:    string(attendance.Status),
-			Notes:     attendance.Notes,
+			ID:          attendance.ID,
+			BookingID:   attendance.BookingID,
+			Status:      string(attendance.Status),
+			Notes:       attendance.Notes,
+			AutoMarked:  attendance.AutoMarked,
+			CheckedInAt: attendance.CheckedInAt,
+			Excused:     attendance.Excused,
 		})
 	}
 
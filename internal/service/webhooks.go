@@ -0,0 +1,468 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"rasp-service/api"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/webhook"
+	"time"
+)
+
+const (
+	DefaultWebhookMaxAttempts = 8
+	DefaultWebhookBackoffBase = 30 * time.Second
+	DefaultWebhookBackoffMax  = 6 * time.Hour
+	DefaultWebhookTimeout     = 10 * time.Second
+
+	DefaultWebhookBatchSize       = 50
+	DefaultWebhookDeliveriesLimit = 100
+	MaxWebhookDeliveriesLimit     = 500
+
+	webhookUserAgent = "rasp-service-webhooks/1.0"
+	// webhookResponseLimit — сколько тела ответа получателя читаем, чтобы переиспользовать соединение
+	webhookResponseLimit = 64 << 10
+)
+
+// WebhookPolicy — повторы доставки вебхуков: после n-й неудачи следующая попытка
+// через BackoffBase·2^(n-1), но не позже BackoffMax; после MaxAttempts доставка
+// становится failed и ждёт ручного повтора.
+type WebhookPolicy struct {
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	Timeout     time.Duration
+}
+
+func WithWebhookPolicy(policy WebhookPolicy) Option {
+	return func(s *Service) {
+		s.webhooks = policy
+	}
+}
+
+// webhookPolicy дополняет незаданные поля политики значениями по умолчанию
+func (s *Service) webhookPolicy() WebhookPolicy {
+	p := s.webhooks
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultWebhookMaxAttempts
+	}
+	if p.BackoffBase <= 0 {
+		p.BackoffBase = DefaultWebhookBackoffBase
+	}
+	if p.BackoffMax <= 0 {
+		p.BackoffMax = DefaultWebhookBackoffMax
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultWebhookTimeout
+	}
+	return p
+}
+
+// Webhooks
+
+func (s *Service) CreateWebhookSubscription(ctx context.Context, req *api.WebhookSubscriptionRequest) (*api.WebhookSubscriptionResponse, error) {
+	const op = "service.CreateWebhookSubscription"
+
+	if err := requireScope(ctx, models.ScopeWebhooksWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	eventTypes, err := validateWebhookSubscription(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret, err = webhook.NewSecret()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	sub := &models.WebhookSubscription{
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Enabled:    req.Enabled == nil || *req.Enabled,
+	}
+
+	id, err := s.store.CreateWebhookSubscription(ctx, sub)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.store.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// секрет показывается только при создании
+	resp := toWebhookSubscriptionResponse(created)
+	resp.Secret = created.Secret
+
+	return resp, nil
+}
+
+func (s *Service) GetWebhookSubscription(ctx context.Context, id string) (*api.WebhookSubscriptionResponse, error) {
+	const op = "service.GetWebhookSubscription"
+
+	if err := requireScope(ctx, models.ScopeWebhooksRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sub, err := s.store.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toWebhookSubscriptionResponse(sub), nil
+}
+
+func (s *Service) ListWebhookSubscriptions(ctx context.Context) ([]*api.WebhookSubscriptionResponse, error) {
+	const op = "service.ListWebhookSubscriptions"
+
+	if err := requireScope(ctx, models.ScopeWebhooksRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	subs, err := s.store.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.WebhookSubscriptionResponse, 0, len(subs))
+	for _, sub := range subs {
+		result = append(result, toWebhookSubscriptionResponse(sub))
+	}
+
+	return result, nil
+}
+
+// UpdateWebhookSubscription заменяет адрес и типы событий; пустой secret оставляет прежний,
+// enabled без значения — прежнее состояние
+func (s *Service) UpdateWebhookSubscription(ctx context.Context, id string, req *api.WebhookSubscriptionRequest) (*api.WebhookSubscriptionResponse, error) {
+	const op = "service.UpdateWebhookSubscription"
+
+	if err := requireScope(ctx, models.ScopeWebhooksWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	eventTypes, err := validateWebhookSubscription(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sub, err := s.store.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sub.URL = req.URL
+	sub.EventTypes = eventTypes
+	if req.Secret != "" {
+		sub.Secret = req.Secret
+	}
+	if req.Enabled != nil {
+		sub.Enabled = *req.Enabled
+	}
+
+	if err := s.store.UpdateWebhookSubscription(ctx, sub); err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetWebhookSubscription(ctx, id)
+}
+
+func (s *Service) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	const op = "service.DeleteWebhookSubscription"
+
+	if err := requireScope(ctx, models.ScopeWebhooksWrite); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.store.DeleteWebhookSubscription(ctx, id); err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries возвращает последние доставки подписки, при status — только с этим статусом
+func (s *Service) ListWebhookDeliveries(ctx context.Context, subscriptionID string, status *string, limit int) ([]*api.WebhookDeliveryResponse, error) {
+	const op = "service.ListWebhookDeliveries"
+
+	if err := requireScope(ctx, models.ScopeWebhooksRead); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if status != nil {
+		switch models.WebhookDeliveryStatus(*status) {
+		case models.WebhookDeliveryPending, models.WebhookDeliveryDelivered, models.WebhookDeliveryFailed:
+		default:
+			return nil, fmt.Errorf("%s: invalid status %q: %w", op, *status, response.ErrBadRequest)
+		}
+	}
+
+	if limit <= 0 {
+		limit = DefaultWebhookDeliveriesLimit
+	}
+	if limit > MaxWebhookDeliveriesLimit {
+		limit = MaxWebhookDeliveriesLimit
+	}
+
+	if _, err := s.store.GetWebhookSubscription(ctx, subscriptionID); err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, subscriptionID, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*api.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, toWebhookDeliveryResponse(d))
+	}
+
+	return result, nil
+}
+
+// ReplayWebhookDelivery ставит доставку в очередь заново с полным запасом попыток.
+// Получатель увидит то же событие с тем же id, что позволяет ему отбросить дубликат.
+func (s *Service) ReplayWebhookDelivery(ctx context.Context, id string) (*api.WebhookDeliveryResponse, error) {
+	const op = "service.ReplayWebhookDelivery"
+
+	if err := requireScope(ctx, models.ScopeWebhooksWrite); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.store.ReplayWebhookDelivery(ctx, id, time.Now()); err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	d, err := s.store.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toWebhookDeliveryResponse(d), nil
+}
+
+// DeliverWebhooks отправляет до limit доставок, чей срок наступил.
+// Возвращает число обработанных доставок, успешных и нет.
+func (s *Service) DeliverWebhooks(ctx context.Context, limit int) (int, error) {
+	const op = "service.DeliverWebhooks"
+
+	if limit <= 0 {
+		limit = DefaultWebhookBatchSize
+	}
+
+	policy := s.webhookPolicy()
+	now := time.Now()
+	// аренды хватает на последовательную отправку всей пачки
+	leaseUntil := now.Add(policy.Timeout*time.Duration(limit) + time.Minute)
+
+	batch, err := s.store.ClaimWebhookDeliveries(ctx, now, leaseUntil, limit)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	client := &http.Client{Timeout: policy.Timeout}
+	for i, d := range batch {
+		if ctx.Err() != nil {
+			// невзятые в работу вернутся в очередь после аренды
+			return i, nil
+		}
+
+		s.attemptWebhookDelivery(ctx, client, d, policy)
+
+		if err := s.store.SaveWebhookAttempt(ctx, &d.WebhookDelivery); err != nil {
+			return i, fmt.Errorf("%s: delivery %s: %w", op, d.ID, err)
+		}
+	}
+
+	return len(batch), nil
+}
+
+// attemptWebhookDelivery выполняет одну попытку и записывает её итог в d
+func (s *Service) attemptWebhookDelivery(ctx context.Context, client *http.Client, d *models.WebhookDispatch, policy WebhookPolicy) {
+	now := time.Now()
+	d.Attempts++
+
+	statusCode, err := sendWebhook(ctx, client, d, now)
+	if statusCode != 0 {
+		d.LastStatusCode = &statusCode
+	}
+
+	if err == nil {
+		d.Status = models.WebhookDeliveryDelivered
+		d.DeliveredAt = &now
+		d.LastError = nil
+		return
+	}
+
+	msg := err.Error()
+	d.LastError = &msg
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = models.WebhookDeliveryFailed
+		return
+	}
+
+	d.Status = models.WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(webhook.Backoff(d.Attempts, policy.BackoffBase, policy.BackoffMax))
+}
+
+// sendWebhook отправляет тело доставки; успех — любой ответ 2xx
+func sendWebhook(ctx context.Context, client *http.Client, d *models.WebhookDispatch, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(webhook.EventHeader, string(d.EventType))
+	req.Header.Set(webhook.DeliveryHeader, d.ID)
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(d.Secret, now, d.Payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// validateWebhookSubscription проверяет адрес и возвращает типы событий без повторов
+func validateWebhookSubscription(req *api.WebhookSubscriptionRequest) ([]models.EventType, error) {
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("url must be an absolute http(s) URL: %w", response.ErrBadRequest)
+	}
+
+	if len(req.EventTypes) == 0 {
+		return nil, fmt.Errorf("event_types is required: %w", response.ErrBadRequest)
+	}
+
+	known := make(map[models.EventType]struct{}, len(models.EventTypes))
+	for _, t := range models.EventTypes {
+		known[t] = struct{}{}
+	}
+
+	seen := make(map[models.EventType]struct{}, len(req.EventTypes))
+	result := make([]models.EventType, 0, len(req.EventTypes))
+	for _, raw := range req.EventTypes {
+		t := models.EventType(raw)
+		if _, ok := known[t]; !ok {
+			return nil, fmt.Errorf("unknown event type %q: %w", raw, response.ErrBadRequest)
+		}
+		if _, dup := seen[t]; dup {
+			continue
+		}
+		seen[t] = struct{}{}
+		result = append(result, t)
+	}
+
+	return result, nil
+}
+
+func toWebhookSubscriptionResponse(sub *models.WebhookSubscription) *api.WebhookSubscriptionResponse {
+	eventTypes := make([]string, len(sub.EventTypes))
+	for i, t := range sub.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	return &api.WebhookSubscriptionResponse{
+		ID:         sub.ID,
+		URL:        sub.URL,
+		EventTypes: eventTypes,
+		Enabled:    sub.Enabled,
+		CreatedAt:  sub.CreatedAt,
+		UpdatedAt:  sub.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(d *models.WebhookDelivery) *api.WebhookDeliveryResponse {
+	resp := &api.WebhookDeliveryResponse{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		LastError:      d.LastError,
+		LastStatusCode: d.LastStatusCode,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		Payload:        json.RawMessage(d.Payload),
+	}
+	// время следующей попытки имеет смысл только для доставки в очереди
+	if d.Status == models.WebhookDeliveryPending {
+		next := d.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+
+	return resp
+}
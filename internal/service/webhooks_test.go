@@ -0,0 +1,130 @@
+package service
+
+import (
+	"rasp-service/internal/models"
+	"rasp-service/pkg/webhook"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testWebhookPolicy = WebhookPolicy{
+	MaxAttempts: 3,
+	BackoffBase: time.Minute,
+	BackoffMax:  90 * time.Second,
+	Timeout:     time.Second,
+}
+
+// receiver отвечает статусом status и проверяет подпись и заголовки каждой доставки
+func receiver(t *testing.T, secret string, status int) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read body: %v", err)
+		}
+		if err := webhook.Verify(secret, r.Header.Get(webhook.SignatureHeader), body, time.Now(), webhook.DefaultTolerance); err != nil {
+			t.Errorf("signature: %v", err)
+		}
+		if got := r.Header.Get(webhook.EventHeader); got != string(models.EventBookingCreated) {
+			t.Errorf("%s = %q", webhook.EventHeader, got)
+		}
+		if got := r.Header.Get(webhook.DeliveryHeader); got != "delivery-1" {
+			t.Errorf("%s = %q", webhook.DeliveryHeader, got)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func dispatch(url string, attempts int) *models.WebhookDispatch {
+	return &models.WebhookDispatch{
+		WebhookDelivery: models.WebhookDelivery{
+			ID:        "delivery-1",
+			EventType: models.EventBookingCreated,
+			Payload:   []byte(`{"booking_id":"b-1"}`),
+			Status:    models.WebhookDeliveryPending,
+			Attempts:  attempts,
+		},
+		URL:    url,
+		Secret: "whsec_test",
+	}
+}
+
+func TestAttemptWebhookDelivery(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		attempts    int
+		wantStatus  models.WebhookDeliveryStatus
+		wantBackoff time.Duration
+	}{
+		{name: "200 delivers", status: http.StatusOK, wantStatus: models.WebhookDeliveryDelivered},
+		{name: "204 delivers", status: http.StatusNoContent, wantStatus: models.WebhookDeliveryDelivered},
+		{name: "delivers on the last attempt", status: http.StatusAccepted, attempts: 2, wantStatus: models.WebhookDeliveryDelivered},
+		{name: "500 retries", status: http.StatusInternalServerError, wantStatus: models.WebhookDeliveryPending, wantBackoff: time.Minute},
+		{name: "3xx is not a success", status: http.StatusMovedPermanently, wantStatus: models.WebhookDeliveryPending, wantBackoff: time.Minute},
+		{name: "backoff is capped", status: http.StatusBadGateway, attempts: 1, wantStatus: models.WebhookDeliveryPending, wantBackoff: 90 * time.Second},
+		{name: "fails after max attempts", status: http.StatusServiceUnavailable, attempts: 2, wantStatus: models.WebhookDeliveryFailed},
+	}
+
+	s := &Service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := receiver(t, "whsec_test", tt.status)
+			d := dispatch(srv.URL, tt.attempts)
+
+			before := time.Now()
+			s.attemptWebhookDelivery(context.Background(), srv.Client(), d, testWebhookPolicy)
+
+			if d.Status != tt.wantStatus {
+				t.Fatalf("Status = %s, want %s", d.Status, tt.wantStatus)
+			}
+			if d.Attempts != tt.attempts+1 {
+				t.Fatalf("Attempts = %d, want %d", d.Attempts, tt.attempts+1)
+			}
+			if d.LastStatusCode == nil || *d.LastStatusCode != tt.status {
+				t.Fatalf("LastStatusCode = %v, want %d", d.LastStatusCode, tt.status)
+			}
+
+			switch tt.wantStatus {
+			case models.WebhookDeliveryDelivered:
+				if d.DeliveredAt == nil || d.LastError != nil {
+					t.Fatalf("DeliveredAt = %v, LastError = %v", d.DeliveredAt, d.LastError)
+				}
+			case models.WebhookDeliveryPending:
+				if d.LastError == nil {
+					t.Fatal("LastError is nil")
+				}
+				delay := d.NextAttemptAt.Sub(before)
+				if delay < tt.wantBackoff || delay > tt.wantBackoff+5*time.Second {
+					t.Fatalf("next attempt in %s, want %s", delay, tt.wantBackoff)
+				}
+			case models.WebhookDeliveryFailed:
+				if d.LastError == nil || d.DeliveredAt != nil {
+					t.Fatalf("DeliveredAt = %v, LastError = %v", d.DeliveredAt, d.LastError)
+				}
+			}
+		})
+	}
+}
+
+func TestAttemptWebhookDeliveryUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	d := dispatch(url, 0)
+	(&Service{}).attemptWebhookDelivery(context.Background(), http.DefaultClient, d, testWebhookPolicy)
+
+	if d.Status != models.WebhookDeliveryPending || d.LastError == nil {
+		t.Fatalf("Status = %s, LastError = %v", d.Status, d.LastError)
+	}
+	if d.LastStatusCode != nil {
+		t.Fatalf("LastStatusCode = %d, want nil", *d.LastStatusCode)
+	}
+}
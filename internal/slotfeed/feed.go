@@ -0,0 +1,270 @@
+// Package slotfeed — живая лента изменений слотов преподавателя.
+//
+// Feed подключается к релею outbox как ещё один publisher: события slot.status_changed
+// и slot.generated дописываются в короткую историю (Redis Stream на преподавателя арендатора)
+// и рассылаются через Redis pub/sub, поэтому подписчик на любой реплике получает
+// изменения сразу после коммита. id записи в истории служит id события SSE:
+// по Last-Event-ID подписчик дочитывает пропущенное из истории.
+package slotfeed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/tenant"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// EventReset — история уже обрезана после Last-Event-ID: клиенту нужно перечитать слоты
+	EventReset = "reset"
+
+	subscriberBuffer = 64
+)
+
+// Event — событие ленты; Type совпадает с типом доменного события, Data — его data
+type Event struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type Feed struct {
+	client  *redis.Client
+	history int64
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New создаёт ленту поверх существующего соединения с Redis; history — примерная длина
+// истории на преподавателя, доступной для продолжения по Last-Event-ID
+func New(client *redis.Client, history int64) *Feed {
+	return &Feed{
+		client:  client,
+		history: history,
+		done:    make(chan struct{}),
+	}
+}
+
+// Publish принимает события из outbox; события не о слотах пропускаются.
+// Повтор публикации после сбоя релея добавит дубликат, но событие несёт состояние
+// слота целиком, поэтому повторное применение безвредно.
+func (f *Feed) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	const op = "slotfeed.Feed.Publish"
+
+	if event.EventType != models.EventSlotStatusChanged && event.EventType != models.EventSlotGenerated {
+		return nil
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(event.Payload, &envelope); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var target struct {
+		TeacherID string `json:"teacher_id"`
+	}
+	if err := json.Unmarshal(envelope.Data, &target); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if target.TeacherID == "" {
+		return nil
+	}
+
+	id, err := f.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: historyKey(ctx, target.TeacherID),
+		MaxLen: f.history,
+		Approx: true,
+		Values: map[string]any{
+			"type": string(event.EventType),
+			"data": string(envelope.Data),
+		},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	msg, err := json.Marshal(Event{ID: id, Type: string(event.EventType), Data: envelope.Data})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := f.client.Publish(ctx, liveChannel(ctx, target.TeacherID), msg).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Subscribe возвращает события преподавателя. С lastEventID сначала приходят
+// пропущенные события из истории, затем живые; дубликаты на стыке отбрасываются.
+// Канал закрывается при отмене ctx или Close.
+func (f *Feed) Subscribe(ctx context.Context, teacherID, lastEventID string) (<-chan Event, error) {
+	const op = "slotfeed.Feed.Subscribe"
+
+	// подписываемся до чтения истории, чтобы не потерять события между ними
+	sub := f.client.Subscribe(ctx, liveChannel(ctx, teacherID))
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, _, ok := parseID(lastEventID); !ok {
+		lastEventID = ""
+	}
+
+	var backlog []Event
+	if lastEventID != "" {
+		var err error
+		backlog, err = f.missed(ctx, teacherID, lastEventID)
+		if err != nil {
+			_ = sub.Close()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	out := make(chan Event, subscriberBuffer)
+
+	go func() {
+		defer close(out)
+		defer func() {
+			_ = sub.Close()
+		}()
+
+		last := lastEventID
+		send := func(ev Event) bool {
+			if ev.Type != EventReset && last != "" && !after(ev.ID, last) {
+				return true
+			}
+			select {
+			case out <- ev:
+				if ev.ID != "" {
+					last = ev.ID
+				}
+				return true
+			case <-ctx.Done():
+				return false
+			case <-f.done:
+				return false
+			}
+		}
+
+		for _, ev := range backlog {
+			if !send(ev) {
+				return
+			}
+		}
+
+		live := sub.Channel()
+		for {
+			select {
+			case msg, ok := <-live:
+				if !ok {
+					return
+				}
+				var ev Event
+				if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+					continue
+				}
+				if !send(ev) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			case <-f.done:
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Close завершает все подписки; вызывается при остановке HTTP-сервера,
+// иначе открытые потоки SSE держали бы Shutdown до таймаута
+func (f *Feed) Close() {
+	f.closeOnce.Do(func() {
+		close(f.done)
+	})
+}
+
+// missed читает историю после lastEventID. Если самая старая запись истории новее
+// lastEventID, часть событий уже обрезана, и первым идёт EventReset.
+func (f *Feed) missed(ctx context.Context, teacherID, lastEventID string) ([]Event, error) {
+	key := historyKey(ctx, teacherID)
+
+	oldest, err := f.client.XRangeN(ctx, key, "-", "+", 1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	if len(oldest) > 0 && after(oldest[0].ID, lastEventID) {
+		events = append(events, Event{Type: EventReset})
+	}
+
+	entries, err := f.client.XRange(ctx, key, "("+lastEventID, "+").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		eventType, _ := entry.Values["type"].(string)
+		data, _ := entry.Values["data"].(string)
+		events = append(events, Event{ID: entry.ID, Type: eventType, Data: json.RawMessage(data)})
+	}
+
+	return events, nil
+}
+
+// ключи ленты разделены по арендатору из ctx: id преподавателей разных школ могут совпадать
+func historyKey(ctx context.Context, teacherID string) string {
+	id, _ := tenant.FromContext(ctx)
+	return "slots:history:" + id + ":" + teacherID
+}
+
+func liveChannel(ctx context.Context, teacherID string) string {
+	id, _ := tenant.FromContext(ctx)
+	return "slots:live:" + id + ":" + teacherID
+}
+
+// parseID разбирает id записи Redis Stream вида "<ms>-<seq>"
+func parseID(id string) (uint64, uint64, bool) {
+	msPart, seqPart, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, 0, false
+	}
+
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return ms, seq, true
+}
+
+// after сообщает, что id записан позже last
+func after(id, last string) bool {
+	ms, seq, ok := parseID(id)
+	if !ok {
+		return false
+	}
+	lastMs, lastSeq, ok := parseID(last)
+	if !ok {
+		return true
+	}
+
+	return ms > lastMs || ms == lastMs && seq > lastSeq
+}
@@ -0,0 +1,9 @@
+-- Calendar Feeds
+-- Токен подписки на ICS-ленту преподавателя или студента; хранится только sha256 от токена
+CREATE TABLE IF NOT EXISTS calendar_tokens (
+    owner_type TEXT NOT NULL CHECK (owner_type IN ('teacher', 'student')),
+    owner_id TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner_type, owner_id)
+);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_time_blocks_external_uid;
+
+DELETE FROM time_blocks WHERE type = 'external';
+
+ALTER TABLE time_blocks DROP COLUMN IF EXISTS external_uid;
+
+ALTER TABLE time_blocks DROP CONSTRAINT IF EXISTS time_blocks_type_check;
+ALTER TABLE time_blocks ADD CONSTRAINT time_blocks_type_check
+    CHECK (type IN ('vacation', 'sick', 'other'));
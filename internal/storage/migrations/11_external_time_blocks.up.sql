@@ -0,0 +1,10 @@
+-- External Time Blocks
+-- Занятость из внешних календарей (ICS): тип external и UID вхождения для идемпотентной синхронизации
+ALTER TABLE time_blocks DROP CONSTRAINT IF EXISTS time_blocks_type_check;
+ALTER TABLE time_blocks ADD CONSTRAINT time_blocks_type_check
+    CHECK (type IN ('vacation', 'sick', 'other', 'external'));
+
+ALTER TABLE time_blocks ADD COLUMN IF NOT EXISTS external_uid TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_blocks_external_uid
+    ON time_blocks (teacher_id, external_uid) WHERE external_uid IS NOT NULL;
@@ -0,0 +1,34 @@
+-- Reminders
+-- Напоминание получателю (студенту или преподавателю) за offset_minutes до начала урока.
+-- Строка одна на (бронь, получатель, смещение): перенос брони сдвигает lesson_start и send_at,
+-- отмена переводит в cancelled
+CREATE TABLE IF NOT EXISTS reminders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    recipient_role TEXT NOT NULL CHECK (recipient_role IN ('student', 'teacher')),
+    recipient_id TEXT NOT NULL,
+    offset_minutes INTEGER NOT NULL CHECK (offset_minutes > 0),
+    lesson_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    send_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed', 'cancelled', 'expired')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (booking_id, recipient_role, offset_minutes)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminders_due ON reminders (send_at) WHERE status = 'pending';
+
+-- Журнал отправленных напоминаний: запись делается до отправки, поэтому после
+-- перезапуска то же напоминание к тому же времени урока повторно не уходит
+CREATE TABLE IF NOT EXISTS reminder_deliveries (
+    booking_id UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    recipient_role TEXT NOT NULL,
+    offset_minutes INTEGER NOT NULL,
+    lesson_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    reminder_id UUID NOT NULL REFERENCES reminders(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (booking_id, recipient_role, offset_minutes, lesson_start)
+);
@@ -0,0 +1,17 @@
+-- API Keys
+-- Ключ для межсервисных вызовов; хранится только sha256 от ключа, prefix — для узнавания в списке.
+-- teacher_id ограничивает ключ данными одного преподавателя, revoked_at — отзыв без удаления
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    teacher_id TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_by TEXT,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_by TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
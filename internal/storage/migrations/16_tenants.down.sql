@@ -0,0 +1,86 @@
+DROP POLICY IF EXISTS tenant_isolation ON availability_templates;
+ALTER TABLE availability_templates NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE availability_templates DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON time_blocks;
+ALTER TABLE time_blocks NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE time_blocks DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON slots;
+ALTER TABLE slots NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE slots DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON bookings;
+ALTER TABLE bookings NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE bookings DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON attendance;
+ALTER TABLE attendance NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE attendance DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON booking_rules;
+ALTER TABLE booking_rules NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE booking_rules DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON booking_events;
+ALTER TABLE booking_events NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE booking_events DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON student_restrictions;
+ALTER TABLE student_restrictions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE student_restrictions DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON makeup_credits;
+ALTER TABLE makeup_credits NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE makeup_credits DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON calendar_tokens;
+ALTER TABLE calendar_tokens NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE calendar_tokens DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_subscriptions;
+ALTER TABLE webhook_subscriptions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE webhook_subscriptions DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_deliveries;
+ALTER TABLE webhook_deliveries NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON outbox;
+ALTER TABLE outbox NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE outbox DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON reminders;
+ALTER TABLE reminders NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE reminders DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON reminder_deliveries;
+ALTER TABLE reminder_deliveries NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE reminder_deliveries DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON api_keys;
+ALTER TABLE api_keys NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE api_keys DISABLE ROW LEVEL SECURITY;
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_student_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_student_no_overlap
+    EXCLUDE USING gist (student_id WITH =, period WITH &&)
+    WHERE (status <> 'cancelled' AND deleted_at IS NULL);
+
+DROP INDEX IF EXISTS idx_time_blocks_external_uid;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_blocks_external_uid
+    ON time_blocks (teacher_id, external_uid) WHERE external_uid IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_booking_rules_teacher;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_rules_teacher ON booking_rules (teacher_id) WHERE template_id IS NULL;
+DROP INDEX IF EXISTS uq_booking_rules_template;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_rules_template ON booking_rules (teacher_id, template_id) WHERE template_id IS NOT NULL;
+
+ALTER TABLE reminder_deliveries DROP CONSTRAINT IF EXISTS reminder_deliveries_pkey;
+ALTER TABLE reminder_deliveries ADD CONSTRAINT reminder_deliveries_pkey
+    PRIMARY KEY (booking_id, recipient_role, offset_minutes, lesson_start);
+
+ALTER TABLE calendar_tokens DROP CONSTRAINT IF EXISTS calendar_tokens_pkey;
+ALTER TABLE calendar_tokens ADD CONSTRAINT calendar_tokens_pkey PRIMARY KEY (owner_type, owner_id);
+
+ALTER TABLE availability_templates DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE time_blocks DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE slots DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE attendance DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE booking_rules DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE booking_events DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE student_restrictions DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE makeup_credits DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE calendar_tokens DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE webhook_subscriptions DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE outbox DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE reminder_deliveries DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,186 @@
+-- Tenants
+-- Каждая строка принадлежит арендатору (школе). Существующие данные переходят к арендатору 'default'.
+-- RLS — страховка: запрос без app.tenant_id или с чужим арендатором не видит и не пишет чужие строки.
+-- Суперпользователь и BYPASSRLS-роль обходят политики, поэтому сервис подключается ролью rasp_app (17_app_role).
+ALTER TABLE availability_templates ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE time_blocks ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE slots ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE attendance ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE booking_rules ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE booking_events ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE student_restrictions ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE makeup_credits ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE calendar_tokens ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook_subscriptions ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE reminder_deliveries ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE availability_templates ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE time_blocks ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE slots ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE bookings ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE attendance ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE booking_rules ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE booking_events ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE student_restrictions ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE makeup_credits ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE calendar_tokens ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE webhook_subscriptions ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE webhook_deliveries ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE outbox ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE reminders ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE reminder_deliveries ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE api_keys ALTER COLUMN tenant_id DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS idx_availability_templates_tenant_id ON availability_templates (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_time_blocks_tenant_id ON time_blocks (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_slots_tenant_id ON slots (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_tenant_id ON bookings (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_attendance_tenant_id ON attendance (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_booking_events_tenant_id ON booking_events (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_student_restrictions_tenant_id ON student_restrictions (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_makeup_credits_tenant_id ON makeup_credits (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_tenant_id ON webhook_subscriptions (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_tenant_id ON webhook_deliveries (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_tenant_id ON outbox (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_reminders_tenant_id ON reminders (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_tenant_id ON api_keys (tenant_id);
+
+-- Уникальность в пределах арендатора
+ALTER TABLE calendar_tokens DROP CONSTRAINT IF EXISTS calendar_tokens_pkey;
+ALTER TABLE calendar_tokens ADD CONSTRAINT calendar_tokens_pkey PRIMARY KEY (tenant_id, owner_type, owner_id);
+
+ALTER TABLE reminder_deliveries DROP CONSTRAINT IF EXISTS reminder_deliveries_pkey;
+ALTER TABLE reminder_deliveries ADD CONSTRAINT reminder_deliveries_pkey
+    PRIMARY KEY (tenant_id, booking_id, recipient_role, offset_minutes, lesson_start);
+
+DROP INDEX IF EXISTS uq_booking_rules_teacher;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_rules_teacher ON booking_rules (tenant_id, teacher_id) WHERE template_id IS NULL;
+DROP INDEX IF EXISTS uq_booking_rules_template;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_rules_template ON booking_rules (tenant_id, teacher_id, template_id) WHERE template_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_time_blocks_external_uid;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_blocks_external_uid
+    ON time_blocks (tenant_id, teacher_id, external_uid) WHERE external_uid IS NOT NULL;
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_student_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_student_no_overlap
+    EXCLUDE USING gist (tenant_id WITH =, student_id WITH =, period WITH &&)
+    WHERE (status <> 'cancelled' AND deleted_at IS NULL);
+
+-- Row Level Security
+ALTER TABLE availability_templates ENABLE ROW LEVEL SECURITY;
+ALTER TABLE availability_templates FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON availability_templates;
+CREATE POLICY tenant_isolation ON availability_templates
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE time_blocks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE time_blocks FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON time_blocks;
+CREATE POLICY tenant_isolation ON time_blocks
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE slots ENABLE ROW LEVEL SECURITY;
+ALTER TABLE slots FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON slots;
+CREATE POLICY tenant_isolation ON slots
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE bookings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE bookings FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON bookings;
+CREATE POLICY tenant_isolation ON bookings
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE attendance ENABLE ROW LEVEL SECURITY;
+ALTER TABLE attendance FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON attendance;
+CREATE POLICY tenant_isolation ON attendance
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE booking_rules ENABLE ROW LEVEL SECURITY;
+ALTER TABLE booking_rules FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON booking_rules;
+CREATE POLICY tenant_isolation ON booking_rules
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE booking_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE booking_events FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON booking_events;
+CREATE POLICY tenant_isolation ON booking_events
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE student_restrictions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE student_restrictions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON student_restrictions;
+CREATE POLICY tenant_isolation ON student_restrictions
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE makeup_credits ENABLE ROW LEVEL SECURITY;
+ALTER TABLE makeup_credits FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON makeup_credits;
+CREATE POLICY tenant_isolation ON makeup_credits
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE calendar_tokens ENABLE ROW LEVEL SECURITY;
+ALTER TABLE calendar_tokens FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON calendar_tokens;
+CREATE POLICY tenant_isolation ON calendar_tokens
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE webhook_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_subscriptions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_subscriptions;
+CREATE POLICY tenant_isolation ON webhook_subscriptions
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_deliveries;
+CREATE POLICY tenant_isolation ON webhook_deliveries
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE outbox ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON outbox;
+CREATE POLICY tenant_isolation ON outbox
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE reminders ENABLE ROW LEVEL SECURITY;
+ALTER TABLE reminders FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON reminders;
+CREATE POLICY tenant_isolation ON reminders
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE reminder_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE reminder_deliveries FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON reminder_deliveries;
+CREATE POLICY tenant_isolation ON reminder_deliveries
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE api_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE api_keys FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON api_keys;
+CREATE POLICY tenant_isolation ON api_keys
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
@@ -0,0 +1,14 @@
+ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE USAGE, SELECT ON SEQUENCES FROM rasp_app;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE SELECT, INSERT, UPDATE, DELETE ON TABLES FROM rasp_app;
+
+REVOKE USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public FROM rasp_app;
+REVOKE SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public FROM rasp_app;
+REVOKE USAGE ON SCHEMA public FROM rasp_app;
+
+DO $$
+BEGIN
+    EXECUTE format('REVOKE CONNECT ON DATABASE %I FROM rasp_app', current_database());
+END
+$$;
+
+DROP ROLE IF EXISTS rasp_app;
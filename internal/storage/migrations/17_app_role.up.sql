@@ -0,0 +1,29 @@
+-- Application Role
+-- Роль, которой подключается сервис: не суперпользователь, не владелец таблиц и без BYPASSRLS,
+-- поэтому политики tenant_isolation из 16_tenants действуют на каждый её запрос.
+-- Миграции по-прежнему применяет владелец схемы. Пароль — для локальной разработки,
+-- в остальных окружениях его меняют: ALTER ROLE rasp_app PASSWORD '...'.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'rasp_app') THEN
+        CREATE ROLE rasp_app LOGIN PASSWORD 'rasp-app-local-dev';
+    END IF;
+END
+$$;
+
+ALTER ROLE rasp_app NOSUPERUSER NOCREATEDB NOCREATEROLE NOREPLICATION NOBYPASSRLS;
+
+DO $$
+BEGIN
+    EXECUTE format('GRANT CONNECT ON DATABASE %I TO rasp_app', current_database());
+END
+$$;
+
+-- Только DML: схему меняют миграции
+GRANT USAGE ON SCHEMA public TO rasp_app;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO rasp_app;
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO rasp_app;
+
+-- Таблицы следующих миграций получают те же права
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO rasp_app;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO rasp_app;
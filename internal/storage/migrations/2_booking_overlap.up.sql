@@ -0,0 +1,16 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Период брони дублирует время слота, чтобы ограничение работало в пределах одной таблицы
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS period TSTZRANGE;
+
+UPDATE bookings b
+SET period = tstzrange(s.starts_at, s.ends_at)
+FROM slots s
+WHERE s.id = b.slot_id AND b.period IS NULL;
+
+ALTER TABLE bookings ALTER COLUMN period SET NOT NULL;
+
+-- Студент не может иметь две активные брони, пересекающиеся по времени
+ALTER TABLE bookings ADD CONSTRAINT bookings_student_no_overlap
+    EXCLUDE USING gist (student_id WITH =, period WITH &&)
+    WHERE (status <> 'cancelled');
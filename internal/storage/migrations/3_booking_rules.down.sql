@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_booking_rules_updated_at ON booking_rules;
+
+DROP TABLE IF EXISTS booking_rules;
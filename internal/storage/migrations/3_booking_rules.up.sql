@@ -0,0 +1,20 @@
+-- Booking Rules
+-- Правило с template_id действует только для слотов этого шаблона и имеет приоритет над правилом преподавателя
+CREATE TABLE IF NOT EXISTS booking_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    teacher_id TEXT NOT NULL,
+    template_id UUID REFERENCES availability_templates(id) ON DELETE CASCADE,
+    min_notice_hours INTEGER CHECK (min_notice_hours >= 0),
+    max_advance_days INTEGER CHECK (max_advance_days >= 0),
+    max_weekly_bookings INTEGER CHECK (max_weekly_bookings >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_rules_teacher ON booking_rules (teacher_id) WHERE template_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_booking_rules_template ON booking_rules (teacher_id, template_id) WHERE template_id IS NOT NULL;
+
+CREATE TRIGGER update_booking_rules_updated_at
+    BEFORE UPDATE ON booking_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,16 @@
+-- Booking Events
+-- booking_id без внешнего ключа: история должна переживать удаление брони
+CREATE TABLE IF NOT EXISTS booking_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    event_type TEXT NOT NULL CHECK (event_type IN ('created', 'confirmed', 'cancelled', 'rescheduled', 'deleted')),
+    from_status TEXT,
+    to_status TEXT,
+    from_slot_id UUID,
+    to_slot_id UUID,
+    actor TEXT NOT NULL,
+    request_id TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_events_booking_id ON booking_events (booking_id, created_at);
@@ -0,0 +1,11 @@
+ALTER TABLE booking_events DROP CONSTRAINT IF EXISTS booking_events_event_type_check;
+ALTER TABLE booking_events ADD CONSTRAINT booking_events_event_type_check
+    CHECK (event_type IN ('created', 'confirmed', 'cancelled', 'rescheduled', 'deleted'));
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_student_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_student_no_overlap
+    EXCLUDE USING gist (student_id WITH =, period WITH &&)
+    WHERE (status <> 'cancelled');
+
+DROP INDEX IF EXISTS idx_bookings_deleted_at;
+ALTER TABLE bookings DROP COLUMN IF EXISTS deleted_at;
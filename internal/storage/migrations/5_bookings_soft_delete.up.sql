@@ -0,0 +1,13 @@
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_deleted_at ON bookings (deleted_at);
+
+-- Удалённые брони не должны мешать новым
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_student_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_student_no_overlap
+    EXCLUDE USING gist (student_id WITH =, period WITH &&)
+    WHERE (status <> 'cancelled' AND deleted_at IS NULL);
+
+ALTER TABLE booking_events DROP CONSTRAINT IF EXISTS booking_events_event_type_check;
+ALTER TABLE booking_events ADD CONSTRAINT booking_events_event_type_check
+    CHECK (event_type IN ('created', 'confirmed', 'cancelled', 'rescheduled', 'deleted', 'restored'));
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_slots_ends_at;
+
+ALTER TABLE attendance DROP COLUMN IF EXISTS auto_marked;
@@ -0,0 +1,4 @@
+-- Отметки, проставленные воркером неявок; преподаватель может их перезаписать
+ALTER TABLE attendance ADD COLUMN IF NOT EXISTS auto_marked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_slots_ends_at ON slots (ends_at);
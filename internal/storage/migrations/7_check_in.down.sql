@@ -0,0 +1,3 @@
+ALTER TABLE attendance DROP COLUMN IF EXISTS checked_in_at;
+
+ALTER TABLE slots DROP COLUMN IF EXISTS checkin_secret;
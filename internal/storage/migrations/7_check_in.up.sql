@@ -0,0 +1,5 @@
+-- Секрет для одноразовых кодов самоотметки, создаётся при первом показе кода
+ALTER TABLE slots ADD COLUMN IF NOT EXISTS checkin_secret TEXT;
+
+-- Момент самоотметки студента
+ALTER TABLE attendance ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,3 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS requires_teacher_confirmation;
+
+DROP TABLE IF EXISTS student_restrictions;
@@ -0,0 +1,19 @@
+-- Student Restrictions
+-- Ограничение после серии неявок или поздних отмен; действует до ends_at или до снятия администратором
+CREATE TABLE IF NOT EXISTS student_restrictions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    student_id TEXT NOT NULL,
+    mode TEXT NOT NULL CHECK (mode IN ('reject', 'require_confirmation')),
+    absences INTEGER NOT NULL DEFAULT 0,
+    late_cancellations INTEGER NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    lifted_at TIMESTAMP WITH TIME ZONE,
+    lifted_by TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_restrictions_student ON student_restrictions (student_id, starts_at DESC);
+
+-- Брони, созданные в режиме require_confirmation, подтверждает только преподаватель
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS requires_teacher_confirmation BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS makeup_credits;
+
+ALTER TABLE attendance DROP COLUMN IF EXISTS excused;
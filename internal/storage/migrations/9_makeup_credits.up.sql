@@ -0,0 +1,19 @@
+-- Уважительная неявка
+ALTER TABLE attendance ADD COLUMN IF NOT EXISTS excused BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Makeup Credits
+-- Кредит на отработку выдаётся за уважительную неявку или отмену урока преподавателем
+-- и погашается новой бронью к тому же преподавателю
+CREATE TABLE IF NOT EXISTS makeup_credits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    student_id TEXT NOT NULL,
+    teacher_id TEXT NOT NULL,
+    source_booking_id UUID NOT NULL UNIQUE,
+    reason TEXT NOT NULL CHECK (reason IN ('excused_absence', 'teacher_cancelled')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    redeemed_booking_id UUID,
+    redeemed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_makeup_credits_student ON makeup_credits (student_id, expires_at) WHERE redeemed_at IS NULL;
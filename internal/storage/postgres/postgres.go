@@ -3,8 +3,10 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"rasp-service/internal/models"
+	"rasp-service/pkg/middleware/tenant"
 	"rasp-service/pkg/response"
 	"time"
 
@@ -34,8 +36,190 @@ func (s *Storage) Close() error {
 	return s.db.Close()
 }
 
+// BeginTx открывает транзакцию арендатора из контекста: app.tenant_id до конца
+// транзакции видят политики RLS. Без арендатора транзакция не открывается.
 func (s *Storage) BeginTx(ctx context.Context) (*sql.Tx, error) {
-	return s.db.BeginTx(ctx, nil)
+	const op = "storage.postgres.BeginTx"
+
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, response.ErrTenantRequired)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT set_config('app.tenant_id', $1, true)`, id); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tx, nil
+}
+
+// tenantID — арендатор запроса для явных условий tenant_id = $N. Пустым он к базе
+// не попадёт: BeginTx без арендатора возвращает ошибку.
+func tenantID(ctx context.Context) string {
+	id, _ := tenant.FromContext(ctx)
+	return id
+}
+
+// rows — общий интерфейс *sql.Rows и выборки в транзакции арендатора
+type rows interface {
+	Next() bool
+	Scan(dest ...any) error
+	Close() error
+	Err() error
+}
+
+// querier — общий интерфейс транзакции сервиса и пула соединений
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) rowScanner
+}
+
+// conn возвращает транзакцию, если она передана, иначе пул соединений
+func (s *Storage) conn(tx *sql.Tx) querier {
+	if tx != nil {
+		return txQuerier{tx: tx}
+	}
+	return tenantPool{s: s}
+}
+
+type txQuerier struct {
+	tx *sql.Tx
+}
+
+func (q txQuerier) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return q.tx.ExecContext(ctx, query, args...)
+}
+
+func (q txQuerier) QueryContext(ctx context.Context, query string, args ...any) (rows, error) {
+	r, err := q.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (q txQuerier) QueryRowContext(ctx context.Context, query string, args ...any) rowScanner {
+	return q.tx.QueryRowContext(ctx, query, args...)
+}
+
+// tenantPool выполняет запрос вне транзакции сервиса в своей короткой транзакции
+// арендатора: без app.tenant_id политики RLS не покажут ни одной строки
+type tenantPool struct {
+	s *Storage
+}
+
+func (p tenantPool) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	tx, err := p.s.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return res, tx.Commit()
+}
+
+func (p tenantPool) QueryContext(ctx context.Context, query string, args ...any) (rows, error) {
+	tx, err := p.s.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return &txRows{Rows: r, tx: tx}, nil
+}
+
+func (p tenantPool) QueryRowContext(ctx context.Context, query string, args ...any) rowScanner {
+	tx, err := p.s.BeginTx(ctx)
+	if err != nil {
+		return errRow{err: err}
+	}
+
+	return &txRow{row: tx.QueryRowContext(ctx, query, args...), tx: tx}
+}
+
+// txRows завершает транзакцию выборки при закрытии
+type txRows struct {
+	*sql.Rows
+	tx     *sql.Tx
+	closed bool
+}
+
+func (r *txRows) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	if err := r.Rows.Close(); err != nil {
+		_ = r.tx.Rollback()
+		return err
+	}
+	return r.tx.Commit()
+}
+
+// txRow завершает транзакцию после Scan; sql.ErrNoRows возвращается как есть
+type txRow struct {
+	row *sql.Row
+	tx  *sql.Tx
+}
+
+func (r *txRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		_ = r.tx.Rollback()
+		return err
+	}
+
+	if commitErr := r.tx.Commit(); commitErr != nil {
+		return commitErr
+	}
+	return err
+}
+
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(dest ...any) error {
+	return r.err
+}
+
+const (
+	pqUniqueViolation    = "23505"
+	pqExclusionViolation = "23P01"
+
+	constraintStudentNoOverlap = "bookings_student_no_overlap"
+)
+
+// isConstraintViolation проверяет, что ошибка postgres вызвана нарушением указанного ограничения
+func isConstraintViolation(err error, code pq.ErrorCode, constraint string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == code && pqErr.Constraint == constraint
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation
 }
 
 // Availability Templates
@@ -44,11 +228,11 @@ func (s *Storage) CreateAvailabilityTemplate(ctx context.Context, template *mode
 	const op = "storage.postgres.CreateAvailabilityTemplate"
 
 	var id string
-	err := s.db.QueryRowContext(ctx,
+	err := s.conn(nil).QueryRowContext(ctx,
 		`INSERT INTO availability_templates 
 		(teacher_id, recurrence_days, recurrence_start_time, recurrence_end_time, 
-		 slot_duration_minutes, start_date, end_date, enabled)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 slot_duration_minutes, start_date, end_date, enabled, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id`,
 		template.TeacherID,
 		pq.Array(template.RecurrenceDays),
@@ -58,6 +242,7 @@ func (s *Storage) CreateAvailabilityTemplate(ctx context.Context, template *mode
 		template.StartDate,
 		template.EndDate,
 		template.Enabled,
+		tenantID(ctx),
 	).Scan(&id)
 
 	if err != nil {
@@ -73,11 +258,11 @@ func (s *Storage) GetAvailabilityTemplate(ctx context.Context, id string) (*mode
 	var template models.AvailabilityTplSlot
 	var recurrenceDays pq.StringArray
 
-	err := s.db.QueryRowContext(ctx,
+	err := s.conn(nil).QueryRowContext(ctx,
 		`SELECT id, teacher_id, recurrence_days, recurrence_start_time, recurrence_end_time,
 		 slot_duration_minutes, start_date, end_date, enabled
-		 FROM availability_templates WHERE id = $1`,
-		id,
+		 FROM availability_templates WHERE id = $1 AND tenant_id = $2`,
+		id, tenantID(ctx),
 	).Scan(
 		&template.ID,
 		&template.TeacherID,
@@ -102,16 +287,62 @@ func (s *Storage) GetAvailabilityTemplate(ctx context.Context, id string) (*mode
 	return &template, nil
 }
 
+// ListAvailabilityTemplates возвращает шаблоны преподавателя по дате начала
+func (s *Storage) ListAvailabilityTemplates(ctx context.Context, teacherID string) ([]*models.AvailabilityTplSlot, error) {
+	const op = "storage.postgres.ListAvailabilityTemplates"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT id, teacher_id, recurrence_days, recurrence_start_time, recurrence_end_time,
+		 slot_duration_minutes, start_date, end_date, enabled
+		 FROM availability_templates WHERE teacher_id = $1 AND tenant_id = $2
+		 ORDER BY start_date, id`,
+		teacherID, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var templates []*models.AvailabilityTplSlot
+	for rows.Next() {
+		var template models.AvailabilityTplSlot
+		var recurrenceDays pq.StringArray
+
+		err := rows.Scan(
+			&template.ID,
+			&template.TeacherID,
+			&recurrenceDays,
+			&template.RecurrenceStartTime,
+			&template.RecurrenceEndTime,
+			&template.SlotDurationMinutes,
+			&template.StartDate,
+			&template.EndDate,
+			&template.Enabled,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		template.RecurrenceDays = []string(recurrenceDays)
+		templates = append(templates, &template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return templates, nil
+}
+
 
 func (s *Storage) UpdateAvailabilityTemplate(ctx context.Context, template *models.AvailabilityTplSlot) error {
 	const op = "storage.postgres.UpdateAvailabilityTemplate"
 
-	res, err := s.db.ExecContext(ctx,
+	res, err := s.conn(nil).ExecContext(ctx,
 		`UPDATE availability_templates 
 		SET teacher_id = $1, recurrence_days = $2, recurrence_start_time = $3, 
 		    recurrence_end_time = $4, slot_duration_minutes = $5, start_date = $6,
 		    end_date = $7, enabled = $8
-		WHERE id = $9`,
+		WHERE id = $9 AND tenant_id = $10`,
 		template.TeacherID,
 		pq.Array(template.RecurrenceDays),
 		template.RecurrenceStartTime,
@@ -121,6 +352,7 @@ func (s *Storage) UpdateAvailabilityTemplate(ctx context.Context, template *mode
 		template.EndDate,
 		template.Enabled,
 		template.ID,
+		tenantID(ctx),
 	)
 
 	if err != nil {
@@ -142,7 +374,7 @@ func (s *Storage) UpdateAvailabilityTemplate(ctx context.Context, template *mode
 func (s *Storage) DeleteAvailabilityTemplate(ctx context.Context, id string) error {
 	const op = "storage.postgres.DeleteAvailabilityTemplate"
 
-	res, err := s.db.ExecContext(ctx, `DELETE FROM availability_templates WHERE id = $1`, id)
+	res, err := s.conn(nil).ExecContext(ctx, `DELETE FROM availability_templates WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx))
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -165,15 +397,16 @@ func (s *Storage) CreateTimeBlock(ctx context.Context, block *models.TimeBlock)
 	const op = "storage.postgres.CreateTimeBlock"
 
 	var id string
-	err := s.db.QueryRowContext(ctx,
-		`INSERT INTO time_blocks (teacher_id, start, "end", reason, type)
-		VALUES ($1, $2, $3, $4, $5)
+	err := s.conn(nil).QueryRowContext(ctx,
+		`INSERT INTO time_blocks (teacher_id, start, "end", reason, type, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id`,
 		block.TeacherID,
 		block.Start,
 		block.End,
 		block.Reason,
 		string(block.Type),
+		tenantID(ctx),
 	).Scan(&id)
 
 	if err != nil {
@@ -189,10 +422,10 @@ func (s *Storage) GetTimeBlock(ctx context.Context, id string) (*models.TimeBloc
 	var block models.TimeBlock
 	var blockType string
 
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, teacher_id, start, "end", reason, type, created_at, updated_at
-		 FROM time_blocks WHERE id = $1`,
-		id,
+	err := s.conn(nil).QueryRowContext(ctx,
+		`SELECT id, teacher_id, start, "end", reason, type, external_uid, created_at, updated_at
+		 FROM time_blocks WHERE id = $1 AND tenant_id = $2`,
+		id, tenantID(ctx),
 	).Scan(
 		&block.ID,
 		&block.TeacherID,
@@ -200,6 +433,7 @@ func (s *Storage) GetTimeBlock(ctx context.Context, id string) (*models.TimeBloc
 		&block.End,
 		&block.Reason,
 		&blockType,
+		&block.ExternalUID,
 		&block.CreatedAt,
 		&block.UpdatedAt,
 	)
@@ -219,9 +453,9 @@ func (s *Storage) GetTimeBlock(ctx context.Context, id string) (*models.TimeBloc
 func (s *Storage) ListTimeBlocks(ctx context.Context, teacherID *string, from, to *time.Time) ([]*models.TimeBlock, error) {
 	const op = "storage.postgres.ListTimeBlocks"
 
-	query := `SELECT id, teacher_id, start, "end", reason, type, created_at, updated_at FROM time_blocks WHERE 1=1`
-	args := []interface{}{}
-	argPos := 1
+	query := `SELECT id, teacher_id, start, "end", reason, type, external_uid, created_at, updated_at FROM time_blocks WHERE tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
+	argPos := 2
 
 	if teacherID != nil {
 		query += fmt.Sprintf(" AND teacher_id = $%d", argPos)
@@ -243,7 +477,7 @@ func (s *Storage) ListTimeBlocks(ctx context.Context, teacherID *string, from, t
 
 	query += " ORDER BY start DESC"
 
-	rows, err := s.db.QueryContext(ctx, query, args...)
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -261,6 +495,7 @@ func (s *Storage) ListTimeBlocks(ctx context.Context, teacherID *string, from, t
 			&block.End,
 			&block.Reason,
 			&blockType,
+			&block.ExternalUID,
 			&block.CreatedAt,
 			&block.UpdatedAt,
 		)
@@ -278,16 +513,17 @@ func (s *Storage) ListTimeBlocks(ctx context.Context, teacherID *string, from, t
 func (s *Storage) UpdateTimeBlock(ctx context.Context, block *models.TimeBlock) error {
 	const op = "storage.postgres.UpdateTimeBlock"
 
-	res, err := s.db.ExecContext(ctx,
+	res, err := s.conn(nil).ExecContext(ctx,
 		`UPDATE time_blocks 
 		SET teacher_id = $1, start = $2, "end" = $3, reason = $4, type = $5
-		WHERE id = $6`,
+		WHERE id = $6 AND tenant_id = $7`,
 		block.TeacherID,
 		block.Start,
 		block.End,
 		block.Reason,
 		string(block.Type),
 		block.ID,
+		tenantID(ctx),
 	)
 
 	if err != nil {
@@ -309,7 +545,7 @@ func (s *Storage) UpdateTimeBlock(ctx context.Context, block *models.TimeBlock)
 func (s *Storage) DeleteTimeBlock(ctx context.Context, id string) error {
 	const op = "storage.postgres.DeleteTimeBlock"
 
-	res, err := s.db.ExecContext(ctx, `DELETE FROM time_blocks WHERE id = $1`, id)
+	res, err := s.conn(nil).ExecContext(ctx, `DELETE FROM time_blocks WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx))
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -326,8 +562,106 @@ func (s *Storage) DeleteTimeBlock(ctx context.Context, id string) error {
 	return nil
 }
 
+// slotUnderBlock — условие для UPDATE slots: слот пересекается с блоком времени преподавателя.
+// Освобождаемый слот под блоком становится blocked, а не free.
+const slotUnderBlock = `EXISTS (
+	SELECT 1 FROM time_blocks b
+	WHERE b.tenant_id = slots.tenant_id AND b.teacher_id = slots.teacher_id AND b.start < slots.ends_at AND b."end" > slots.starts_at)`
+
+// UpsertExternalTimeBlock создаёт или обновляет внешний блок по (tenant_id, teacher_id, external_uid).
+// created = true, если строка вставлена, а не обновлена.
+func (s *Storage) UpsertExternalTimeBlock(ctx context.Context, tx *sql.Tx, block *models.TimeBlock) (bool, error) {
+	const op = "storage.postgres.UpsertExternalTimeBlock"
+
+	var created bool
+	err := s.conn(tx).QueryRowContext(ctx,
+		`INSERT INTO time_blocks (teacher_id, start, "end", reason, type, external_uid, tenant_id)
+		VALUES ($1, $2, $3, $4, 'external', $5, $6)
+		ON CONFLICT (tenant_id, teacher_id, external_uid) WHERE external_uid IS NOT NULL DO UPDATE
+		SET start = EXCLUDED.start, "end" = EXCLUDED."end", reason = EXCLUDED.reason, type = 'external'
+		RETURNING (xmax = 0)`,
+		block.TeacherID,
+		block.Start,
+		block.End,
+		block.Reason,
+		block.ExternalUID,
+		tenantID(ctx),
+	).Scan(&created)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// DeleteStaleExternalTimeBlocks удаляет внешние блоки преподавателя, пересекающие [from, to),
+// которых нет среди keepUIDs — событие удалили или перенесли во внешнем календаре.
+// Блоки событий из keepEvents (сам UID и все его вхождения UID/время) не трогаются.
+func (s *Storage) DeleteStaleExternalTimeBlocks(ctx context.Context, tx *sql.Tx, teacherID string, keepUIDs, keepEvents []string, from, to time.Time) (int, error) {
+	const op = "storage.postgres.DeleteStaleExternalTimeBlocks"
+
+	res, err := s.conn(tx).ExecContext(ctx,
+		`DELETE FROM time_blocks
+		WHERE teacher_id = $1 AND type = 'external'
+		  AND "end" > $3 AND start < $4
+		  AND NOT (external_uid = ANY($2)) AND tenant_id = $5
+		  AND NOT EXISTS (
+			SELECT 1 FROM unnest($6::text[]) AS e(uid)
+			WHERE external_uid = e.uid OR starts_with(external_uid, e.uid || '/')
+		  )`,
+		teacherID, pq.Array(keepUIDs), from, to, tenantID(ctx), pq.Array(keepEvents),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// RefreshBlockedSlots приводит статусы слотов преподавателя в [from, to) в соответствие
+// с блоками времени: свободные слоты под блоком становятся blocked, заблокированные
+// без блока снова free. Занятые слоты не трогаются. Возвращает изменённые слоты.
+func (s *Storage) RefreshBlockedSlots(ctx context.Context, tx *sql.Tx, teacherID string, from, to time.Time) ([]*models.Slot, error) {
+	const op = "storage.postgres.RefreshBlockedSlots"
+
+	rows, err := s.conn(tx).QueryContext(ctx,
+		`UPDATE slots SET status = CASE WHEN status = 'free' THEN 'blocked' ELSE 'free' END
+		WHERE teacher_id = $1 AND ends_at > $2 AND starts_at < $3 AND tenant_id = $4
+		  AND ((status = 'free' AND `+slotUnderBlock+`)
+		    OR (status = 'blocked' AND NOT `+slotUnderBlock+`))
+		`+slotReturning,
+		teacherID, from, to, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var slots []*models.Slot
+	for rows.Next() {
+		slot, err := scanSlot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		slots = append(slots, slot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return slots, nil
+}
+
 // Slots
 
+// slotReturning — колонки слота для scanSlot после UPDATE
+const slotReturning = `RETURNING id, teacher_id, starts_at, ends_at, status, booking_id, template_id, created_at, updated_at`
+
 func (s *Storage) GetSlot(ctx context.Context, id string) (*models.Slot, error) {
 	const op = "storage.postgres.GetSlot"
 
@@ -335,10 +669,10 @@ func (s *Storage) GetSlot(ctx context.Context, id string) (*models.Slot, error)
 	var status string
 	var bookingID, templateID sql.NullString
 
-	err := s.db.QueryRowContext(ctx,
+	err := s.conn(nil).QueryRowContext(ctx,
 		`SELECT id, teacher_id, starts_at, ends_at, status, booking_id, template_id, created_at, updated_at
-		 FROM slots WHERE id = $1`,
-		id,
+		 FROM slots WHERE id = $1 AND tenant_id = $2`,
+		id, tenantID(ctx),
 	).Scan(
 		&slot.ID,
 		&slot.TeacherID,
@@ -369,24 +703,14 @@ func (s *Storage) GetSlot(ctx context.Context, id string) (*models.Slot, error)
 	return &slot, nil
 }
 
-type SlotFilters struct {
-	TeacherID *string
-	From      *time.Time
-	To        *time.Time
-	Duration  *int
-	Status    *string
-	Q         *string
-	Page      *int
-	PerPage   *int
-	Sort      *string
-}
+type SlotFilters = models.SlotFilters
 
 func (s *Storage) ListSlots(ctx context.Context, filters interface{}) ([]*models.Slot, error) {
 	const op = "storage.postgres.ListSlots"
 
-	query := `SELECT id, teacher_id, starts_at, ends_at, status, booking_id, template_id, created_at, updated_at FROM slots WHERE 1=1`
-	args := []interface{}{}
-	argPos := 1
+	query := `SELECT id, teacher_id, starts_at, ends_at, status, booking_id, template_id, created_at, updated_at FROM slots WHERE tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
+	argPos := 2
 
 	if filters != nil {
 		f, ok := filters.(*SlotFilters)
@@ -469,7 +793,7 @@ func (s *Storage) ListSlots(ctx context.Context, filters interface{}) ([]*models
 		query += " ORDER BY starts_at LIMIT 20 OFFSET 0"
 	}
 
-	rows, err := s.db.QueryContext(ctx, query, args...)
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -518,8 +842,69 @@ func (s *Storage) GetSlotsByIDs(ctx context.Context, ids []string) ([]*models.Sl
 	}
 
 	query := `SELECT id, teacher_id, starts_at, ends_at, status, booking_id, template_id, created_at, updated_at 
-			  FROM slots WHERE id = ANY($1)`
-	rows, err := s.db.QueryContext(ctx, query, pq.Array(ids))
+			  FROM slots WHERE id = ANY($1) AND tenant_id = $2`
+	rows, err := s.conn(nil).QueryContext(ctx, query, pq.Array(ids), tenantID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var slots []*models.Slot
+	for rows.Next() {
+		var slot models.Slot
+		var status string
+		var bookingID, templateID sql.NullString
+
+		err := rows.Scan(
+			&slot.ID,
+			&slot.TeacherID,
+			&slot.Start,
+			&slot.End,
+			&status,
+			&bookingID,
+			&templateID,
+			&slot.CreatedAt,
+			&slot.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		slot.Status = models.SlotStatus(status)
+		if bookingID.Valid {
+			slot.BookingID = &bookingID.String
+		}
+		if templateID.Valid {
+			slot.TemplateID = &templateID.String
+		}
+
+		slots = append(slots, &slot)
+	}
+
+	return slots, nil
+}
+
+// ListAlternativeSlots возвращает свободные будущие слоты того же преподавателя и той же длительности,
+// упорядоченные по удалённости от исходного слота
+func (s *Storage) ListAlternativeSlots(ctx context.Context, slotID string, limit int) ([]*models.Slot, error) {
+	const op = "storage.postgres.ListAlternativeSlots"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT s.id, s.teacher_id, s.starts_at, s.ends_at, s.status, s.booking_id, s.template_id, s.created_at, s.updated_at
+		FROM slots s
+		JOIN slots ref ON ref.id = $1 AND ref.tenant_id = s.tenant_id
+		WHERE s.tenant_id = $3
+		  AND s.teacher_id = ref.teacher_id
+		  AND s.id <> ref.id
+		  AND s.status = 'free'
+		  AND s.starts_at > CURRENT_TIMESTAMP
+		  AND s.ends_at - s.starts_at = ref.ends_at - ref.starts_at
+		ORDER BY ABS(EXTRACT(EPOCH FROM (s.starts_at - ref.starts_at))), s.starts_at
+		LIMIT $2`,
+		slotID,
+		limit,
+		tenantID(ctx),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -565,14 +950,15 @@ func (s *Storage) CreateSlot(ctx context.Context, tx *sql.Tx, slot *models.Slot)
 
 	var id string
 	err := tx.QueryRowContext(ctx,
-		`INSERT INTO slots (teacher_id, starts_at, ends_at, status, template_id)
-		VALUES ($1, $2, $3, $4, $5)
+		`INSERT INTO slots (teacher_id, starts_at, ends_at, status, template_id, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id`,
 		slot.TeacherID,
 		slot.Start,
 		slot.End,
 		string(slot.Status),
 		slot.TemplateID,
+		tenantID(ctx),
 	).Scan(&id)
 
 	if err != nil {
@@ -582,34 +968,43 @@ func (s *Storage) CreateSlot(ctx context.Context, tx *sql.Tx, slot *models.Slot)
 	return id, nil
 }
 
-func (s *Storage) UpdateSlotStatus(ctx context.Context, slotID string, status models.SlotStatus, bookingID *string) error {
+// UpdateSlotStatus меняет статус слота и возвращает слот после изменения: освобождённый
+// слот под блоком времени получает статус blocked, а не free
+func (s *Storage) UpdateSlotStatus(ctx context.Context, tx *sql.Tx, slotID string, status models.SlotStatus, bookingID *string) (*models.Slot, error) {
 	const op = "storage.postgres.UpdateSlotStatus"
 
-	_, err := s.db.ExecContext(ctx,
-		`UPDATE slots SET status = $1, booking_id = $2 WHERE id = $3`,
+	slot, err := scanSlot(s.conn(tx).QueryRowContext(ctx,
+		`UPDATE slots SET status = CASE WHEN $1 = 'free' AND `+slotUnderBlock+` THEN 'blocked' ELSE $1 END,
+			booking_id = $2
+		WHERE id = $3 AND tenant_id = $4
+		`+slotReturning,
 		string(status),
 		bookingID,
 		slotID,
-	)
+		tenantID(ctx),
+	))
 
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return slot, nil
 }
 
-func (s *Storage) GetSlotForBooking(ctx context.Context, slotID string) (*models.Slot, error) {
+func (s *Storage) GetSlotForBooking(ctx context.Context, tx *sql.Tx, slotID string) (*models.Slot, error) {
 	const op = "storage.postgres.GetSlotForBooking"
 
 	var slot models.Slot
 	var status string
 	var bookingID, templateID sql.NullString
 
-	err := s.db.QueryRowContext(ctx,
+	err := s.conn(tx).QueryRowContext(ctx,
 		`SELECT id, teacher_id, starts_at, ends_at, status, booking_id, template_id
-		 FROM slots WHERE id = $1 FOR UPDATE`,
-		slotID,
+		 FROM slots WHERE id = $1 AND tenant_id = $2 FOR UPDATE`,
+		slotID, tenantID(ctx),
 	).Scan(
 		&slot.ID,
 		&slot.TeacherID,
@@ -630,6 +1025,11 @@ func (s *Storage) GetSlotForBooking(ctx context.Context, slotID string) (*models
 		return nil, fmt.Errorf("%s: %w", op, response.ErrSlotNotAvailable)
 	}
 
+	slot.Status = models.SlotStatus(status)
+	if templateID.Valid {
+		slot.TemplateID = &templateID.String
+	}
+
 	return &slot, nil
 }
 
@@ -639,20 +1039,29 @@ func (s *Storage) CreateBooking(ctx context.Context, tx *sql.Tx, booking *models
 
 	var id string
 	err := tx.QueryRowContext(ctx,
-		`INSERT INTO bookings (slot_id, student_id, teacher_id, status)
-		VALUES ($1, $2, $3, $4)
+		`INSERT INTO bookings (slot_id, student_id, teacher_id, status, requires_teacher_confirmation, period, tenant_id)
+		SELECT $1, $2, $3, $4, $5, tstzrange(starts_at, ends_at), tenant_id
+		FROM slots WHERE id = $1 AND tenant_id = $6
 		RETURNING id`,
 		booking.SlotID,
 		booking.StudentID,
 		booking.TeacherID,
 		string(booking.Status),
+		booking.RequiresTeacherConfirmation,
+		tenantID(ctx),
 	).Scan(&id)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		if isConstraintViolation(err, pqExclusionViolation, constraintStudentNoOverlap) {
+			return "", fmt.Errorf("%s: %w", op, response.ErrBookingOverlap)
+		}
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
 	_, err = tx.ExecContext(ctx, 
-		`UPDATE slots SET status = $1, booking_id = $2 WHERE id = $3`,
-		string(models.SlotBooked), id, booking.SlotID,
+		`UPDATE slots SET status = $1, booking_id = $2 WHERE id = $3 AND tenant_id = $4`,
+		string(models.SlotBooked), id, booking.SlotID, tenantID(ctx),
 	)
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", op, err)
@@ -667,16 +1076,50 @@ func (s *Storage) GetBooking(ctx context.Context, id string) (*models.Booking, e
 	var booking models.Booking
 	var status string
 
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, slot_id, student_id, teacher_id, status
-		 FROM bookings WHERE id = $1`,
-		id,
+	err := s.conn(nil).QueryRowContext(ctx,
+		`SELECT id, slot_id, student_id, teacher_id, status, requires_teacher_confirmation
+		 FROM bookings WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		id, tenantID(ctx),
+	).Scan(
+		&booking.ID,
+		&booking.SlotID,
+		&booking.StudentID,
+		&booking.TeacherID,
+		&status,
+		&booking.RequiresTeacherConfirmation,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking.Status = models.BookingStatus(status)
+
+	return &booking, nil
+}
+
+// GetBookingForUpdate возвращает бронь и блокирует её строку до конца транзакции
+func (s *Storage) GetBookingForUpdate(ctx context.Context, tx *sql.Tx, id string) (*models.Booking, error) {
+	const op = "storage.postgres.GetBookingForUpdate"
+
+	var booking models.Booking
+	var status string
+
+	err := s.conn(tx).QueryRowContext(ctx,
+		`SELECT id, slot_id, student_id, teacher_id, status, requires_teacher_confirmation
+		 FROM bookings WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		 FOR UPDATE`,
+		id, tenantID(ctx),
 	).Scan(
 		&booking.ID,
 		&booking.SlotID,
 		&booking.StudentID,
 		&booking.TeacherID,
 		&status,
+		&booking.RequiresTeacherConfirmation,
 	)
 
 	if err != nil {
@@ -691,13 +1134,17 @@ func (s *Storage) GetBooking(ctx context.Context, id string) (*models.Booking, e
 	return &booking, nil
 }
 
-func (s *Storage) ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string) ([]*models.Booking, error) {
+func (s *Storage) ListBookings(ctx context.Context, studentID, teacherID *string, from, to *time.Time, status *string, includeDeleted bool) ([]*models.Booking, error) {
 	const op = "storage.postgres.ListBookings"
 
-	query := `SELECT id, slot_id, student_id, teacher_id, status,
-				FROM bookings WHERE 1=1`
-	args := []interface{}{}
-	argPos := 1
+	query := `SELECT id, slot_id, student_id, teacher_id, status, requires_teacher_confirmation, deleted_at
+				FROM bookings WHERE tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
+	argPos := 2
+
+	if !includeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	if studentID != nil {
 		query += fmt.Sprintf(" AND student_id = $%d", argPos)
@@ -731,7 +1178,7 @@ func (s *Storage) ListBookings(ctx context.Context, studentID, teacherID *string
 
 	query += " ORDER BY created_at DESC"
 
-	rows, err := s.db.QueryContext(ctx, query, args...)
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -741,7 +1188,7 @@ func (s *Storage) ListBookings(ctx context.Context, studentID, teacherID *string
 	for rows.Next() {
 		var booking models.Booking
 		var status string
-		var paymentStr string
+		var deletedAt sql.NullTime
 
 		err := rows.Scan(
 			&booking.ID,
@@ -749,13 +1196,17 @@ func (s *Storage) ListBookings(ctx context.Context, studentID, teacherID *string
 			&booking.StudentID,
 			&booking.TeacherID,
 			&status,
-			&paymentStr,
+			&booking.RequiresTeacherConfirmation,
+			&deletedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 
 		booking.Status = models.BookingStatus(status)
+		if deletedAt.Valid {
+			booking.DeletedAt = &deletedAt.Time
+		}
 
 		bookings = append(bookings, &booking)
 	}
@@ -763,17 +1214,20 @@ func (s *Storage) ListBookings(ctx context.Context, studentID, teacherID *string
 	return bookings, nil
 }
 
-func (s *Storage) UpdateBookingStatus(ctx context.Context, bookingID string, status models.BookingStatus) error {
+func (s *Storage) UpdateBookingStatus(ctx context.Context, tx *sql.Tx, bookingID string, status models.BookingStatus) error {
 	const op = "storage.postgres.UpdateBookingStatus"
 
+	// менять статус можно только у действующей брони: повторная отмена записала бы
+	// ещё одно событие отмены и освободила бы слот, который уже мог занять другой студент
 	now := time.Now()
-	res, err := s.db.ExecContext(ctx,
+	res, err := s.conn(tx).ExecContext(ctx,
 		`UPDATE bookings 
 		SET status = $1, cancelled_at = CASE WHEN $1 = 'cancelled' THEN $2 ELSE cancelled_at END
-		WHERE id = $3`,
+		WHERE id = $3 AND tenant_id = $4 AND deleted_at IS NULL AND status IN ('pending', 'confirmed')`,
 		string(status),
 		now,
 		bookingID,
+		tenantID(ctx),
 	)
 
 	if err != nil {
@@ -786,56 +1240,136 @@ func (s *Storage) UpdateBookingStatus(ctx context.Context, bookingID string, sta
 	}
 
 	if rowsAffected == 0 {
+		var exists bool
+		err := s.conn(tx).QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM bookings WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL)`,
+			bookingID, tenantID(ctx),
+		).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if exists {
+			return fmt.Errorf("%s: booking is already cancelled: %w", op, response.ErrConflict)
+		}
 		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
 	}
 
 	return nil
 }
 
-func (s *Storage) RescheduleBooking(ctx context.Context, tx *sql.Tx, bookingID, newSlotID string) error {
+// RescheduleBooking переносит бронь из oldSlotID в newSlotID (вместе с преподавателем слота)
+// и возвращает освобождённый и занятый слоты. oldSlotID берётся из строки брони,
+// заблокированной GetBookingForUpdate; переносится только действующая бронь, которая
+// всё ещё в oldSlotID — иначе ErrConflict.
+// С requireConfirmation бронь возвращается в pending и ждёт подтверждения преподавателя.
+func (s *Storage) RescheduleBooking(ctx context.Context, tx *sql.Tx, bookingID, oldSlotID, newSlotID string, requireConfirmation bool) (*models.Slot, *models.Slot, error) {
 	const op = "storage.postgres.RescheduleBooking"
 
-	// Get old slot and new slot
-	var oldSlotID string
-	err := tx.QueryRowContext(ctx, `SELECT slot_id FROM bookings WHERE id = $1`, bookingID).Scan(&oldSlotID)
+	// Update booking slot
+	res, err := tx.ExecContext(ctx,
+		`UPDATE bookings SET slot_id = s.id, teacher_id = s.teacher_id, period = tstzrange(s.starts_at, s.ends_at),
+			requires_teacher_confirmation = bookings.requires_teacher_confirmation OR $4::boolean,
+			status = CASE WHEN $4::boolean THEN 'pending' ELSE bookings.status END
+		FROM slots s
+		WHERE s.id = $1 AND bookings.id = $2 AND s.tenant_id = $3 AND bookings.tenant_id = $3
+		  AND bookings.slot_id = $5 AND bookings.deleted_at IS NULL
+		  AND bookings.status IN ('pending', 'confirmed')`,
+		newSlotID, bookingID, tenantID(ctx), requireConfirmation, oldSlotID,
+	)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		if isConstraintViolation(err, pqExclusionViolation, constraintStudentNoOverlap) {
+			return nil, nil, fmt.Errorf("%s: %w", op, response.ErrBookingOverlap)
 		}
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
-
-	// Update booking slot
-	_, err = tx.ExecContext(ctx, `UPDATE bookings SET slot_id = $1 WHERE id = $2`, newSlotID, bookingID)
+	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return nil, nil, fmt.Errorf("%s: booking is no longer active: %w", op, response.ErrConflict)
 	}
 
-	// Update old slot to free
-	_, err = tx.ExecContext(ctx, `UPDATE slots SET status = 'free', booking_id = NULL WHERE id = $1`, oldSlotID)
+	// старый слот освобождается, только если его всё ещё занимает эта бронь
+	freed, err := scanSlot(tx.QueryRowContext(ctx,
+		`UPDATE slots SET status = CASE WHEN `+slotUnderBlock+` THEN 'blocked' ELSE 'free' END, booking_id = NULL
+		WHERE id = $1 AND booking_id = $3 AND tenant_id = $2
+		`+slotReturning,
+		oldSlotID, tenantID(ctx), bookingID,
+	))
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		if err == sql.ErrNoRows {
+			return nil, nil, fmt.Errorf("%s: slot %s is not held by booking %s: %w", op, oldSlotID, bookingID, response.ErrConflict)
+		}
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Update new slot to booked
-	_, err = tx.ExecContext(ctx, `UPDATE slots SET status = 'booked', booking_id = $1 WHERE id = $2`, bookingID, newSlotID)
+	booked, err := scanSlot(tx.QueryRowContext(ctx,
+		`UPDATE slots SET status = 'booked', booking_id = $1 WHERE id = $2 AND tenant_id = $3
+		`+slotReturning,
+		bookingID, newSlotID, tenantID(ctx),
+	))
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return freed, booked, nil
 }
 
-func (s *Storage) DeleteBooking(ctx context.Context, bookingID string) error {
-	const op = "storage.postgres.DeleteBooking"
+// GetDeletedBooking возвращает мягко удалённую бронь и блокирует её строку до конца транзакции
+func (s *Storage) GetDeletedBooking(ctx context.Context, tx *sql.Tx, id string) (*models.Booking, error) {
+	const op = "storage.postgres.GetDeletedBooking"
 
-	res, err := s.db.ExecContext(ctx, `DELETE FROM bookings WHERE id = $1`, bookingID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
+	var booking models.Booking
+	var status string
+	var deletedAt time.Time
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
+	err := s.conn(tx).QueryRowContext(ctx,
+		`SELECT id, slot_id, student_id, teacher_id, status, requires_teacher_confirmation, deleted_at
+		 FROM bookings WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL
+		 FOR UPDATE`,
+		id, tenantID(ctx),
+	).Scan(
+		&booking.ID,
+		&booking.SlotID,
+		&booking.StudentID,
+		&booking.TeacherID,
+		&status,
+		&booking.RequiresTeacherConfirmation,
+		&deletedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	booking.Status = models.BookingStatus(status)
+	booking.DeletedAt = &deletedAt
+
+	return &booking, nil
+}
+
+// RestoreBooking снимает отметку об удалении; занятие слота выполняет вызывающая сторона
+func (s *Storage) RestoreBooking(ctx context.Context, tx *sql.Tx, bookingID string) error {
+	const op = "storage.postgres.RestoreBooking"
+
+	res, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE bookings SET deleted_at = NULL WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`,
+		bookingID, tenantID(ctx),
+	)
+	if err != nil {
+		if isConstraintViolation(err, pqExclusionViolation, constraintStudentNoOverlap) {
+			return fmt.Errorf("%s: %w", op, response.ErrBookingOverlap)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -846,46 +1380,195 @@ func (s *Storage) DeleteBooking(ctx context.Context, bookingID string) error {
 	return nil
 }
 
-// Attendance
-
-func (s *Storage) CreateAttendance(ctx context.Context, attendance *models.Attendance) (string, error) {
-	const op = "storage.postgres.CreateAttendance"
+// FindOverlappingBooking возвращает ID активной брони студента, пересекающейся по времени со слотом.
+// excludeBookingID исключает саму переносимую бронь. Пустая строка — пересечений нет.
+func (s *Storage) FindOverlappingBooking(ctx context.Context, tx *sql.Tx, studentID, slotID string, excludeBookingID *string) (string, error) {
+	const op = "storage.postgres.FindOverlappingBooking"
 
 	var id string
-	err := s.db.QueryRowContext(ctx,
-		`INSERT INTO attendance (booking_id, status, notes)
-		VALUES ($1, $2, $3)
-		RETURNING id`,
-		attendance.BookingID,
-		string(attendance.Status),
-		attendance.Notes,
+	err := s.conn(tx).QueryRowContext(ctx,
+		`SELECT b.id
+		FROM bookings b
+		JOIN slots s ON s.id = $2 AND s.tenant_id = b.tenant_id
+		WHERE b.tenant_id = $4
+		  AND b.student_id = $1
+		  AND b.status <> 'cancelled'
+		  AND b.deleted_at IS NULL
+		  AND b.period && tstzrange(s.starts_at, s.ends_at)
+		  AND ($3::uuid IS NULL OR b.id <> $3::uuid)
+		ORDER BY lower(b.period)
+		LIMIT 1`,
+		studentID,
+		slotID,
+		excludeBookingID,
+		tenantID(ctx),
 	).Scan(&id)
 
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) GetAttendance(ctx context.Context, id string) (*models.Attendance, error) {
-	const op = "storage.postgres.GetAttendance"
+func (s *Storage) DeleteBooking(ctx context.Context, tx *sql.Tx, bookingID string) error {
+	const op = "storage.postgres.DeleteBooking"
 
-	var attendance models.Attendance
-	var status string
+	res, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE bookings SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		bookingID, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, booking_id, status, notes, created_at, updated_at
-		 FROM attendance WHERE id = $1`,
-		id,
-	).Scan(
-		&attendance.ID,
-		&attendance.BookingID,
-		&status,
-		&attendance.Notes,
-		&attendance.CreatedAt,
-		&attendance.UpdatedAt,
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Booking Events
+
+func (s *Storage) CreateBookingEvent(ctx context.Context, tx *sql.Tx, event *models.BookingEvent) error {
+	const op = "storage.postgres.CreateBookingEvent"
+
+	var fromStatus, toStatus *string
+	if event.FromStatus != nil {
+		v := string(*event.FromStatus)
+		fromStatus = &v
+	}
+	if event.ToStatus != nil {
+		v := string(*event.ToStatus)
+		toStatus = &v
+	}
+
+	_, err := s.conn(tx).ExecContext(ctx,
+		`INSERT INTO booking_events (booking_id, event_type, from_status, to_status, from_slot_id, to_slot_id, actor, request_id, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9)`,
+		event.BookingID,
+		string(event.Type),
+		fromStatus,
+		toStatus,
+		event.FromSlotID,
+		event.ToSlotID,
+		event.Actor,
+		event.RequestID,
+		tenantID(ctx),
+	)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListBookingEvents(ctx context.Context, bookingID string) ([]*models.BookingEvent, error) {
+	const op = "storage.postgres.ListBookingEvents"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT id, booking_id, event_type, from_status, to_status, from_slot_id, to_slot_id, actor, request_id, created_at
+		FROM booking_events
+		WHERE booking_id = $1 AND tenant_id = $2
+		ORDER BY created_at, id`,
+		bookingID, tenantID(ctx),
 	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var events []*models.BookingEvent
+	for rows.Next() {
+		var event models.BookingEvent
+		var eventType string
+		var fromStatus, toStatus, fromSlotID, toSlotID, requestID sql.NullString
+
+		err := rows.Scan(
+			&event.ID,
+			&event.BookingID,
+			&eventType,
+			&fromStatus,
+			&toStatus,
+			&fromSlotID,
+			&toSlotID,
+			&event.Actor,
+			&requestID,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		event.Type = models.BookingEventType(eventType)
+		if fromStatus.Valid {
+			st := models.BookingStatus(fromStatus.String)
+			event.FromStatus = &st
+		}
+		if toStatus.Valid {
+			st := models.BookingStatus(toStatus.String)
+			event.ToStatus = &st
+		}
+		if fromSlotID.Valid {
+			event.FromSlotID = &fromSlotID.String
+		}
+		if toSlotID.Valid {
+			event.ToSlotID = &toSlotID.String
+		}
+		event.RequestID = requestID.String
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// Booking Rules
+
+func (s *Storage) CreateBookingRules(ctx context.Context, rules *models.BookingRules) (string, error) {
+	const op = "storage.postgres.CreateBookingRules"
+
+	var id string
+	err := s.conn(nil).QueryRowContext(ctx,
+		`INSERT INTO booking_rules (teacher_id, template_id, min_notice_hours, max_advance_days, max_weekly_bookings, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`,
+		rules.TeacherID,
+		rules.TemplateID,
+		rules.MinNoticeHours,
+		rules.MaxAdvanceDays,
+		rules.MaxWeeklyBookings,
+		tenantID(ctx),
+	).Scan(&id)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return "", fmt.Errorf("%s: %w", op, response.ErrConflict)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) GetBookingRules(ctx context.Context, id string) (*models.BookingRules, error) {
+	const op = "storage.postgres.GetBookingRules"
+
+	rules, err := scanBookingRules(s.conn(nil).QueryRowContext(ctx,
+		`SELECT id, teacher_id, template_id, min_notice_hours, max_advance_days, max_weekly_bookings, created_at, updated_at
+		 FROM booking_rules WHERE id = $1 AND tenant_id = $2`,
+		id, tenantID(ctx),
+	))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -894,67 +1577,2113 @@ func (s *Storage) GetAttendance(ctx context.Context, id string) (*models.Attenda
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	attendance.Status = models.AttendanceStatus(status)
+	return rules, nil
+}
 
-	return &attendance, nil
+// GetEffectiveBookingRules возвращает правило шаблона слота, а при его отсутствии — правило преподавателя
+func (s *Storage) GetEffectiveBookingRules(ctx context.Context, tx *sql.Tx, teacherID string, templateID *string) (*models.BookingRules, error) {
+	const op = "storage.postgres.GetEffectiveBookingRules"
+
+	rules, err := scanBookingRules(s.conn(tx).QueryRowContext(ctx,
+		`SELECT id, teacher_id, template_id, min_notice_hours, max_advance_days, max_weekly_bookings, created_at, updated_at
+		 FROM booking_rules
+		 WHERE tenant_id = $3 AND teacher_id = $1 AND (template_id IS NULL OR template_id = $2::uuid)
+		 ORDER BY template_id NULLS LAST
+		 LIMIT 1`,
+		teacherID,
+		templateID,
+		tenantID(ctx),
+	))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rules, nil
 }
 
-func (s *Storage) ListAttendance(ctx context.Context, teacherID *string, from, to *time.Time) ([]*models.Attendance, error) {
-	const op = "storage.postgres.ListAttendance"
+func (s *Storage) ListBookingRules(ctx context.Context, teacherID *string) ([]*models.BookingRules, error) {
+	const op = "storage.postgres.ListBookingRules"
 
-	query := `SELECT a.id, a.booking_id, a.status, a.notes, a.created_at, a.updated_at
-			  FROM attendance a
-			  JOIN bookings b ON a.booking_id = b.id
-			  WHERE 1=1`
-	args := []interface{}{}
-	argPos := 1
+	query := `SELECT id, teacher_id, template_id, min_notice_hours, max_advance_days, max_weekly_bookings, created_at, updated_at
+			  FROM booking_rules WHERE tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
 
 	if teacherID != nil {
-		query += fmt.Sprintf(" AND b.teacher_id = $%d", argPos)
+		query += " AND teacher_id = $2"
 		args = append(args, *teacherID)
-		argPos++
-	}
-
-	if from != nil {
-		query += fmt.Sprintf(" AND a.created_at >= $%d", argPos)
-		args = append(args, *from)
-		argPos++
-	}
-
-	if to != nil {
-		query += fmt.Sprintf(" AND a.created_at <= $%d", argPos)
-		args = append(args, *to)
-		argPos++
 	}
 
-	query += " ORDER BY a.created_at DESC"
+	query += " ORDER BY teacher_id, template_id NULLS FIRST"
 
-	rows, err := s.db.QueryContext(ctx, query, args...)
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
-	var attendances []*models.Attendance
+	var result []*models.BookingRules
 	for rows.Next() {
-		var attendance models.Attendance
-		var status string
-
-		err := rows.Scan(
-			&attendance.ID,
-			&attendance.BookingID,
-			&status,
-			&attendance.Notes,
-			&attendance.CreatedAt,
-			&attendance.UpdatedAt,
-		)
+		rules, err := scanBookingRules(rows)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		result = append(result, rules)
+	}
 
-		attendance.Status = models.AttendanceStatus(status)
-		attendances = append(attendances, &attendance)
+	return result, nil
+}
+
+func (s *Storage) UpdateBookingRules(ctx context.Context, rules *models.BookingRules) error {
+	const op = "storage.postgres.UpdateBookingRules"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE booking_rules
+		SET teacher_id = $1, template_id = $2, min_notice_hours = $3, max_advance_days = $4, max_weekly_bookings = $5
+		WHERE id = $6 AND tenant_id = $7`,
+		rules.TeacherID,
+		rules.TemplateID,
+		rules.MinNoticeHours,
+		rules.MaxAdvanceDays,
+		rules.MaxWeeklyBookings,
+		rules.ID,
+		tenantID(ctx),
+	)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, response.ErrConflict)
+		}
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	return attendances, nil
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteBookingRules(ctx context.Context, id string) error {
+	const op = "storage.postgres.DeleteBookingRules"
+
+	res, err := s.conn(nil).ExecContext(ctx, `DELETE FROM booking_rules WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+// CountStudentBookings считает активные брони студента у преподавателя со стартом в [from, to),
+// кроме excludeBookingID
+func (s *Storage) CountStudentBookings(ctx context.Context, tx *sql.Tx, studentID, teacherID string, from, to time.Time, excludeBookingID *string) (int, error) {
+	const op = "storage.postgres.CountStudentBookings"
+
+	var count int
+	err := s.conn(tx).QueryRowContext(ctx,
+		`SELECT COUNT(*)
+		FROM bookings
+		WHERE tenant_id = $5 AND student_id = $1 AND teacher_id = $2
+		  AND status <> 'cancelled'
+		  AND deleted_at IS NULL
+		  AND lower(period) >= $3 AND lower(period) < $4
+		  AND ($6::uuid IS NULL OR id <> $6::uuid)`,
+		studentID,
+		teacherID,
+		from,
+		to,
+		tenantID(ctx),
+		excludeBookingID,
+	).Scan(&count)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// LockStudentBookings до конца транзакции сериализует брони студента: без блокировки
+// две параллельные брони посчитают квоту до вставки друг друга и обе её пройдут.
+// Строк студента может ещё не быть, поэтому берётся advisory-блокировка, а не FOR UPDATE.
+func (s *Storage) LockStudentBookings(ctx context.Context, tx *sql.Tx, studentID string) error {
+	const op = "storage.postgres.LockStudentBookings"
+
+	_, err := tx.ExecContext(ctx,
+		`SELECT pg_advisory_xact_lock(hashtextextended('bookings:' || $1 || ':' || $2, 0))`,
+		tenantID(ctx),
+		studentID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSlot(row rowScanner) (*models.Slot, error) {
+	var slot models.Slot
+	var status string
+	var bookingID, templateID sql.NullString
+
+	err := row.Scan(
+		&slot.ID,
+		&slot.TeacherID,
+		&slot.Start,
+		&slot.End,
+		&status,
+		&bookingID,
+		&templateID,
+		&slot.CreatedAt,
+		&slot.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slot.Status = models.SlotStatus(status)
+	if bookingID.Valid {
+		slot.BookingID = &bookingID.String
+	}
+	if templateID.Valid {
+		slot.TemplateID = &templateID.String
+	}
+
+	return &slot, nil
+}
+
+func scanBookingRules(row rowScanner) (*models.BookingRules, error) {
+	var rules models.BookingRules
+	var templateID sql.NullString
+	var minNotice, maxAdvance, maxWeekly sql.NullInt64
+
+	err := row.Scan(
+		&rules.ID,
+		&rules.TeacherID,
+		&templateID,
+		&minNotice,
+		&maxAdvance,
+		&maxWeekly,
+		&rules.CreatedAt,
+		&rules.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateID.Valid {
+		rules.TemplateID = &templateID.String
+	}
+	rules.MinNoticeHours = nullIntPtr(minNotice)
+	rules.MaxAdvanceDays = nullIntPtr(maxAdvance)
+	rules.MaxWeeklyBookings = nullIntPtr(maxWeekly)
+
+	return &rules, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
+
+// EnsureSlotCheckInSecret сохраняет secret, если у слота ещё нет секрета самоотметки,
+// и возвращает действующий секрет
+func (s *Storage) EnsureSlotCheckInSecret(ctx context.Context, slotID, secret string) (string, error) {
+	const op = "storage.postgres.EnsureSlotCheckInSecret"
+
+	var current string
+	err := s.conn(nil).QueryRowContext(ctx,
+		`UPDATE slots SET checkin_secret = COALESCE(checkin_secret, $2)
+		WHERE id = $1 AND tenant_id = $3
+		RETURNING checkin_secret`,
+		slotID, secret, tenantID(ctx),
+	).Scan(&current)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return current, nil
+}
+
+// Student Restrictions
+
+const studentRestrictionColumns = `id, student_id, mode, absences, late_cancellations, starts_at, ends_at, lifted_at, lifted_by, created_at`
+
+func scanStudentRestriction(row rowScanner) (*models.StudentRestriction, error) {
+	var r models.StudentRestriction
+	var mode string
+	var liftedAt sql.NullTime
+	var liftedBy sql.NullString
+
+	err := row.Scan(
+		&r.ID,
+		&r.StudentID,
+		&mode,
+		&r.Absences,
+		&r.LateCancellations,
+		&r.StartsAt,
+		&r.EndsAt,
+		&liftedAt,
+		&liftedBy,
+		&r.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Mode = models.RestrictionMode(mode)
+	if liftedAt.Valid {
+		r.LiftedAt = &liftedAt.Time
+	}
+	if liftedBy.Valid {
+		r.LiftedBy = &liftedBy.String
+	}
+
+	return &r, nil
+}
+
+func (s *Storage) CreateStudentRestriction(ctx context.Context, tx *sql.Tx, r *models.StudentRestriction) (string, error) {
+	const op = "storage.postgres.CreateStudentRestriction"
+
+	var id string
+	err := s.conn(tx).QueryRowContext(ctx,
+		`INSERT INTO student_restrictions (student_id, mode, absences, late_cancellations, starts_at, ends_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		r.StudentID,
+		string(r.Mode),
+		r.Absences,
+		r.LateCancellations,
+		r.StartsAt,
+		r.EndsAt,
+		tenantID(ctx),
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) GetStudentRestriction(ctx context.Context, id string) (*models.StudentRestriction, error) {
+	const op = "storage.postgres.GetStudentRestriction"
+
+	r, err := scanStudentRestriction(s.conn(nil).QueryRowContext(ctx,
+		`SELECT `+studentRestrictionColumns+` FROM student_restrictions WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r, nil
+}
+
+// GetLatestStudentRestriction возвращает последнее наложенное на студента ограничение
+func (s *Storage) GetLatestStudentRestriction(ctx context.Context, tx *sql.Tx, studentID string) (*models.StudentRestriction, error) {
+	const op = "storage.postgres.GetLatestStudentRestriction"
+
+	r, err := scanStudentRestriction(s.conn(tx).QueryRowContext(ctx,
+		`SELECT `+studentRestrictionColumns+` FROM student_restrictions
+		WHERE student_id = $1 AND tenant_id = $2
+		ORDER BY starts_at DESC
+		LIMIT 1`, studentID, tenantID(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r, nil
+}
+
+func (s *Storage) ListStudentRestrictions(ctx context.Context, studentID *string, activeAt *time.Time) ([]*models.StudentRestriction, error) {
+	const op = "storage.postgres.ListStudentRestrictions"
+
+	query := `SELECT ` + studentRestrictionColumns + ` FROM student_restrictions WHERE tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
+	argPos := 2
+
+	if studentID != nil {
+		query += fmt.Sprintf(" AND student_id = $%d", argPos)
+		args = append(args, *studentID)
+		argPos++
+	}
+
+	if activeAt != nil {
+		query += fmt.Sprintf(" AND lifted_at IS NULL AND ends_at > $%d", argPos)
+		args = append(args, *activeAt)
+		argPos++
+	}
+
+	query += " ORDER BY starts_at DESC"
+
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.StudentRestriction
+	for rows.Next() {
+		r, err := scanStudentRestriction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) LiftStudentRestriction(ctx context.Context, id, liftedBy string) error {
+	const op = "storage.postgres.LiftStudentRestriction"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE student_restrictions SET lifted_at = CURRENT_TIMESTAMP, lifted_by = $2
+		WHERE id = $1 AND tenant_id = $3 AND lifted_at IS NULL`,
+		id, liftedBy, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+// CountStudentOffenses считает неявки (по началу слота) и поздние отмены начиная с since.
+// Поздняя отмена — отмена меньше чем за lateCancelNotice до начала урока, сделанная самим студентом
+// (не преподавателем, админом или API-ключом); каждая бронь считается один раз.
+func (s *Storage) CountStudentOffenses(ctx context.Context, tx *sql.Tx, studentID string, since, until time.Time, lateCancelNotice time.Duration) (int, int, error) {
+	const op = "storage.postgres.CountStudentOffenses"
+
+	var absences, lateCancellations int
+	err := s.conn(tx).QueryRowContext(ctx,
+		`SELECT
+			(SELECT COUNT(*)
+			 FROM attendance a
+			 JOIN bookings b ON b.id = a.booking_id
+			 JOIN slots s ON s.id = b.slot_id
+			 WHERE b.tenant_id = $5
+			   AND b.student_id = $1
+			   AND b.deleted_at IS NULL
+			   AND a.status = 'absent'
+			   AND NOT a.excused
+			   AND s.starts_at >= $2 AND s.starts_at < $3),
+			(SELECT COUNT(DISTINCT e.booking_id)
+			 FROM booking_events e
+			 JOIN bookings b ON b.id = e.booking_id
+			 JOIN slots s ON s.id = COALESCE(e.from_slot_id, b.slot_id)
+			 WHERE b.tenant_id = $5
+			   AND b.student_id = $1
+			   AND b.deleted_at IS NULL
+			   AND e.event_type = 'cancelled'
+			   AND e.actor = b.student_id
+			   AND e.created_at >= $2 AND e.created_at < $3
+			   AND e.created_at > s.starts_at - make_interval(secs => $4))`,
+		studentID, since, until, lateCancelNotice.Seconds(), tenantID(ctx),
+	).Scan(&absences, &lateCancellations)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return absences, lateCancellations, nil
+}
+
+// Makeup Credits
+
+const makeupCreditColumns = `id, student_id, teacher_id, source_booking_id, reason, expires_at, redeemed_booking_id, redeemed_at, created_at`
+
+func scanMakeupCredit(row rowScanner) (*models.MakeupCredit, error) {
+	var c models.MakeupCredit
+	var reason string
+	var redeemedBookingID sql.NullString
+	var redeemedAt sql.NullTime
+
+	err := row.Scan(
+		&c.ID,
+		&c.StudentID,
+		&c.TeacherID,
+		&c.SourceBookingID,
+		&reason,
+		&c.ExpiresAt,
+		&redeemedBookingID,
+		&redeemedAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Reason = models.MakeupCreditReason(reason)
+	if redeemedBookingID.Valid {
+		c.RedeemedBookingID = &redeemedBookingID.String
+	}
+	if redeemedAt.Valid {
+		c.RedeemedAt = &redeemedAt.Time
+	}
+
+	return &c, nil
+}
+
+// IssueMakeupCredit выдаёт кредит за бронь; повторная выдача за ту же бронь ничего не делает
+func (s *Storage) IssueMakeupCredit(ctx context.Context, tx *sql.Tx, credit *models.MakeupCredit) error {
+	const op = "storage.postgres.IssueMakeupCredit"
+
+	_, err := s.conn(tx).ExecContext(ctx,
+		`INSERT INTO makeup_credits (student_id, teacher_id, source_booking_id, reason, expires_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (source_booking_id) DO NOTHING`,
+		credit.StudentID,
+		credit.TeacherID,
+		credit.SourceBookingID,
+		string(credit.Reason),
+		credit.ExpiresAt,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeMakeupCredit удаляет ещё не погашенный кредит, выданный за бронь по указанной причине
+func (s *Storage) RevokeMakeupCredit(ctx context.Context, tx *sql.Tx, sourceBookingID string, reason models.MakeupCreditReason) error {
+	const op = "storage.postgres.RevokeMakeupCredit"
+
+	_, err := s.conn(tx).ExecContext(ctx,
+		`DELETE FROM makeup_credits
+		WHERE source_booking_id = $1 AND reason = $2 AND tenant_id = $3 AND redeemed_at IS NULL`,
+		sourceBookingID, string(reason), tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RedeemMakeupCredit гасит открытый кредит студента у преподавателя новой бронью
+func (s *Storage) RedeemMakeupCredit(ctx context.Context, tx *sql.Tx, creditID, studentID, teacherID, bookingID string, now time.Time) error {
+	const op = "storage.postgres.RedeemMakeupCredit"
+
+	res, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE makeup_credits SET redeemed_booking_id = $4, redeemed_at = $5
+		WHERE id = $1 AND student_id = $2 AND teacher_id = $3 AND tenant_id = $6
+		  AND redeemed_at IS NULL AND expires_at > $5`,
+		creditID, studentID, teacherID, bookingID, now, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrCreditNotAvailable)
+	}
+
+	return nil
+}
+
+// ListMakeupCredits возвращает кредиты студента; при openAt — только непогашенные и не истёкшие к этому моменту
+func (s *Storage) ListMakeupCredits(ctx context.Context, studentID string, teacherID *string, openAt *time.Time) ([]*models.MakeupCredit, error) {
+	const op = "storage.postgres.ListMakeupCredits"
+
+	query := `SELECT ` + makeupCreditColumns + ` FROM makeup_credits WHERE student_id = $1 AND tenant_id = $2`
+	args := []interface{}{studentID, tenantID(ctx)}
+	argPos := 3
+
+	if teacherID != nil {
+		query += fmt.Sprintf(" AND teacher_id = $%d", argPos)
+		args = append(args, *teacherID)
+		argPos++
+	}
+
+	if openAt != nil {
+		query += fmt.Sprintf(" AND redeemed_at IS NULL AND expires_at > $%d", argPos)
+		args = append(args, *openAt)
+		argPos++
+	}
+
+	query += " ORDER BY expires_at"
+
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.MakeupCredit
+	for rows.Next() {
+		c, err := scanMakeupCredit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// Calendar Feeds
+
+// SetCalendarToken сохраняет хеш токена ленты владельца, заменяя прежний
+func (s *Storage) SetCalendarToken(ctx context.Context, ownerType models.CalendarOwner, ownerID, tokenHash string) error {
+	const op = "storage.postgres.SetCalendarToken"
+
+	_, err := s.conn(nil).ExecContext(ctx,
+		`INSERT INTO calendar_tokens (owner_type, owner_id, token_hash, tenant_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id, owner_type, owner_id) DO UPDATE
+		SET token_hash = EXCLUDED.token_hash, created_at = CURRENT_TIMESTAMP`,
+		string(ownerType), ownerID, tokenHash, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetCalendarTokenHash(ctx context.Context, ownerType models.CalendarOwner, ownerID string) (string, error) {
+	const op = "storage.postgres.GetCalendarTokenHash"
+
+	var tokenHash string
+	err := s.conn(nil).QueryRowContext(ctx,
+		`SELECT token_hash FROM calendar_tokens WHERE owner_type = $1 AND owner_id = $2 AND tenant_id = $3`,
+		string(ownerType), ownerID, tenantID(ctx),
+	).Scan(&tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokenHash, nil
+}
+
+// ListCalendarBookings возвращает брони студента или преподавателя, чьи слоты
+// пересекают [from, to), вместе с отменёнными — лента должна сообщить об отмене
+func (s *Storage) ListCalendarBookings(ctx context.Context, studentID, teacherID *string, from, to time.Time) ([]*models.CalendarBooking, error) {
+	const op = "storage.postgres.ListCalendarBookings"
+
+	query := `SELECT b.id, b.slot_id, b.student_id, b.teacher_id, b.status, b.requires_teacher_confirmation,
+			s.starts_at, s.ends_at,
+			(SELECT COUNT(*) FROM booking_events e
+			 WHERE e.booking_id = b.id AND e.event_type IN ('rescheduled', 'cancelled', 'restored')),
+			b.updated_at
+		FROM bookings b
+		JOIN slots s ON s.id = b.slot_id
+		WHERE b.tenant_id = $3 AND b.deleted_at IS NULL AND s.ends_at > $1 AND s.starts_at < $2`
+	args := []interface{}{from, to, tenantID(ctx)}
+	argPos := 4
+
+	if studentID != nil {
+		query += fmt.Sprintf(" AND b.student_id = $%d", argPos)
+		args = append(args, *studentID)
+		argPos++
+	}
+
+	if teacherID != nil {
+		query += fmt.Sprintf(" AND b.teacher_id = $%d", argPos)
+		args = append(args, *teacherID)
+		argPos++
+	}
+
+	query += " ORDER BY s.starts_at"
+
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var bookings []*models.CalendarBooking
+	for rows.Next() {
+		var booking models.CalendarBooking
+		var status string
+
+		err := rows.Scan(
+			&booking.ID,
+			&booking.SlotID,
+			&booking.StudentID,
+			&booking.TeacherID,
+			&status,
+			&booking.RequiresTeacherConfirmation,
+			&booking.StartsAt,
+			&booking.EndsAt,
+			&booking.Sequence,
+			&booking.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		booking.Status = models.BookingStatus(status)
+		bookings = append(bookings, &booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bookings, nil
+}
+
+// Attendance
+
+func (s *Storage) CreateAttendance(ctx context.Context, tx *sql.Tx, attendance *models.Attendance) (string, error) {
+	const op = "storage.postgres.CreateAttendance"
+
+	var id string
+	err := s.conn(tx).QueryRowContext(ctx,
+		`INSERT INTO attendance (booking_id, status, notes, auto_marked, checked_in_at, excused, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		attendance.BookingID,
+		string(attendance.Status),
+		attendance.Notes,
+		attendance.AutoMarked,
+		attendance.CheckedInAt,
+		attendance.Excused,
+		tenantID(ctx),
+	).Scan(&id)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return "", fmt.Errorf("%s: %w", op, response.ErrConflict)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// UpsertAttendance создаёт или перезаписывает отметку по booking_id.
+// created = true, если запись была вставлена, а не обновлена.
+func (s *Storage) UpsertAttendance(ctx context.Context, tx *sql.Tx, attendance *models.Attendance) (string, bool, error) {
+	const op = "storage.postgres.UpsertAttendance"
+
+	var id string
+	var created bool
+	err := s.conn(tx).QueryRowContext(ctx,
+		`INSERT INTO attendance (booking_id, status, notes, auto_marked, excused, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (booking_id) DO UPDATE
+		SET status = EXCLUDED.status, notes = EXCLUDED.notes, auto_marked = EXCLUDED.auto_marked, excused = EXCLUDED.excused
+		RETURNING id, (xmax = 0)`,
+		attendance.BookingID,
+		string(attendance.Status),
+		attendance.Notes,
+		attendance.AutoMarked,
+		attendance.Excused,
+		tenantID(ctx),
+	).Scan(&id, &created)
+
+	if err != nil {
+		return "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, created, nil
+}
+
+// MarkRemainingPresent отмечает присутствующими всех, у кого есть подтверждённая бронь
+// к преподавателю на уроки, начавшиеся в [from, to) и не позже now, и ещё нет отметки.
+// Брони из excludeBookingIDs пропускаются.
+func (s *Storage) MarkRemainingPresent(ctx context.Context, tx *sql.Tx, teacherID string, from, to, now time.Time, excludeBookingIDs []string) ([]*models.Attendance, error) {
+	const op = "storage.postgres.MarkRemainingPresent"
+
+	if excludeBookingIDs == nil {
+		excludeBookingIDs = []string{}
+	}
+
+	rows, err := s.conn(tx).QueryContext(ctx,
+		`INSERT INTO attendance (booking_id, status, notes, tenant_id)
+		SELECT b.id, 'present', '', b.tenant_id
+		FROM bookings b
+		JOIN slots s ON s.id = b.slot_id
+		WHERE b.tenant_id = $6
+		  AND b.teacher_id = $1
+		  AND b.status = 'confirmed'
+		  AND b.deleted_at IS NULL
+		  AND s.starts_at >= $2 AND s.starts_at < $3
+		  AND s.starts_at <= $4
+		  AND NOT (b.id::text = ANY($5))
+		  AND NOT EXISTS (SELECT 1 FROM attendance a WHERE a.booking_id = b.id)
+		ON CONFLICT (booking_id) DO NOTHING
+		RETURNING id, booking_id, status, notes, auto_marked, checked_in_at, excused, created_at, updated_at`,
+		teacherID, from, to, now, pq.Array(excludeBookingIDs), tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var attendances []*models.Attendance
+	for rows.Next() {
+		var attendance models.Attendance
+		var status string
+
+		err := rows.Scan(
+			&attendance.ID,
+			&attendance.BookingID,
+			&status,
+			&attendance.Notes,
+			&attendance.AutoMarked,
+			&attendance.CheckedInAt,
+			&attendance.Excused,
+			&attendance.CreatedAt,
+			&attendance.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		attendance.Status = models.AttendanceStatus(status)
+		attendances = append(attendances, &attendance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attendances, nil
+}
+
+// ListUnmarkedEndedBookings возвращает подтверждённые брони без отметки посещаемости,
+// чей слот закончился не позже endedBefore. Самые старые — первыми.
+func (s *Storage) ListUnmarkedEndedBookings(ctx context.Context, endedBefore time.Time, limit int) ([]*models.Booking, error) {
+	const op = "storage.postgres.ListUnmarkedEndedBookings"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT b.id, b.slot_id, b.student_id, b.teacher_id, b.status
+		FROM bookings b
+		JOIN slots s ON s.id = b.slot_id
+		WHERE b.tenant_id = $3
+		  AND b.status = 'confirmed'
+		  AND b.deleted_at IS NULL
+		  AND s.ends_at <= $1
+		  AND NOT EXISTS (SELECT 1 FROM attendance a WHERE a.booking_id = b.id)
+		ORDER BY s.ends_at
+		LIMIT $2`,
+		endedBefore, limit, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var bookings []*models.Booking
+	for rows.Next() {
+		var booking models.Booking
+		var status string
+
+		if err := rows.Scan(&booking.ID, &booking.SlotID, &booking.StudentID, &booking.TeacherID, &status); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		booking.Status = models.BookingStatus(status)
+		bookings = append(bookings, &booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bookings, nil
+}
+
+func (s *Storage) GetAttendanceByBooking(ctx context.Context, bookingID string) (*models.Attendance, error) {
+	const op = "storage.postgres.GetAttendanceByBooking"
+
+	var attendance models.Attendance
+	var status string
+
+	err := s.conn(nil).QueryRowContext(ctx,
+		`SELECT id, booking_id, status, notes, auto_marked, checked_in_at, excused, created_at, updated_at
+		 FROM attendance WHERE booking_id = $1 AND tenant_id = $2`,
+		bookingID, tenantID(ctx),
+	).Scan(
+		&attendance.ID,
+		&attendance.BookingID,
+		&status,
+		&attendance.Notes,
+		&attendance.AutoMarked,
+		&attendance.CheckedInAt,
+		&attendance.Excused,
+		&attendance.CreatedAt,
+		&attendance.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attendance.Status = models.AttendanceStatus(status)
+
+	return &attendance, nil
+}
+
+func (s *Storage) UpdateAttendance(ctx context.Context, tx *sql.Tx, attendance *models.Attendance) error {
+	const op = "storage.postgres.UpdateAttendance"
+
+	res, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE attendance SET status = $1, notes = $2, auto_marked = $3, excused = $4 WHERE id = $5 AND tenant_id = $6`,
+		string(attendance.Status),
+		attendance.Notes,
+		attendance.AutoMarked,
+		attendance.Excused,
+		attendance.ID,
+		tenantID(ctx),
+	)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteAttendance(ctx context.Context, tx *sql.Tx, id string) error {
+	const op = "storage.postgres.DeleteAttendance"
+
+	res, err := s.conn(tx).ExecContext(ctx, `DELETE FROM attendance WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetAttendance(ctx context.Context, tx *sql.Tx, id string) (*models.Attendance, error) {
+	const op = "storage.postgres.GetAttendance"
+
+	var attendance models.Attendance
+	var status string
+
+	err := s.conn(tx).QueryRowContext(ctx,
+		`SELECT id, booking_id, status, notes, auto_marked, checked_in_at, excused, created_at, updated_at
+		 FROM attendance WHERE id = $1 AND tenant_id = $2`,
+		id, tenantID(ctx),
+	).Scan(
+		&attendance.ID,
+		&attendance.BookingID,
+		&status,
+		&attendance.Notes,
+		&attendance.AutoMarked,
+		&attendance.CheckedInAt,
+		&attendance.Excused,
+		&attendance.CreatedAt,
+		&attendance.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attendance.Status = models.AttendanceStatus(status)
+
+	return &attendance, nil
+}
+
+// ключи группировки отчёта; недели начинаются с понедельника, время слота в UTC
+var attendanceReportKeys = map[models.AttendanceReportGroup]string{
+	models.ReportGroupStudent: "b.student_id",
+	models.ReportGroupTeacher: "b.teacher_id",
+	models.ReportGroupWeek:    "to_char(date_trunc('week', s.starts_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD')",
+	models.ReportGroupMonth:   "to_char(date_trunc('month', s.starts_at AT TIME ZONE 'UTC'), 'YYYY-MM')",
+}
+
+// AttendanceReport считает present/absent/late по группам за период по времени начала слота
+func (s *Storage) AttendanceReport(ctx context.Context, filter models.AttendanceReportFilter) ([]*models.AttendanceStats, error) {
+	const op = "storage.postgres.AttendanceReport"
+
+	key, ok := attendanceReportKeys[filter.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown group %q: %w", op, filter.GroupBy, response.ErrBadRequest)
+	}
+
+	query := `SELECT ` + key + ` AS key,
+				COUNT(*) FILTER (WHERE a.status = 'present'),
+				COUNT(*) FILTER (WHERE a.status = 'absent'),
+				COUNT(*) FILTER (WHERE a.status = 'late')
+			  FROM attendance a
+			  JOIN bookings b ON b.id = a.booking_id
+			  JOIN slots s ON s.id = b.slot_id
+			  WHERE b.tenant_id = $3 AND b.deleted_at IS NULL
+			    AND s.starts_at >= $1 AND s.starts_at < $2`
+	args := []interface{}{filter.From, filter.To, tenantID(ctx)}
+	argPos := 4
+
+	if filter.TeacherID != nil {
+		query += fmt.Sprintf(" AND b.teacher_id = $%d", argPos)
+		args = append(args, *filter.TeacherID)
+		argPos++
+	}
+
+	if filter.StudentID != nil {
+		query += fmt.Sprintf(" AND b.student_id = $%d", argPos)
+		args = append(args, *filter.StudentID)
+		argPos++
+	}
+
+	query += " GROUP BY 1 ORDER BY 1"
+
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var stats []*models.AttendanceStats
+	for rows.Next() {
+		var st models.AttendanceStats
+		if err := rows.Scan(&st.Key, &st.Present, &st.Absent, &st.Late); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		stats = append(stats, &st)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) ListAttendance(ctx context.Context, teacherID *string, from, to *time.Time) ([]*models.Attendance, error) {
+	const op = "storage.postgres.ListAttendance"
+
+	query := `SELECT a.id, a.booking_id, a.status, a.notes, a.auto_marked, a.checked_in_at, a.excused, a.created_at, a.updated_at
+			  FROM attendance a
+			  JOIN bookings b ON a.booking_id = b.id
+			  WHERE a.tenant_id = $1`
+	args := []interface{}{tenantID(ctx)}
+	argPos := 2
+
+	if teacherID != nil {
+		query += fmt.Sprintf(" AND b.teacher_id = $%d", argPos)
+		args = append(args, *teacherID)
+		argPos++
+	}
+
+	if from != nil {
+		query += fmt.Sprintf(" AND a.created_at >= $%d", argPos)
+		args = append(args, *from)
+		argPos++
+	}
+
+	if to != nil {
+		query += fmt.Sprintf(" AND a.created_at <= $%d", argPos)
+		args = append(args, *to)
+		argPos++
+	}
+
+	query += " ORDER BY a.created_at DESC"
+
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var attendances []*models.Attendance
+	for rows.Next() {
+		var attendance models.Attendance
+		var status string
+
+		err := rows.Scan(
+			&attendance.ID,
+			&attendance.BookingID,
+			&status,
+			&attendance.Notes,
+			&attendance.AutoMarked,
+			&attendance.CheckedInAt,
+			&attendance.Excused,
+			&attendance.CreatedAt,
+			&attendance.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		attendance.Status = models.AttendanceStatus(status)
+		attendances = append(attendances, &attendance)
+	}
+
+	return attendances, nil
+}
+
+// Webhooks
+
+const webhookSubscriptionColumns = `id, url, secret, event_types, enabled, created_at, updated_at`
+
+func scanWebhookSubscription(row rowScanner) (*models.WebhookSubscription, error) {
+	var sub models.WebhookSubscription
+	var eventTypes []string
+
+	err := row.Scan(
+		&sub.ID,
+		&sub.URL,
+		&sub.Secret,
+		pq.Array(&eventTypes),
+		&sub.Enabled,
+		&sub.CreatedAt,
+		&sub.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.EventTypes = make([]models.EventType, len(eventTypes))
+	for i, t := range eventTypes {
+		sub.EventTypes[i] = models.EventType(t)
+	}
+
+	return &sub, nil
+}
+
+func eventTypeStrings(types []models.EventType) []string {
+	result := make([]string, len(types))
+	for i, t := range types {
+		result[i] = string(t)
+	}
+	return result
+}
+
+func (s *Storage) CreateWebhookSubscription(ctx context.Context, sub *models.WebhookSubscription) (string, error) {
+	const op = "storage.postgres.CreateWebhookSubscription"
+
+	var id string
+	err := s.conn(nil).QueryRowContext(ctx,
+		`INSERT INTO webhook_subscriptions (url, secret, event_types, enabled, tenant_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		sub.URL,
+		sub.Secret,
+		pq.Array(eventTypeStrings(sub.EventTypes)),
+		sub.Enabled,
+		tenantID(ctx),
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) GetWebhookSubscription(ctx context.Context, id string) (*models.WebhookSubscription, error) {
+	const op = "storage.postgres.GetWebhookSubscription"
+
+	sub, err := scanWebhookSubscription(s.conn(nil).QueryRowContext(ctx,
+		`SELECT `+webhookSubscriptionColumns+` FROM webhook_subscriptions WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sub, nil
+}
+
+func (s *Storage) ListWebhookSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	const op = "storage.postgres.ListWebhookSubscriptions"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT `+webhookSubscriptionColumns+` FROM webhook_subscriptions WHERE tenant_id = $1 ORDER BY created_at`,
+		tenantID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.WebhookSubscription
+	for rows.Next() {
+		sub, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) UpdateWebhookSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	const op = "storage.postgres.UpdateWebhookSubscription"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE webhook_subscriptions
+		SET url = $2, secret = $3, event_types = $4, enabled = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND tenant_id = $6`,
+		sub.ID,
+		sub.URL,
+		sub.Secret,
+		pq.Array(eventTypeStrings(sub.EventTypes)),
+		sub.Enabled,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	const op = "storage.postgres.DeleteWebhookSubscription"
+
+	res, err := s.conn(nil).ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+// EnqueueWebhookDeliveries ставит событие в очередь доставки всем включённым подпискам на его тип.
+// Возвращает число созданных доставок.
+func (s *Storage) EnqueueWebhookDeliveries(ctx context.Context, tx *sql.Tx, eventID string, eventType models.EventType, payload []byte) (int, error) {
+	const op = "storage.postgres.EnqueueWebhookDeliveries"
+
+	// payload передаётся строкой: []byte lib/pq отправил бы как bytea
+	res, err := s.conn(tx).ExecContext(ctx,
+		`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, tenant_id)
+		SELECT id, $1, $2, $3::jsonb, tenant_id
+		FROM webhook_subscriptions
+		WHERE tenant_id = $4 AND enabled AND $2 = ANY(event_types)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING`,
+		eventID, string(eventType), string(payload), tenantID(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+const webhookDeliveryColumns = `d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts,
+	d.next_attempt_at, d.last_error, d.last_status_code, d.delivered_at, d.created_at, d.updated_at`
+
+func scanWebhookDelivery(row rowScanner, extra ...any) (*models.WebhookDelivery, error) {
+	var d models.WebhookDelivery
+	var eventType, status string
+	var lastError sql.NullString
+	var lastStatusCode sql.NullInt64
+	var deliveredAt sql.NullTime
+
+	dest := []any{
+		&d.ID,
+		&d.SubscriptionID,
+		&d.EventID,
+		&eventType,
+		&d.Payload,
+		&status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&lastError,
+		&lastStatusCode,
+		&deliveredAt,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	d.EventType = models.EventType(eventType)
+	d.Status = models.WebhookDeliveryStatus(status)
+	if lastError.Valid {
+		d.LastError = &lastError.String
+	}
+	d.LastStatusCode = nullIntPtr(lastStatusCode)
+	if deliveredAt.Valid {
+		d.DeliveredAt = &deliveredAt.Time
+	}
+
+	return &d, nil
+}
+
+// ClaimWebhookDeliveries берёт в работу до limit доставок, срок которых наступил к now.
+// Взятые доставки откладываются до leaseUntil, поэтому параллельный обработчик их не увидит,
+// а при падении процесса они вернутся в очередь после истечения аренды.
+func (s *Storage) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.WebhookDispatch, error) {
+	const op = "storage.postgres.ClaimWebhookDeliveries"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`UPDATE webhook_deliveries d
+		SET next_attempt_at = $2, updated_at = CURRENT_TIMESTAMP
+		FROM webhook_subscriptions s
+		WHERE s.id = d.subscription_id
+		  AND d.id IN (
+			SELECT pd.id FROM webhook_deliveries pd
+			JOIN webhook_subscriptions ps ON ps.id = pd.subscription_id
+			WHERE pd.tenant_id = $4 AND pd.status = 'pending' AND pd.next_attempt_at <= $1 AND ps.enabled
+			ORDER BY pd.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF pd SKIP LOCKED
+		  )
+		RETURNING `+webhookDeliveryColumns+`, s.url, s.secret`,
+		now, leaseUntil, limit, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.WebhookDispatch
+	for rows.Next() {
+		var dispatch models.WebhookDispatch
+		d, err := scanWebhookDelivery(rows, &dispatch.URL, &dispatch.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		dispatch.WebhookDelivery = *d
+		result = append(result, &dispatch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// SaveWebhookAttempt сохраняет результат попытки доставки: статус, счётчик попыток,
+// время следующей попытки и последнюю ошибку
+func (s *Storage) SaveWebhookAttempt(ctx context.Context, d *models.WebhookDelivery) error {
+	const op = "storage.postgres.SaveWebhookAttempt"
+
+	_, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5,
+		    last_status_code = $6, delivered_at = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND tenant_id = $8`,
+		d.ID,
+		string(d.Status),
+		d.Attempts,
+		d.NextAttemptAt,
+		d.LastError,
+		d.LastStatusCode,
+		d.DeliveredAt,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetWebhookDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	const op = "storage.postgres.GetWebhookDelivery"
+
+	d, err := scanWebhookDelivery(s.conn(nil).QueryRowContext(ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries d WHERE d.id = $1 AND d.tenant_id = $2`, id, tenantID(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return d, nil
+}
+
+// ListWebhookDeliveries возвращает последние доставки подписки, новые первыми
+func (s *Storage) ListWebhookDeliveries(ctx context.Context, subscriptionID string, status *string, limit int) ([]*models.WebhookDelivery, error) {
+	const op = "storage.postgres.ListWebhookDeliveries"
+
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries d WHERE d.subscription_id = $1 AND d.tenant_id = $2`
+	args := []interface{}{subscriptionID, tenantID(ctx)}
+	argPos := 3
+
+	if status != nil {
+		query += fmt.Sprintf(" AND d.status = $%d", argPos)
+		args = append(args, *status)
+		argPos++
+	}
+
+	query += fmt.Sprintf(" ORDER BY d.created_at DESC LIMIT $%d", argPos)
+	args = append(args, limit)
+
+	rows, err := s.conn(nil).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.WebhookDelivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// ReplayWebhookDelivery возвращает доставку в очередь с обнулённым счётчиком попыток
+func (s *Storage) ReplayWebhookDelivery(ctx context.Context, id string, now time.Time) error {
+	const op = "storage.postgres.ReplayWebhookDelivery"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE webhook_deliveries
+		SET status = 'pending', attempts = 0, next_attempt_at = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND tenant_id = $3`,
+		id, now, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Outbox
+
+const outboxColumns = `id, event_id, event_type, payload, attempts, next_attempt_at, last_error, published_at, created_at`
+
+func scanOutboxEvent(row rowScanner) (*models.OutboxEvent, error) {
+	var e models.OutboxEvent
+	var eventType string
+	var lastError sql.NullString
+	var publishedAt sql.NullTime
+
+	err := row.Scan(
+		&e.ID,
+		&e.EventID,
+		&eventType,
+		&e.Payload,
+		&e.Attempts,
+		&e.NextAttemptAt,
+		&lastError,
+		&publishedAt,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.EventType = models.EventType(eventType)
+	if lastError.Valid {
+		e.LastError = &lastError.String
+	}
+	if publishedAt.Valid {
+		e.PublishedAt = &publishedAt.Time
+	}
+
+	return &e, nil
+}
+
+// CreateOutboxEvent пишет событие в outbox; вызывается в транзакции изменения
+func (s *Storage) CreateOutboxEvent(ctx context.Context, tx *sql.Tx, event *models.OutboxEvent) error {
+	const op = "storage.postgres.CreateOutboxEvent"
+
+	// payload передаётся строкой: []byte lib/pq отправил бы как bytea
+	_, err := s.conn(tx).ExecContext(ctx,
+		`INSERT INTO outbox (event_id, event_type, payload, tenant_id) VALUES ($1, $2, $3::jsonb, $4)`,
+		event.EventID, string(event.EventType), string(event.Payload), tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxEvents блокирует до limit неопубликованных событий, чей срок наступил, в порядке записи.
+// Строки, уже взятые другим релеем, пропускаются (SKIP LOCKED) и остаются за ним до конца его транзакции.
+func (s *Storage) ClaimOutboxEvents(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]*models.OutboxEvent, error) {
+	const op = "storage.postgres.ClaimOutboxEvents"
+
+	rows, err := s.conn(tx).QueryContext(ctx,
+		`SELECT `+outboxColumns+` FROM outbox
+		WHERE tenant_id = $3 AND published_at IS NULL AND next_attempt_at <= $1
+		ORDER BY id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`,
+		now, limit, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.OutboxEvent
+	for rows.Next() {
+		e, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) MarkOutboxPublished(ctx context.Context, tx *sql.Tx, ids []int64, at time.Time) error {
+	const op = "storage.postgres.MarkOutboxPublished"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE outbox SET published_at = $2, last_error = NULL WHERE id = ANY($1) AND tenant_id = $3`,
+		pq.Array(ids), at, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RescheduleOutboxEvent откладывает неудавшуюся публикацию до nextAttemptAt
+func (s *Storage) RescheduleOutboxEvent(ctx context.Context, tx *sql.Tx, id int64, nextAttemptAt time.Time, lastError string) error {
+	const op = "storage.postgres.RescheduleOutboxEvent"
+
+	_, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE outbox SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3 WHERE id = $1 AND tenant_id = $4`,
+		id, nextAttemptAt, lastError, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PurgeOutbox удаляет события, опубликованные раньше before
+func (s *Storage) PurgeOutbox(ctx context.Context, before time.Time) (int, error) {
+	const op = "storage.postgres.PurgeOutbox"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`DELETE FROM outbox WHERE tenant_id = $2 AND published_at IS NOT NULL AND published_at < $1`, before, tenantID(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// Reminders
+
+const reminderColumns = `r.id, r.booking_id, r.recipient_role, r.recipient_id, r.offset_minutes, r.lesson_start,
+	r.send_at, r.status, r.attempts, r.last_error, r.sent_at, r.created_at, r.updated_at`
+
+func scanReminder(row rowScanner, extra ...any) (*models.Reminder, error) {
+	var r models.Reminder
+	var role, status string
+	var offsetMinutes int
+	var lastError sql.NullString
+	var sentAt sql.NullTime
+
+	dest := []any{
+		&r.ID,
+		&r.BookingID,
+		&role,
+		&r.RecipientID,
+		&offsetMinutes,
+		&r.LessonStart,
+		&r.SendAt,
+		&status,
+		&r.Attempts,
+		&lastError,
+		&sentAt,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	r.RecipientRole = models.ReminderRecipient(role)
+	r.Offset = time.Duration(offsetMinutes) * time.Minute
+	r.Status = models.ReminderStatus(status)
+	if lastError.Valid {
+		r.LastError = &lastError.String
+	}
+	if sentAt.Valid {
+		r.SentAt = &sentAt.Time
+	}
+
+	return &r, nil
+}
+
+// ScheduleReminders ставит напоминания по брони для каждого получателя и смещения (в минутах)
+// от текущего начала её слота. Уже существующие напоминания переводятся на новое время
+// и снова становятся pending; смещения, время которых к now прошло, пропускаются.
+// Возвращает число запланированных напоминаний.
+func (s *Storage) ScheduleReminders(ctx context.Context, tx *sql.Tx, bookingID string, offsetMinutes []int, roles []string, now time.Time) (int, error) {
+	const op = "storage.postgres.ScheduleReminders"
+
+	res, err := s.conn(tx).ExecContext(ctx,
+		`INSERT INTO reminders (booking_id, recipient_role, recipient_id, offset_minutes, lesson_start, send_at, tenant_id)
+		SELECT b.id, r.role,
+		       CASE r.role WHEN 'student' THEN b.student_id ELSE b.teacher_id END,
+		       o.minutes, sl.starts_at, sl.starts_at - make_interval(mins => o.minutes), b.tenant_id
+		FROM bookings b
+		JOIN slots sl ON sl.id = b.slot_id
+		CROSS JOIN unnest($2::int[]) AS o(minutes)
+		CROSS JOIN unnest($3::text[]) AS r(role)
+		WHERE b.id = $1 AND b.tenant_id = $5 AND b.deleted_at IS NULL
+		  AND sl.starts_at - make_interval(mins => o.minutes) > $4
+		ON CONFLICT (booking_id, recipient_role, offset_minutes) DO UPDATE
+		SET recipient_id = EXCLUDED.recipient_id, lesson_start = EXCLUDED.lesson_start,
+		    send_at = EXCLUDED.send_at, status = 'pending', attempts = 0, last_error = NULL,
+		    sent_at = NULL, updated_at = CURRENT_TIMESTAMP`,
+		bookingID, pq.Array(offsetMinutes), pq.Array(roles), now, tenantID(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// CancelReminders отменяет ещё не отправленные напоминания по брони
+func (s *Storage) CancelReminders(ctx context.Context, tx *sql.Tx, bookingID string) (int, error) {
+	const op = "storage.postgres.CancelReminders"
+
+	res, err := s.conn(tx).ExecContext(ctx,
+		`UPDATE reminders SET status = 'cancelled', updated_at = CURRENT_TIMESTAMP
+		WHERE booking_id = $1 AND tenant_id = $2 AND status = 'pending'`,
+		bookingID, tenantID(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// ExpireReminders закрывает pending-напоминания, урок по которым уже начался
+func (s *Storage) ExpireReminders(ctx context.Context, now time.Time) (int, error) {
+	const op = "storage.postgres.ExpireReminders"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE reminders SET status = 'expired', updated_at = CURRENT_TIMESTAMP
+		WHERE tenant_id = $2 AND status = 'pending' AND lesson_start <= $1`,
+		now, tenantID(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// ClaimReminders берёт в работу до limit напоминаний, срок которых наступил к now, по ещё
+// не начавшимся урокам. Взятые напоминания откладываются до leaseUntil, как доставки вебхуков.
+func (s *Storage) ClaimReminders(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*models.ReminderDispatch, error) {
+	const op = "storage.postgres.ClaimReminders"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`UPDATE reminders r
+		SET send_at = $2, updated_at = CURRENT_TIMESTAMP
+		FROM bookings b
+		JOIN slots sl ON sl.id = b.slot_id
+		WHERE b.id = r.booking_id
+		  AND r.id IN (
+			SELECT pr.id FROM reminders pr
+			WHERE pr.tenant_id = $4 AND pr.status = 'pending' AND pr.send_at <= $1 AND pr.lesson_start > $1
+			ORDER BY pr.send_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		  )
+		RETURNING `+reminderColumns+`, b.slot_id, b.student_id, b.teacher_id, sl.ends_at`,
+		now, leaseUntil, limit, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.ReminderDispatch
+	for rows.Next() {
+		var dispatch models.ReminderDispatch
+		r, err := scanReminder(rows, &dispatch.SlotID, &dispatch.StudentID, &dispatch.TeacherID, &dispatch.LessonEnd)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		dispatch.Reminder = *r
+		result = append(result, &dispatch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// LogReminderDelivery записывает напоминание в журнал отправок. false — напоминание
+// к этому времени урока уже уходило, и повторно его отправлять не нужно.
+func (s *Storage) LogReminderDelivery(ctx context.Context, r *models.Reminder) (bool, error) {
+	const op = "storage.postgres.LogReminderDelivery"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`INSERT INTO reminder_deliveries (booking_id, recipient_role, offset_minutes, lesson_start, reminder_id, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING`,
+		r.BookingID, string(r.RecipientRole), int(r.Offset/time.Minute), r.LessonStart, r.ID, tenantID(ctx),
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// DeleteReminderDelivery убирает запись журнала после неудачной отправки, чтобы её можно было повторить
+func (s *Storage) DeleteReminderDelivery(ctx context.Context, r *models.Reminder) error {
+	const op = "storage.postgres.DeleteReminderDelivery"
+
+	_, err := s.conn(nil).ExecContext(ctx,
+		`DELETE FROM reminder_deliveries
+		WHERE booking_id = $1 AND recipient_role = $2 AND offset_minutes = $3 AND lesson_start = $4 AND tenant_id = $5`,
+		r.BookingID, string(r.RecipientRole), int(r.Offset/time.Minute), r.LessonStart, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SaveReminderAttempt сохраняет результат попытки отправки. Напоминание, которое тем
+// временем отменили или перенесли на другое время урока, не перезаписывается.
+func (s *Storage) SaveReminderAttempt(ctx context.Context, r *models.Reminder) error {
+	const op = "storage.postgres.SaveReminderAttempt"
+
+	_, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE reminders
+		SET status = $2, attempts = $3, send_at = $4, last_error = $5, sent_at = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND tenant_id = $8 AND status = 'pending' AND lesson_start = $7`,
+		r.ID,
+		string(r.Status),
+		r.Attempts,
+		r.SendAt,
+		r.LastError,
+		r.SentAt,
+		r.LessonStart,
+		tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListReminders(ctx context.Context, bookingID string) ([]*models.Reminder, error) {
+	const op = "storage.postgres.ListReminders"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT `+reminderColumns+` FROM reminders r
+		WHERE r.booking_id = $1 AND r.tenant_id = $2
+		ORDER BY r.send_at, r.recipient_role`,
+		bookingID, tenantID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.Reminder
+	for rows.Next() {
+		r, err := scanReminder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// Consistency
+
+// consistencyChecks — запросы проверок целостности; каждый возвращает
+// id сущности, преподавателя и описание расхождения, $1 — лимит строк, $2 — арендатор
+var consistencyChecks = []struct {
+	check models.ConsistencyCheck
+	query string
+}{
+	{models.CheckBookedSlotWithoutBooking,
+		`SELECT s.id, s.teacher_id, format('slot %s is booked without an active booking', s.starts_at)
+		FROM slots s
+		WHERE s.tenant_id = $2 AND s.status = 'booked' AND NOT EXISTS (
+			SELECT 1 FROM bookings b
+			WHERE b.slot_id = s.id AND b.tenant_id = s.tenant_id AND b.status <> 'cancelled' AND b.deleted_at IS NULL)
+		ORDER BY s.starts_at LIMIT $1`},
+	{models.CheckBookingSlotMismatch,
+		`SELECT b.id, b.teacher_id, format('slot %s is %s with booking_id %s', s.id, s.status, coalesce(s.booking_id::text, 'null'))
+		FROM bookings b JOIN slots s ON s.id = b.slot_id AND s.tenant_id = b.tenant_id
+		WHERE b.tenant_id = $2 AND b.status <> 'cancelled' AND b.deleted_at IS NULL
+		  AND (s.status <> 'booked' OR s.booking_id IS DISTINCT FROM b.id)
+		ORDER BY s.starts_at LIMIT $1`},
+	{models.CheckBookingPeriodMismatch,
+		`SELECT b.id, b.teacher_id, format('booking %s / %s, slot %s %s / %s', b.period, b.teacher_id, s.id, tstzrange(s.starts_at, s.ends_at), s.teacher_id)
+		FROM bookings b JOIN slots s ON s.id = b.slot_id AND s.tenant_id = b.tenant_id
+		WHERE b.tenant_id = $2 AND b.status <> 'cancelled' AND b.deleted_at IS NULL
+		  AND (b.period <> tstzrange(s.starts_at, s.ends_at) OR b.teacher_id <> s.teacher_id)
+		ORDER BY s.starts_at LIMIT $1`},
+	{models.CheckSlotOverlap,
+		`SELECT a.id, a.teacher_id, format('overlaps slot %s (%s - %s)', o.id, o.starts_at, o.ends_at)
+		FROM slots a JOIN slots o ON o.tenant_id = a.tenant_id AND o.teacher_id = a.teacher_id AND a.id < o.id
+		  AND a.starts_at < o.ends_at AND o.starts_at < a.ends_at
+		WHERE a.tenant_id = $2 AND a.status <> 'cancelled' AND o.status <> 'cancelled'
+		ORDER BY a.starts_at LIMIT $1`},
+	{models.CheckSlotBlockMismatch,
+		`SELECT id, teacher_id, CASE WHEN status = 'free'
+			THEN format('free slot %s lies under a time block', starts_at)
+			ELSE format('blocked slot %s has no time block', starts_at) END
+		FROM slots
+		WHERE tenant_id = $2 AND ((status = 'free' AND ` + slotUnderBlock + `)
+		   OR (status = 'blocked' AND NOT ` + slotUnderBlock + `))
+		ORDER BY starts_at LIMIT $1`},
+	{models.CheckAttendanceOnCancelledBooking,
+		`SELECT a.id, b.teacher_id, format('booking %s is cancelled', b.id)
+		FROM attendance a JOIN bookings b ON b.id = a.booking_id AND b.tenant_id = a.tenant_id
+		WHERE a.tenant_id = $2 AND b.status = 'cancelled'
+		ORDER BY a.created_at LIMIT $1`},
+}
+
+// FindConsistencyIssues выполняет все проверки целостности, не больше limit строк на проверку
+func (s *Storage) FindConsistencyIssues(ctx context.Context, limit int) ([]*models.ConsistencyIssue, error) {
+	const op = "storage.postgres.FindConsistencyIssues"
+
+	var issues []*models.ConsistencyIssue
+	for _, c := range consistencyChecks {
+		rows, err := s.conn(nil).QueryContext(ctx, c.query, limit, tenantID(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", op, c.check, err)
+		}
+
+		for rows.Next() {
+			issue := &models.ConsistencyIssue{Check: c.check}
+			if err := rows.Scan(&issue.EntityID, &issue.TeacherID, &issue.Detail); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("%s: %s: %w", op, c.check, err)
+			}
+			issues = append(issues, issue)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", op, c.check, err)
+		}
+	}
+
+	return issues, nil
+}
+
+// API Keys
+
+const apiKeyColumns = `id, name, prefix, key_hash, scopes, teacher_id, expires_at, last_used_at,
+	created_by, revoked_at, revoked_by, created_at`
+
+func scanAPIKey(row rowScanner) (*models.APIKey, error) {
+	var key models.APIKey
+	var scopes []string
+
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		pq.Array(&scopes),
+		&key.TeacherID,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.CreatedBy,
+		&key.RevokedAt,
+		&key.RevokedBy,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Scopes = make([]models.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		key.Scopes[i] = models.APIKeyScope(scope)
+	}
+
+	return &key, nil
+}
+
+func (s *Storage) CreateAPIKey(ctx context.Context, key *models.APIKey) (string, error) {
+	const op = "storage.postgres.CreateAPIKey"
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	var id string
+	err := s.conn(nil).QueryRowContext(ctx,
+		`INSERT INTO api_keys (name, prefix, key_hash, scopes, teacher_id, expires_at, created_by, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		pq.Array(scopes),
+		key.TeacherID,
+		key.ExpiresAt,
+		key.CreatedBy,
+		tenantID(ctx),
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) GetAPIKey(ctx context.Context, id string) (*models.APIKey, error) {
+	const op = "storage.postgres.GetAPIKey"
+
+	key, err := scanAPIKey(s.conn(nil).QueryRowContext(ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1 AND tenant_id = $2`, id, tenantID(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return key, nil
+}
+
+func (s *Storage) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	const op = "storage.postgres.ListAPIKeys"
+
+	rows, err := s.conn(nil).QueryContext(ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE tenant_id = $1 ORDER BY created_at`, tenantID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []*models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// UseAPIKey находит действующий ключ по хешу и отмечает время использования.
+// Отозванный или истёкший ключ не находится.
+func (s *Storage) UseAPIKey(ctx context.Context, keyHash string, now time.Time) (*models.APIKey, error) {
+	const op = "storage.postgres.UseAPIKey"
+
+	key, err := scanAPIKey(s.conn(nil).QueryRowContext(ctx,
+		`UPDATE api_keys SET last_used_at = $2
+		WHERE key_hash = $1 AND tenant_id = $3 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > $2)
+		RETURNING `+apiKeyColumns,
+		keyHash, now, tenantID(ctx),
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s: %w", op, response.ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey отзывает ключ; уже отозванный ключ не найден
+func (s *Storage) RevokeAPIKey(ctx context.Context, id, revokedBy string) error {
+	const op = "storage.postgres.RevokeAPIKey"
+
+	res, err := s.conn(nil).ExecContext(ctx,
+		`UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP, revoked_by = $2
+		WHERE id = $1 AND tenant_id = $3 AND revoked_at IS NULL`,
+		id, revokedBy, tenantID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, response.ErrNotFound)
+	}
+
+	return nil
 }
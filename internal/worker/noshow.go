@@ -0,0 +1,101 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"rasp-service/internal/lock"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/sl"
+	"time"
+)
+
+const noShowLockKey = "worker:no_show"
+
+type NoShowMarker interface {
+	MarkNoShows(ctx context.Context, grace time.Duration, limit int) (int, error)
+}
+
+// NoShow периодически отмечает неявки по закончившимся урокам.
+// Redis-лок не даёт нескольким экземплярам сервиса работать одновременно.
+// За один проход обходятся все арендаторы.
+type NoShow struct {
+	log       *slog.Logger
+	marker    NoShowMarker
+	locker    lock.Locker
+	tenants   []string
+	interval  time.Duration
+	grace     time.Duration
+	batchSize int
+}
+
+func NewNoShow(log *slog.Logger, marker NoShowMarker, locker lock.Locker, tenants []string, interval, grace time.Duration, batchSize int) *NoShow {
+	return &NoShow{
+		log:       log.With(slog.String("worker", "no_show")),
+		marker:    marker,
+		locker:    locker,
+		tenants:   tenants,
+		interval:  interval,
+		grace:     grace,
+		batchSize: batchSize,
+	}
+}
+
+// Run работает до отмены ctx
+func (w *NoShow) Run(ctx context.Context) {
+	w.log.Info("Starting no-show worker",
+		slog.String("interval", w.interval.String()),
+		slog.String("grace_period", w.grace.String()),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.log.Info("No-show worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *NoShow) tick(ctx context.Context) {
+	ok, err := w.locker.Lock(ctx, noShowLockKey, w.interval)
+	if err != nil {
+		w.log.Error("Failed to acquire lock", sl.Err(err))
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		_ = w.locker.Unlock(context.Background(), noShowLockKey)
+	}()
+
+	for _, id := range w.tenants {
+		if ctx.Err() != nil {
+			return
+		}
+		w.mark(tenant.WithTenant(ctx, id), w.log.With(slog.String("tenant", id)))
+	}
+}
+
+func (w *NoShow) mark(ctx context.Context, log *slog.Logger) {
+	// добираем пачками, пока есть что отмечать
+	for ctx.Err() == nil {
+		marked, err := w.marker.MarkNoShows(ctx, w.grace, w.batchSize)
+		if err != nil {
+			log.Error("Failed to mark no-shows", sl.Err(err))
+			return
+		}
+		if marked > 0 {
+			log.Info("No-shows marked", slog.Int("count", marked))
+		}
+		if marked < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/sl"
+	"time"
+)
+
+// outboxPurgeInterval — как часто удалять давно опубликованные события
+const outboxPurgeInterval = time.Hour
+
+type OutboxRelayer interface {
+	RelayOutbox(ctx context.Context, limit int) (int, error)
+	PurgeOutbox(ctx context.Context, retention time.Duration) (int, error)
+}
+
+// Outbox публикует события из outbox. Лок не нужен: строки разбираются
+// FOR UPDATE SKIP LOCKED, и несколько экземпляров делят очередь между собой.
+type Outbox struct {
+	log       *slog.Logger
+	relayer   OutboxRelayer
+	tenants   []string
+	interval  time.Duration
+	batchSize int
+	retention time.Duration
+	lastPurge time.Time
+}
+
+func NewOutbox(log *slog.Logger, relayer OutboxRelayer, tenants []string, interval time.Duration, batchSize int, retention time.Duration) *Outbox {
+	return &Outbox{
+		log:       log.With(slog.String("worker", "outbox")),
+		relayer:   relayer,
+		tenants:   tenants,
+		interval:  interval,
+		batchSize: batchSize,
+		retention: retention,
+	}
+}
+
+// Run работает до отмены ctx
+func (w *Outbox) Run(ctx context.Context) {
+	w.log.Info("Starting outbox relay", slog.String("interval", w.interval.String()))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.log.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Outbox) tick(ctx context.Context) {
+	purge := w.retention > 0 && time.Since(w.lastPurge) >= outboxPurgeInterval
+	if purge {
+		w.lastPurge = time.Now()
+	}
+
+	for _, id := range w.tenants {
+		if ctx.Err() != nil {
+			return
+		}
+		w.relay(tenant.WithTenant(ctx, id), w.log.With(slog.String("tenant", id)), purge)
+	}
+}
+
+func (w *Outbox) relay(ctx context.Context, log *slog.Logger, purge bool) {
+	// публикуем пачками, пока есть готовые события
+	for ctx.Err() == nil {
+		relayed, err := w.relayer.RelayOutbox(ctx, w.batchSize)
+		if err != nil {
+			log.Error("Failed to relay outbox", sl.Err(err))
+			break
+		}
+		if relayed > 0 {
+			log.Debug("Outbox events relayed", slog.Int("count", relayed))
+		}
+		if relayed < w.batchSize {
+			break
+		}
+	}
+
+	if purge && ctx.Err() == nil {
+		purged, err := w.relayer.PurgeOutbox(ctx, w.retention)
+		if err != nil {
+			log.Error("Failed to purge outbox", sl.Err(err))
+			return
+		}
+		if purged > 0 {
+			log.Info("Published outbox events purged", slog.Int("count", purged))
+		}
+	}
+}
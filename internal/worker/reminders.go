@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/sl"
+	"time"
+)
+
+type ReminderSender interface {
+	SendReminders(ctx context.Context, limit int) (int, error)
+}
+
+// Reminders отправляет напоминания об уроках. Лок не нужен: напоминания берутся
+// FOR UPDATE SKIP LOCKED с арендой, и несколько экземпляров делят очередь между собой.
+type Reminders struct {
+	log       *slog.Logger
+	sender    ReminderSender
+	tenants   []string
+	interval  time.Duration
+	batchSize int
+}
+
+func NewReminders(log *slog.Logger, sender ReminderSender, tenants []string, interval time.Duration, batchSize int) *Reminders {
+	return &Reminders{
+		log:       log.With(slog.String("worker", "reminders")),
+		sender:    sender,
+		tenants:   tenants,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run работает до отмены ctx
+func (w *Reminders) Run(ctx context.Context) {
+	w.log.Info("Starting reminders worker", slog.String("interval", w.interval.String()))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.log.Info("Reminders worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Reminders) tick(ctx context.Context) {
+	for _, id := range w.tenants {
+		if ctx.Err() != nil {
+			return
+		}
+		w.send(tenant.WithTenant(ctx, id), w.log.With(slog.String("tenant", id)))
+	}
+}
+
+func (w *Reminders) send(ctx context.Context, log *slog.Logger) {
+	for ctx.Err() == nil {
+		sent, err := w.sender.SendReminders(ctx, w.batchSize)
+		if err != nil {
+			log.Error("Failed to send reminders", sl.Err(err))
+			return
+		}
+		if sent > 0 {
+			log.Info("Reminders processed", slog.Int("count", sent))
+		}
+		if sent < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"rasp-service/internal/lock"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/sl"
+	"time"
+)
+
+const webhooksLockKey = "worker:webhooks"
+
+type WebhookDeliverer interface {
+	DeliverWebhooks(ctx context.Context, limit int) (int, error)
+}
+
+// Webhooks периодически отправляет доставки вебхуков, чей срок наступил.
+// Redis-лок не даёт нескольким экземплярам сервиса работать одновременно.
+// За один проход обходятся все арендаторы.
+type Webhooks struct {
+	log       *slog.Logger
+	deliverer WebhookDeliverer
+	locker    lock.Locker
+	tenants   []string
+	interval  time.Duration
+	batchSize int
+}
+
+func NewWebhooks(log *slog.Logger, deliverer WebhookDeliverer, locker lock.Locker, tenants []string, interval time.Duration, batchSize int) *Webhooks {
+	return &Webhooks{
+		log:       log.With(slog.String("worker", "webhooks")),
+		deliverer: deliverer,
+		locker:    locker,
+		tenants:   tenants,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run работает до отмены ctx
+func (w *Webhooks) Run(ctx context.Context) {
+	w.log.Info("Starting webhooks worker", slog.String("interval", w.interval.String()))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.log.Info("Webhooks worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Webhooks) tick(ctx context.Context) {
+	ok, err := w.locker.Lock(ctx, webhooksLockKey, w.interval)
+	if err != nil {
+		w.log.Error("Failed to acquire lock", sl.Err(err))
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		_ = w.locker.Unlock(context.Background(), webhooksLockKey)
+	}()
+
+	for _, id := range w.tenants {
+		if ctx.Err() != nil {
+			return
+		}
+		w.deliver(tenant.WithTenant(ctx, id), w.log.With(slog.String("tenant", id)))
+	}
+}
+
+func (w *Webhooks) deliver(ctx context.Context, log *slog.Logger) {
+	// отправляем пачками, пока очередь не опустеет
+	for ctx.Err() == nil {
+		sent, err := w.deliverer.DeliverWebhooks(ctx, w.batchSize)
+		if err != nil {
+			log.Error("Failed to deliver webhooks", sl.Err(err))
+			return
+		}
+		if sent > 0 {
+			log.Info("Webhook deliveries processed", slog.Int("count", sent))
+		}
+		if sent < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+)
+
+// CreateAPIKey — POST /admin/api_keys; сам ключ возвращается только здесь
+func (c *Client) CreateAPIKey(ctx context.Context, req api.APIKeyRequest) (*api.APIKeyResponse, error) {
+	var resp struct {
+		APIKey api.APIKeyResponse `json:"api_key"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/admin/api_keys", body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.APIKey, nil
+}
+
+// GetAPIKey — GET /admin/api_keys/{id}
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*api.APIKeyResponse, error) {
+	var resp struct {
+		APIKey api.APIKeyResponse `json:"api_key"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/admin/api_keys/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.APIKey, nil
+}
+
+// ListAPIKeys — GET /admin/api_keys, включая отозванные
+func (c *Client) ListAPIKeys(ctx context.Context) ([]api.APIKeyResponse, error) {
+	var resp struct {
+		APIKeys []api.APIKeyResponse `json:"api_keys"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/admin/api_keys"}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.APIKeys, nil
+}
+
+// RevokeAPIKey — POST /admin/api_keys/{id}/revoke
+func (c *Client) RevokeAPIKey(ctx context.Context, id string) (*api.APIKeyResponse, error) {
+	var resp struct {
+		APIKey api.APIKeyResponse `json:"api_key"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/admin/api_keys/" + pathEscape(id) + "/revoke"}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.APIKey, nil
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AttendanceFilter — фильтр GET /attendance; пустые поля не передаются
+type AttendanceFilter struct {
+	TeacherID string
+	From      *time.Time
+	To        *time.Time
+}
+
+func (f AttendanceFilter) query() url.Values {
+	query := url.Values{}
+	if f.TeacherID != "" {
+		query.Set("teacher_id", f.TeacherID)
+	}
+	if f.From != nil {
+		query.Set("from", formatTime(*f.From))
+	}
+	if f.To != nil {
+		query.Set("to", formatTime(*f.To))
+	}
+	return query
+}
+
+// CreateAttendance — POST /attendance. При upsert существующая отметка по той же
+// брони перезаписывается; created сообщает, была ли отметка создана.
+func (c *Client) CreateAttendance(ctx context.Context, req api.AttendanceRequest, upsert bool) (attendance *api.AttendanceResponse, created bool, err error) {
+	var query url.Values
+	if upsert {
+		query = url.Values{"upsert": {"true"}}
+	}
+
+	var resp struct {
+		Attendance api.AttendanceResponse `json:"attendance"`
+	}
+	status, err := c.doStatus(ctx, request{method: http.MethodPost, path: "/attendance", query: query, body: req}, &resp)
+	if err != nil {
+		return nil, false, err
+	}
+	return &resp.Attendance, status == http.StatusCreated, nil
+}
+
+// GetAttendance — GET /attendance/{id}
+func (c *Client) GetAttendance(ctx context.Context, id string) (*api.AttendanceResponse, error) {
+	var resp struct {
+		Attendance api.AttendanceResponse `json:"attendance"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/attendance/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Attendance, nil
+}
+
+// ListAttendance — GET /attendance
+func (c *Client) ListAttendance(ctx context.Context, filter AttendanceFilter) ([]api.AttendanceResponse, error) {
+	var resp struct {
+		Attendances []api.AttendanceResponse `json:"attendances"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/attendance", query: filter.query()}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Attendances, nil
+}
+
+// UpdateAttendance — PUT /attendance/{id}
+func (c *Client) UpdateAttendance(ctx context.Context, id string, req api.AttendanceUpdateRequest) (*api.AttendanceResponse, error) {
+	var resp struct {
+		Attendance api.AttendanceResponse `json:"attendance"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPut, path: "/attendance/" + pathEscape(id), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Attendance, nil
+}
+
+// PatchAttendance — PATCH /attendance/{id}: меняет только переданные поля
+func (c *Client) PatchAttendance(ctx context.Context, id string, req api.AttendancePatchRequest) (*api.AttendanceResponse, error) {
+	var resp struct {
+		Attendance api.AttendanceResponse `json:"attendance"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPatch, path: "/attendance/" + pathEscape(id), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Attendance, nil
+}
+
+// DeleteAttendance — DELETE /attendance/{id}
+func (c *Client) DeleteAttendance(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/attendance/" + pathEscape(id)}, nil)
+}
+
+// BulkAttendance — POST /attendance/bulk. При BULK_VALIDATION_FAILED возвращает и
+// ошибку, и результаты по каждой записи, чтобы показать, какие из них отклонены.
+func (c *Client) BulkAttendance(ctx context.Context, req api.AttendanceBulkRequest) (*api.AttendanceBulkResponse, error) {
+	var resp api.AttendanceBulkResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/attendance/bulk", body: req}, &resp); err != nil {
+		if resp.Results != nil {
+			return &resp, err
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
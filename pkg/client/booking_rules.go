@@ -0,0 +1,62 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CreateBookingRules — POST /booking_rules
+func (c *Client) CreateBookingRules(ctx context.Context, req api.BookingRulesRequest) (*api.BookingRulesResponse, error) {
+	var resp struct {
+		Rules api.BookingRulesResponse `json:"rules"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/booking_rules", body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Rules, nil
+}
+
+// GetBookingRules — GET /booking_rules/{id}
+func (c *Client) GetBookingRules(ctx context.Context, id string) (*api.BookingRulesResponse, error) {
+	var resp struct {
+		Rules api.BookingRulesResponse `json:"rules"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/booking_rules/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Rules, nil
+}
+
+// ListBookingRules — GET /booking_rules; пустой teacherID — правила всех преподавателей
+func (c *Client) ListBookingRules(ctx context.Context, teacherID string) ([]api.BookingRulesResponse, error) {
+	var query url.Values
+	if teacherID != "" {
+		query = url.Values{"teacher_id": {teacherID}}
+	}
+
+	var resp struct {
+		Rules []api.BookingRulesResponse `json:"rules_list"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/booking_rules", query: query}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Rules, nil
+}
+
+// UpdateBookingRules — PUT /booking_rules/{id}
+func (c *Client) UpdateBookingRules(ctx context.Context, id string, req api.BookingRulesRequest) (*api.BookingRulesResponse, error) {
+	var resp struct {
+		Rules api.BookingRulesResponse `json:"rules"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPut, path: "/booking_rules/" + pathEscape(id), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Rules, nil
+}
+
+// DeleteBookingRules — DELETE /booking_rules/{id}
+func (c *Client) DeleteBookingRules(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/booking_rules/" + pathEscape(id)}, nil)
+}
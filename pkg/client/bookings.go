@@ -0,0 +1,164 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// BookingFilter — фильтр GET /bookings; пустые поля не передаются
+type BookingFilter struct {
+	StudentID      string
+	TeacherID      string
+	From           *time.Time
+	To             *time.Time
+	Status         string
+	IncludeDeleted bool
+}
+
+func (f BookingFilter) query() url.Values {
+	query := url.Values{}
+	if f.StudentID != "" {
+		query.Set("student_id", f.StudentID)
+	}
+	if f.TeacherID != "" {
+		query.Set("teacher_id", f.TeacherID)
+	}
+	if f.From != nil {
+		query.Set("from", formatTime(*f.From))
+	}
+	if f.To != nil {
+		query.Set("to", formatTime(*f.To))
+	}
+	if f.Status != "" {
+		query.Set("status", f.Status)
+	}
+	if f.IncludeDeleted {
+		query.Set("include_deleted", "true")
+	}
+	return query
+}
+
+// AlternativesDefault оставляет число альтернативных слотов на усмотрение сервера
+const AlternativesDefault = -1
+
+// alternativesQuery — ?alternatives=N: сколько свободных слотов подобрать, если
+// выбранный занят; 0 отключает подбор
+func alternativesQuery(limit int) url.Values {
+	if limit < 0 {
+		return nil
+	}
+	return url.Values{"alternatives": {strconv.Itoa(limit)}}
+}
+
+// CreateBooking — POST /bookings. При SLOT_NOT_AVAILABLE предложенные слоты
+// доступны через (*Error).Alternatives; alternatives — их число или AlternativesDefault.
+func (c *Client) CreateBooking(ctx context.Context, req api.BookingRequest, alternatives int) (*api.BookingResponse, error) {
+	var resp struct {
+		Booking api.BookingResponse `json:"booking"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/bookings", query: alternativesQuery(alternatives), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Booking, nil
+}
+
+// GetBooking — GET /bookings/{id}
+func (c *Client) GetBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
+	var resp struct {
+		Booking api.BookingResponse `json:"booking"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/bookings/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Booking, nil
+}
+
+// ListBookings — GET /bookings
+func (c *Client) ListBookings(ctx context.Context, filter BookingFilter) ([]api.BookingResponse, error) {
+	var resp struct {
+		Bookings []api.BookingResponse `json:"bookings"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/bookings", query: filter.query()}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Bookings, nil
+}
+
+// CancelBooking — PUT /bookings/{id}/cancel
+func (c *Client) CancelBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
+	return c.bookingAction(ctx, http.MethodPut, "/bookings/"+pathEscape(id)+"/cancel")
+}
+
+// ConfirmBooking — POST /bookings/{id}/confirm
+func (c *Client) ConfirmBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
+	return c.bookingAction(ctx, http.MethodPost, "/bookings/"+pathEscape(id)+"/confirm")
+}
+
+// RestoreBooking — POST /bookings/{id}/restore
+func (c *Client) RestoreBooking(ctx context.Context, id string) (*api.BookingResponse, error) {
+	return c.bookingAction(ctx, http.MethodPost, "/bookings/"+pathEscape(id)+"/restore")
+}
+
+// DeleteBooking — DELETE /bookings/{id} (мягкое удаление)
+func (c *Client) DeleteBooking(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/bookings/" + pathEscape(id)}, nil)
+}
+
+// RescheduleBooking — POST /bookings/reschedule; alternatives — как в CreateBooking
+func (c *Client) RescheduleBooking(ctx context.Context, req api.BookingRescheduleRequest, alternatives int) (*api.BookingResponse, error) {
+	var resp struct {
+		Booking api.BookingResponse `json:"booking"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/bookings/reschedule", query: alternativesQuery(alternatives), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Booking, nil
+}
+
+// GetBookingHistory — GET /bookings/{id}/history
+func (c *Client) GetBookingHistory(ctx context.Context, id string) ([]api.BookingEventResponse, error) {
+	var resp struct {
+		Events []api.BookingEventResponse `json:"events"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/bookings/" + pathEscape(id) + "/history"}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Events, nil
+}
+
+// ListBookingReminders — GET /bookings/{id}/reminders
+func (c *Client) ListBookingReminders(ctx context.Context, id string) ([]api.ReminderResponse, error) {
+	var resp struct {
+		Reminders []api.ReminderResponse `json:"reminders"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/bookings/" + pathEscape(id) + "/reminders"}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Reminders, nil
+}
+
+// CheckIn — POST /bookings/{id}/check-in: самостоятельная отметка студента по коду слота
+func (c *Client) CheckIn(ctx context.Context, bookingID, code string) (*api.AttendanceResponse, error) {
+	var resp struct {
+		Attendance api.AttendanceResponse `json:"attendance"`
+	}
+	req := request{method: http.MethodPost, path: "/bookings/" + pathEscape(bookingID) + "/check-in", body: api.CheckInRequest{Code: code}}
+	if err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Attendance, nil
+}
+
+func (c *Client) bookingAction(ctx context.Context, method, path string) (*api.BookingResponse, error) {
+	var resp struct {
+		Booking api.BookingResponse `json:"booking"`
+	}
+	if err := c.do(ctx, request{method: method, path: path}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Booking, nil
+}
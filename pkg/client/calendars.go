@@ -0,0 +1,56 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Владельцы календарной ленты
+const (
+	CalendarOwnerTeacher = "teacher"
+	CalendarOwnerStudent = "student"
+)
+
+func calendarPath(ownerType, id string) (string, error) {
+	switch ownerType {
+	case CalendarOwnerTeacher:
+		return "/calendars/teachers/" + pathEscape(id), nil
+	case CalendarOwnerStudent:
+		return "/calendars/students/" + pathEscape(id), nil
+	}
+	return "", fmt.Errorf("client: unknown calendar owner type %q", ownerType)
+}
+
+// CalendarFeed — GET /calendars/{teachers|students}/{id}.ics: лента iCalendar по токену
+func (c *Client) CalendarFeed(ctx context.Context, ownerType, id, token string) ([]byte, error) {
+	path, err := calendarPath(ownerType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var feed []byte
+	if err := c.do(ctx, request{method: http.MethodGet, path: path + ".ics", query: url.Values{"token": {token}}}, &feed); err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
+
+// IssueCalendarToken — POST /calendars/{teachers|students}/{id}/token: выпускает
+// новый токен ленты, прежний перестаёт действовать
+func (c *Client) IssueCalendarToken(ctx context.Context, ownerType, id string) (*api.CalendarTokenResponse, error) {
+	path, err := calendarPath(ownerType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Calendar api.CalendarTokenResponse `json:"calendar"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: path + "/token"}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Calendar, nil
+}
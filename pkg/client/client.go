@@ -0,0 +1,360 @@
+// Package client — типизированный Go-клиент REST API сервиса расписания поверх DTO
+// из пакета api. Запросы, получившие 423 Locked, повторяются с экспоненциальной
+// паузой; изменяющие запросы получают Idempotency-Key, общий для всех повторов;
+// ошибки API возвращаются как *Error и сравниваются с sentinel-ошибками пакета
+// response через errors.Is.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	mrand "math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultTimeout    = 30 * time.Second
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = 200 * time.Millisecond
+	// MaxRetryDelay ограничивает паузу между повторами, в том числе из Retry-After
+	MaxRetryDelay = 5 * time.Second
+
+	// IdempotencyKeyHeader — заголовок ключа идемпотентности
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// ActorHeader — заголовок инициатора запроса (pkg/middleware/actor)
+	ActorHeader = "X-Actor-ID"
+	// RequestIDHeader — заголовок идентификатора запроса (chi middleware.RequestID)
+	RequestIDHeader = "X-Request-Id"
+	// APIKeyHeader — заголовок API-ключа (pkg/middleware/apikey)
+	APIKeyHeader = "X-API-Key"
+	// TenantHeader — заголовок арендатора (pkg/middleware/tenant)
+	TenantHeader = "X-Tenant-ID"
+
+	userAgent = "rasp-service-client/1.0"
+)
+
+// Client — клиент API. Безопасен для одновременного использования.
+type Client struct {
+	baseURL    *url.URL
+	http       *http.Client
+	actor      string
+	token      string
+	apiKey     string
+	tenant     string
+	maxRetries int
+	retryDelay time.Duration
+}
+
+type Option func(*Client)
+
+// WithHTTPClient заменяет http.Client (по умолчанию — с таймаутом DefaultTimeout)
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.http = hc
+	}
+}
+
+// WithActor передаёт инициатора во всех запросах (X-Actor-ID)
+func WithActor(id string) Option {
+	return func(c *Client) {
+		c.actor = id
+	}
+}
+
+// WithToken передаёт bearer-токен во всех запросах (Authorization)
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithAPIKey передаёт API-ключ во всех запросах (X-API-Key) — для внутренних сервисов
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithTenant передаёт арендатора во всех запросах (X-Tenant-ID); без него сервер
+// берёт арендатора из токена или арендатора по умолчанию
+func WithTenant(id string) Option {
+	return func(c *Client) {
+		c.tenant = id
+	}
+}
+
+// WithRetry задаёт число повторов при 423 Locked и начальную паузу между ними;
+// пауза удваивается с каждой попыткой. maxRetries = 0 отключает повторы.
+func WithRetry(maxRetries int, delay time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryDelay = delay
+	}
+}
+
+// New создаёт клиент для сервера baseURL, например "http://localhost:8080"
+func New(baseURL string, opts ...Option) (*Client, error) {
+	const op = "client.New"
+
+	u, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%s: base url must be http or https: %q", op, baseURL)
+	}
+
+	c := &Client{
+		baseURL:    u,
+		http:       &http.Client{Timeout: DefaultTimeout},
+		maxRetries: DefaultMaxRetries,
+		retryDelay: DefaultRetryDelay,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.maxRetries < 0 {
+		c.maxRetries = 0
+	}
+	if c.retryDelay <= 0 {
+		c.retryDelay = DefaultRetryDelay
+	}
+
+	return c, nil
+}
+
+type ctxKey int
+
+const (
+	idempotencyKeyCtx ctxKey = iota
+	actorCtx
+	requestIDCtx
+)
+
+// WithIdempotencyKey задаёт ключ идемпотентности для изменяющих запросов в ctx вместо
+// сгенерированного. Нужен, чтобы повторить вызов после сбоя сети с тем же ключом.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx, key)
+}
+
+// WithActorContext задаёт инициатора для запросов в ctx вместо WithActor
+func WithActorContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, actorCtx, id)
+}
+
+// WithRequestID передаёт идентификатор запроса (X-Request-Id), например из входящего запроса
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDCtx, id)
+}
+
+// request — описание одного вызова API
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        any
+	rawBody     []byte
+	contentType string
+}
+
+// do выполняет запрос с повторами при 423 и декодирует ответ в out (если out != nil).
+// При ответе с ошибкой возвращает *Error; если тело ошибки несёт данные (например,
+// результаты пакетной отметки), они тоже декодируются в out.
+func (c *Client) do(ctx context.Context, req request, out any) error {
+	_, err := c.doStatus(ctx, req, out)
+	return err
+}
+
+// doStatus — do, дополнительно возвращающий HTTP-статус успешного ответа
+func (c *Client) doStatus(ctx context.Context, req request, out any) (int, error) {
+	payload, contentType, err := req.payload()
+	if err != nil {
+		return 0, err
+	}
+
+	idempotencyKey := ""
+	if isMutation(req.method) {
+		idempotencyKey, err = c.idempotencyKey(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, req, payload, contentType, idempotencyKey)
+		if err != nil {
+			return 0, err
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return 0, fmt.Errorf("client: %s %s: read response: %w", req.method, req.path, err)
+		}
+
+		if resp.StatusCode == http.StatusLocked && attempt < c.maxRetries {
+			if err := sleep(ctx, c.backoff(attempt, resp.Header.Get("Retry-After"))); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			requestID, _ := ctx.Value(requestIDCtx).(string)
+			apiErr := decodeError(resp, data, requestID)
+			if out != nil && len(data) > 0 {
+				_ = json.Unmarshal(data, out)
+			}
+			return resp.StatusCode, apiErr
+		}
+
+		if out == nil || len(data) == 0 {
+			return resp.StatusCode, nil
+		}
+		if raw, ok := out.(*[]byte); ok {
+			*raw = data
+			return resp.StatusCode, nil
+		}
+		if err := json.Unmarshal(data, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("client: %s %s: decode response: %w", req.method, req.path, err)
+		}
+		return resp.StatusCode, nil
+	}
+}
+
+func (c *Client) send(ctx context.Context, req request, payload []byte, contentType, idempotencyKey string) (*http.Response, error) {
+	u := *c.baseURL
+	u.Path = c.baseURL.Path + req.path
+	if len(req.query) > 0 {
+		u.RawQuery = req.query.Encode()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("client: %s %s: %w", req.method, req.path, err)
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if idempotencyKey != "" {
+		httpReq.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+	}
+	if actor := c.actorFor(ctx); actor != "" {
+		httpReq.Header.Set(ActorHeader, actor)
+	}
+	if c.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.apiKey != "" {
+		httpReq.Header.Set(APIKeyHeader, c.apiKey)
+	}
+	if c.tenant != "" {
+		httpReq.Header.Set(TenantHeader, c.tenant)
+	}
+	if id, ok := ctx.Value(requestIDCtx).(string); ok && id != "" {
+		httpReq.Header.Set(RequestIDHeader, id)
+	}
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("client: %s %s: %w", req.method, req.path, err)
+	}
+
+	return resp, nil
+}
+
+func (req request) payload() ([]byte, string, error) {
+	if req.rawBody != nil {
+		return req.rawBody, req.contentType, nil
+	}
+	if req.body == nil {
+		return nil, "", nil
+	}
+
+	data, err := json.Marshal(req.body)
+	if err != nil {
+		return nil, "", fmt.Errorf("client: %s %s: encode request: %w", req.method, req.path, err)
+	}
+	return data, "application/json", nil
+}
+
+func (c *Client) actorFor(ctx context.Context) string {
+	if id, ok := ctx.Value(actorCtx).(string); ok && id != "" {
+		return id
+	}
+	return c.actor
+}
+
+func (c *Client) idempotencyKey(ctx context.Context) (string, error) {
+	if key, ok := ctx.Value(idempotencyKeyCtx).(string); ok && key != "" {
+		return key, nil
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("client: idempotency key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// backoff — пауза перед повтором attempt: Retry-After сервера или удвоение
+// начальной паузы с разбросом ±25%, но не больше MaxRetryDelay
+func (c *Client) backoff(attempt int, retryAfter string) time.Duration {
+	if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+		return min(time.Duration(secs)*time.Second, MaxRetryDelay)
+	}
+
+	d := c.retryDelay << attempt
+	if d <= 0 || d > MaxRetryDelay {
+		d = MaxRetryDelay
+	}
+	jitter := time.Duration(mrand.Int64N(int64(d)/2+1)) - d/4
+	return d + jitter
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// formatTime — время для query-параметров
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// pathEscape экранирует идентификатор для подстановки в путь
+func pathEscape(id string) string {
+	return url.PathEscape(id)
+}
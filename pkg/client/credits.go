@@ -0,0 +1,28 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ListMakeupCredits — GET /students/{id}/credits. По умолчанию только доступные
+// кредиты; all добавляет погашенные и просроченные.
+func (c *Client) ListMakeupCredits(ctx context.Context, studentID, teacherID string, all bool) ([]api.MakeupCreditResponse, error) {
+	query := url.Values{}
+	if teacherID != "" {
+		query.Set("teacher_id", teacherID)
+	}
+	if all {
+		query.Set("all", "true")
+	}
+
+	var resp struct {
+		Credits []api.MakeupCreditResponse `json:"credits"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/students/" + pathEscape(studentID) + "/credits", query: query}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Credits, nil
+}
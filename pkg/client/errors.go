@@ -0,0 +1,138 @@
+package client
+
+import (
+	"rasp-service/api"
+	"rasp-service/pkg/response"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Error — ошибка API: HTTP-статус и тело response.ResponseError.
+// errors.Is сравнивает её с sentinel-ошибками пакета response по коду, errors.As
+// достаёт типизированные ошибки (*response.BookingOverlapError и др.), если сервер
+// передал детали.
+type Error struct {
+	StatusCode int
+	// RequestID — X-Request-Id, переданный через WithRequestID
+	RequestID string
+	response.ResponseError
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api error: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("api error: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// codeErrors — sentinel-ошибка для кода ответа
+var codeErrors = map[string]error{
+	string(response.BAD_REQUEST):                   response.ErrBadRequest,
+	string(response.INVALID_REQUEST):               response.ErrBadRequest,
+	string(response.VALIDATION_FAILED):             response.ErrBadRequest,
+	string(response.NOT_FOUND):                     response.ErrNotFound,
+	string(response.LOCKED):                        response.ErrLocked,
+	string(response.CONFLICT):                      response.ErrConflict,
+	string(response.SLOT_NOT_AVAILABLE):            response.ErrSlotNotAvailable,
+	string(response.BOOKING_OVERLAP):               response.ErrBookingOverlap,
+	string(response.BOOKING_RULE_VIOLATION):        response.ErrRuleViolation,
+	string(response.ATTENDANCE_EXISTS):             response.ErrAttendanceExists,
+	string(response.BOOKING_NOT_CONFIRMED):         response.ErrBookingNotConfirmed,
+	string(response.LESSON_NOT_STARTED):            response.ErrLessonNotStarted,
+	string(response.BULK_VALIDATION_FAILED):        response.ErrBulkValidation,
+	string(response.INVALID_CHECK_IN_CODE):         response.ErrInvalidCheckInCode,
+	string(response.CHECK_IN_CLOSED):               response.ErrCheckInClosed,
+	string(response.STUDENT_RESTRICTED):            response.ErrStudentRestricted,
+	string(response.TEACHER_CONFIRMATION_REQUIRED): response.ErrTeacherConfirmationRequired,
+	string(response.CREDIT_NOT_AVAILABLE):          response.ErrCreditNotAvailable,
+	string(response.INVALID_CALENDAR_TOKEN):        response.ErrInvalidCalendarToken,
+	string(response.UNAUTHORIZED):                  response.ErrUnauthorized,
+	string(response.FORBIDDEN):                     response.ErrForbidden,
+}
+
+// Unwrap возвращает типизированную ошибку из деталей ответа или sentinel по коду.
+// Для неизвестного кода — nil.
+func (e *Error) Unwrap() error {
+	switch response.ErrCode(e.Code) {
+	case response.BOOKING_OVERLAP:
+		if id := e.detailString("conflicting_booking_id"); id != "" {
+			return &response.BookingOverlapError{BookingID: id}
+		}
+	case response.ATTENDANCE_EXISTS:
+		if id := e.detailString("attendance_id"); id != "" {
+			return &response.AttendanceExistsError{AttendanceID: id}
+		}
+	case response.STUDENT_RESTRICTED:
+		if id := e.detailString("restriction_id"); id != "" {
+			until, _ := time.Parse(time.RFC3339, e.detailString("until"))
+			return &response.StudentRestrictedError{RestrictionID: id, Until: until}
+		}
+	case response.BOOKING_RULE_VIOLATION:
+		if rule := e.detailString("rule"); rule != "" {
+			limit, _ := e.Details["limit"].(float64)
+			return &response.RuleViolationError{Rule: rule, Limit: int(limit), Message: e.Message}
+		}
+	}
+
+	return codeErrors[e.Code]
+}
+
+// Alternatives — свободные слоты, предложенные сервером при SLOT_NOT_AVAILABLE
+func (e *Error) Alternatives() []api.SlotResponse {
+	var out []api.SlotResponse
+	_ = e.decodeDetail("alternatives", &out)
+	return out
+}
+
+// FieldErrors — ошибки полей при VALIDATION_FAILED
+func (e *Error) FieldErrors() []FieldError {
+	var out []FieldError
+	_ = e.decodeDetail("fields", &out)
+	return out
+}
+
+// FieldError — несоответствие запроса спецификации API (pkg/middleware/openapi)
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
+
+func (e *Error) detailString(key string) string {
+	v, _ := e.Details[key].(string)
+	return v
+}
+
+func (e *Error) decodeDetail(key string, out any) error {
+	v, ok := e.Details[key]
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// decodeError собирает *Error из ответа; тело, не похожее на ответ API
+// (например, от прокси), остаётся только в статусе
+func decodeError(resp *http.Response, data []byte, requestID string) *Error {
+	apiErr := &Error{
+		StatusCode: resp.StatusCode,
+		RequestID:  requestID,
+	}
+
+	var body response.Response
+	if err := json.Unmarshal(data, &body); err == nil {
+		apiErr.ResponseError = body.ResponseError
+	}
+	if apiErr.Code == "" && resp.StatusCode == http.StatusNotFound {
+		apiErr.Code = string(response.NOT_FOUND)
+	}
+
+	return apiErr
+}
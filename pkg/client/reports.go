@@ -0,0 +1,50 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AttendanceReportFilter — параметры GET /reports/attendance; пустые поля —
+// значения сервера по умолчанию (группировка по студентам за последние 30 дней)
+type AttendanceReportFilter struct {
+	GroupBy   string
+	TeacherID string
+	StudentID string
+	From      *time.Time
+	To        *time.Time
+}
+
+func (f AttendanceReportFilter) query() url.Values {
+	query := url.Values{}
+	if f.GroupBy != "" {
+		query.Set("group_by", f.GroupBy)
+	}
+	if f.TeacherID != "" {
+		query.Set("teacher_id", f.TeacherID)
+	}
+	if f.StudentID != "" {
+		query.Set("student_id", f.StudentID)
+	}
+	if f.From != nil {
+		query.Set("from", formatTime(*f.From))
+	}
+	if f.To != nil {
+		query.Set("to", formatTime(*f.To))
+	}
+	return query
+}
+
+// AttendanceReport — GET /reports/attendance
+func (c *Client) AttendanceReport(ctx context.Context, filter AttendanceReportFilter) (*api.AttendanceReportResponse, error) {
+	var resp struct {
+		Report api.AttendanceReportResponse `json:"report"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/reports/attendance", query: filter.query()}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Report, nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ListRestrictions — GET /admin/restrictions; пустой studentID — все студенты,
+// activeOnly — только действующие ограничения
+func (c *Client) ListRestrictions(ctx context.Context, studentID string, activeOnly bool) ([]api.StudentRestrictionResponse, error) {
+	query := url.Values{}
+	if studentID != "" {
+		query.Set("student_id", studentID)
+	}
+	if activeOnly {
+		query.Set("active", "true")
+	}
+
+	var resp struct {
+		Restrictions []api.StudentRestrictionResponse `json:"restrictions"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/admin/restrictions", query: query}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Restrictions, nil
+}
+
+// GetRestriction — GET /admin/restrictions/{id}
+func (c *Client) GetRestriction(ctx context.Context, id string) (*api.StudentRestrictionResponse, error) {
+	var resp struct {
+		Restriction api.StudentRestrictionResponse `json:"restriction"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/admin/restrictions/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Restriction, nil
+}
+
+// LiftRestriction — POST /admin/restrictions/{id}/lift: досрочно снимает
+// ограничение; снявший берётся из X-Actor-ID
+func (c *Client) LiftRestriction(ctx context.Context, id string) (*api.StudentRestrictionResponse, error) {
+	var resp struct {
+		Restriction api.StudentRestrictionResponse `json:"restriction"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/admin/restrictions/" + pathEscape(id) + "/lift"}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Restriction, nil
+}
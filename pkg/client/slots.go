@@ -0,0 +1,53 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetSlot — GET /slots/{id}
+func (c *Client) GetSlot(ctx context.Context, id string) (*api.SlotResponse, error) {
+	var resp struct {
+		Slot api.SlotResponse `json:"slot"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/slots/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Slot, nil
+}
+
+// BatchGetSlots — GET /slots/batch; ненайденные слоты пропускаются
+func (c *Client) BatchGetSlots(ctx context.Context, ids []string) ([]api.SlotResponse, error) {
+	var resp struct {
+		Slots []api.SlotResponse `json:"slots"`
+	}
+	query := url.Values{"ids": {strings.Join(ids, ",")}}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/slots/batch", query: query}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Slots, nil
+}
+
+// GenerateSlots — POST /slots/generate: ставит генерацию слотов в очередь и
+// возвращает идентификатор задачи
+func (c *Client) GenerateSlots(ctx context.Context, req api.SlotGenerateRequest) (string, error) {
+	var resp api.SlotGenerateResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/slots/generate", body: req}, &resp); err != nil {
+		return "", err
+	}
+	return resp.JobID, nil
+}
+
+// GetCheckInCode — GET /slots/{id}/check-in-code
+func (c *Client) GetCheckInCode(ctx context.Context, slotID string) (*api.CheckInCodeResponse, error) {
+	var resp struct {
+		CheckIn api.CheckInCodeResponse `json:"check_in"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/slots/" + pathEscape(slotID) + "/check-in-code"}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.CheckIn, nil
+}
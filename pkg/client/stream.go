@@ -0,0 +1,156 @@
+package client
+
+import (
+	"rasp-service/api"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Типы событий ленты слотов
+const (
+	SlotEventStatusChanged = "slot.status_changed"
+	SlotEventGenerated     = "slot.generated"
+	// SlotEventReset — история ленты не покрывает переданный Last-Event-ID:
+	// состояние слотов нужно перечитать целиком
+	SlotEventReset = "reset"
+)
+
+// SlotEvent — событие GET /slots/stream
+type SlotEvent struct {
+	ID   string
+	Type string
+	Data json.RawMessage
+}
+
+// StatusChanged декодирует data события slot.status_changed
+func (e SlotEvent) StatusChanged() (*api.SlotEventData, error) {
+	var data api.SlotEventData
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, fmt.Errorf("client: decode %s event: %w", e.Type, err)
+	}
+	return &data, nil
+}
+
+// Generated декодирует data события slot.generated
+func (e SlotEvent) Generated() (*api.SlotGeneratedEventData, error) {
+	var data api.SlotGeneratedEventData
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, fmt.Errorf("client: decode %s event: %w", e.Type, err)
+	}
+	return &data, nil
+}
+
+// StreamSlots — GET /slots/stream: подписывается на изменения слотов преподавателя и
+// вызывает fn для каждого события до отмены ctx, закрытия потока сервером или
+// ошибки fn. lastEventID продолжает поток после уже полученного события.
+// Поток не переподключается сам: для продолжения вызовите StreamSlots снова с ID
+// последнего события. Timeout http.Client на поток не действует.
+func (c *Client) StreamSlots(ctx context.Context, teacherID, lastEventID string, fn func(SlotEvent) error) error {
+	u := *c.baseURL
+	u.Path = c.baseURL.Path + "/slots/stream"
+	u.RawQuery = url.Values{"teacher_id": {teacherID}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("client: GET /slots/stream: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("User-Agent", userAgent)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	if actor := c.actorFor(ctx); actor != "" {
+		req.Header.Set(ActorHeader, actor)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.apiKey != "" {
+		req.Header.Set(APIKeyHeader, c.apiKey)
+	}
+	if c.tenant != "" {
+		req.Header.Set(TenantHeader, c.tenant)
+	}
+	if id, ok := ctx.Value(requestIDCtx).(string); ok && id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
+
+	// поток живёт дольше таймаута обычных запросов
+	hc := c.http
+	if hc.Timeout != 0 {
+		streamClient := *hc
+		streamClient.Timeout = 0
+		hc = &streamClient
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("client: GET /slots/stream: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		requestID, _ := ctx.Value(requestIDCtx).(string)
+		return decodeError(resp, data, requestID)
+	}
+
+	err = readEvents(resp.Body, fn)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// readEvents разбирает text/event-stream: поля id, event и data, события
+// разделены пустой строкой, строки-комментарии (heartbeat) пропускаются
+func readEvents(r io.Reader, fn func(SlotEvent) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var ev SlotEvent
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if ev.Type != "" || len(data) > 0 {
+				if ev.Type == "" {
+					ev.Type = "message"
+				}
+				ev.Data = json.RawMessage(strings.Join(data, "\n"))
+				if err := fn(ev); err != nil {
+					return err
+				}
+			}
+			ev, data = SlotEvent{}, nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			ev.ID = value
+		case "event":
+			ev.Type = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("client: read slot stream: %w", err)
+	}
+	return nil
+}
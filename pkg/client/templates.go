@@ -0,0 +1,45 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+)
+
+// CreateAvailabilityTemplate — POST /availability_templates
+func (c *Client) CreateAvailabilityTemplate(ctx context.Context, req api.AvailabilityTemplateRequest) (*api.AvailabilityTemplateResponse, error) {
+	var resp struct {
+		Template api.AvailabilityTemplateResponse `json:"template"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/availability_templates", body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Template, nil
+}
+
+// GetAvailabilityTemplate — GET /availability_templates/{id}
+func (c *Client) GetAvailabilityTemplate(ctx context.Context, id string) (*api.AvailabilityTemplateResponse, error) {
+	var resp struct {
+		Template api.AvailabilityTemplateResponse `json:"template"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/availability_templates/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Template, nil
+}
+
+// UpdateAvailabilityTemplate — PUT /availability_templates/{id}
+func (c *Client) UpdateAvailabilityTemplate(ctx context.Context, id string, req api.AvailabilityTemplateRequest) (*api.AvailabilityTemplateResponse, error) {
+	var resp struct {
+		Template api.AvailabilityTemplateResponse `json:"template"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPut, path: "/availability_templates/" + pathEscape(id), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Template, nil
+}
+
+// DeleteAvailabilityTemplate — DELETE /availability_templates/{id}
+func (c *Client) DeleteAvailabilityTemplate(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/availability_templates/" + pathEscape(id)}, nil)
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CreateTimeBlock — POST /time_blocks
+func (c *Client) CreateTimeBlock(ctx context.Context, req api.TimeBlockRequest) (*api.TimeBlockResponse, error) {
+	var resp struct {
+		TimeBlock api.TimeBlockResponse `json:"time_block"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/time_blocks", body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.TimeBlock, nil
+}
+
+// GetTimeBlock — GET /time_blocks/{id}
+func (c *Client) GetTimeBlock(ctx context.Context, id string) (*api.TimeBlockResponse, error) {
+	var resp struct {
+		TimeBlock api.TimeBlockResponse `json:"time_block"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/time_blocks/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.TimeBlock, nil
+}
+
+// UpdateTimeBlock — PUT /time_blocks/{id}
+func (c *Client) UpdateTimeBlock(ctx context.Context, id string, req api.TimeBlockRequest) (*api.TimeBlockResponse, error) {
+	var resp struct {
+		TimeBlock api.TimeBlockResponse `json:"time_block"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPut, path: "/time_blocks/" + pathEscape(id), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.TimeBlock, nil
+}
+
+// DeleteTimeBlock — DELETE /time_blocks/{id}
+func (c *Client) DeleteTimeBlock(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/time_blocks/" + pathEscape(id)}, nil)
+}
+
+// ImportTimeBlocks — POST /time_blocks/import: синхронизирует блокировки
+// преподавателя с iCalendar-выгрузкой внешнего календаря. tz — часовой пояс
+// для времён без TZID; пустой — UTC.
+func (c *Client) ImportTimeBlocks(ctx context.Context, teacherID, tz string, ics []byte) (*api.TimeBlockImportResponse, error) {
+	query := url.Values{"teacher_id": {teacherID}}
+	if tz != "" {
+		query.Set("tz", tz)
+	}
+
+	var resp struct {
+		Import api.TimeBlockImportResponse `json:"import"`
+	}
+	req := request{
+		method:      http.MethodPost,
+		path:        "/time_blocks/import",
+		query:       query,
+		rawBody:     ics,
+		contentType: "text/calendar",
+	}
+	if err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Import, nil
+}
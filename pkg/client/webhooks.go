@@ -0,0 +1,90 @@
+package client
+
+import (
+	"rasp-service/api"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateWebhook — POST /webhooks; секрет подписи возвращается только здесь
+func (c *Client) CreateWebhook(ctx context.Context, req api.WebhookSubscriptionRequest) (*api.WebhookSubscriptionResponse, error) {
+	var resp struct {
+		Webhook api.WebhookSubscriptionResponse `json:"webhook"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/webhooks", body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// GetWebhook — GET /webhooks/{id}
+func (c *Client) GetWebhook(ctx context.Context, id string) (*api.WebhookSubscriptionResponse, error) {
+	var resp struct {
+		Webhook api.WebhookSubscriptionResponse `json:"webhook"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/webhooks/" + pathEscape(id)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// ListWebhooks — GET /webhooks
+func (c *Client) ListWebhooks(ctx context.Context) ([]api.WebhookSubscriptionResponse, error) {
+	var resp struct {
+		Webhooks []api.WebhookSubscriptionResponse `json:"webhooks"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/webhooks"}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Webhooks, nil
+}
+
+// UpdateWebhook — PUT /webhooks/{id}
+func (c *Client) UpdateWebhook(ctx context.Context, id string, req api.WebhookSubscriptionRequest) (*api.WebhookSubscriptionResponse, error) {
+	var resp struct {
+		Webhook api.WebhookSubscriptionResponse `json:"webhook"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPut, path: "/webhooks/" + pathEscape(id), body: req}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// DeleteWebhook — DELETE /webhooks/{id}
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/webhooks/" + pathEscape(id)}, nil)
+}
+
+// ListWebhookDeliveries — GET /webhooks/{id}/deliveries; пустой status — все
+// доставки, limit = 0 — ограничение сервера по умолчанию
+func (c *Client) ListWebhookDeliveries(ctx context.Context, webhookID, status string, limit int) ([]api.WebhookDeliveryResponse, error) {
+	query := url.Values{}
+	if status != "" {
+		query.Set("status", status)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var resp struct {
+		Deliveries []api.WebhookDeliveryResponse `json:"deliveries"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/webhooks/" + pathEscape(webhookID) + "/deliveries", query: query}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Deliveries, nil
+}
+
+// ReplayWebhookDelivery — POST /webhooks/deliveries/{id}/replay: ставит доставку
+// в очередь повторно
+func (c *Client) ReplayWebhookDelivery(ctx context.Context, deliveryID string) (*api.WebhookDeliveryResponse, error) {
+	var resp struct {
+		Delivery api.WebhookDeliveryResponse `json:"delivery"`
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/webhooks/deliveries/" + pathEscape(deliveryID) + "/replay"}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Delivery, nil
+}
@@ -0,0 +1,157 @@
+// Package ical — минимальная запись календарей iCalendar (RFC 5545): VCALENDAR с VEVENT.
+package ical
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	// maxLineOctets — предел длины строки контента без CRLF (RFC 5545, 3.1)
+	maxLineOctets = 75
+	utcLayout     = "20060102T150405Z"
+)
+
+type Status string
+
+const (
+	StatusTentative Status = "TENTATIVE"
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+type Transparency string
+
+const (
+	Opaque      Transparency = "OPAQUE"
+	Transparent Transparency = "TRANSPARENT"
+)
+
+type Event struct {
+	// UID должен быть стабильным между выгрузками, иначе клиент создаст дубликат
+	UID          string
+	Sequence     int
+	Stamp        time.Time
+	LastModified time.Time
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Status       Status
+	Transparency Transparency
+	Categories   []string
+
+	// Поля ниже заполняет только Parse; Encode их не пишет
+	AllDay       bool
+	Rule         *Recurrence
+	ExDates      []time.Time
+	RecurrenceID time.Time
+}
+
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+// Encode пишет календарь в w; все времена выводятся в UTC
+func (c *Calendar) Encode(w io.Writer) error {
+	e := &encoder{w: w}
+
+	e.line("BEGIN:VCALENDAR")
+	e.line("VERSION:2.0")
+	e.line("PRODID:" + escape(c.ProdID))
+	e.line("CALSCALE:GREGORIAN")
+	e.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME:" + escape(c.Name))
+	}
+
+	for _, ev := range c.Events {
+		e.line("BEGIN:VEVENT")
+		e.line("UID:" + escape(ev.UID))
+		stamp := ev.Stamp
+		if stamp.IsZero() {
+			stamp = time.Now()
+		}
+		e.line("DTSTAMP:" + formatTime(stamp))
+		e.line("DTSTART:" + formatTime(ev.Start))
+		e.line("DTEND:" + formatTime(ev.End))
+		e.line("SEQUENCE:" + strconv.Itoa(ev.Sequence))
+		if !ev.LastModified.IsZero() {
+			e.line("LAST-MODIFIED:" + formatTime(ev.LastModified))
+		}
+		e.line("SUMMARY:" + escape(ev.Summary))
+		if ev.Description != "" {
+			e.line("DESCRIPTION:" + escape(ev.Description))
+		}
+		if ev.Status != "" {
+			e.line("STATUS:" + string(ev.Status))
+		}
+		if ev.Transparency != "" {
+			e.line("TRANSP:" + string(ev.Transparency))
+		}
+		if len(ev.Categories) > 0 {
+			escaped := make([]string, len(ev.Categories))
+			for i, category := range ev.Categories {
+				escaped[i] = escape(category)
+			}
+			e.line("CATEGORIES:" + strings.Join(escaped, ","))
+		}
+		e.line("END:VEVENT")
+	}
+
+	e.line("END:VCALENDAR")
+
+	return e.err
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+// line пишет строку контента, сворачивая её по 75 октетов без разрыва UTF-8 символов
+func (e *encoder) line(s string) {
+	if e.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		n := len(string(r))
+		if width+n > maxLineOctets {
+			b.WriteString("\r\n ")
+			// пробел продолжения входит в длину следующей строки
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	b.WriteString("\r\n")
+
+	_, e.err = io.WriteString(e.w, b.String())
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(utcLayout)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escape экранирует значение типа TEXT (RFC 5545, 3.3.11)
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
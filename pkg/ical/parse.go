@@ -0,0 +1,333 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var ErrNotCalendar = errors.New("ical: payload is not a VCALENDAR")
+
+// InvalidEvent — VEVENT, который не удалось разобрать; остальные события календаря при этом читаются
+type InvalidEvent struct {
+	UID string
+	Err error
+}
+
+// Parse читает VEVENT из календаря. Времена без TZID и даты без времени
+// трактуются в loc; неизвестный TZID тоже заменяется на loc.
+func Parse(r io.Reader, loc *time.Location) (*Calendar, []InvalidEvent, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cal := &Calendar{}
+	var invalid []InvalidEvent
+	var props []property
+	inCalendar, inEvent, depth := false, false, 0
+
+	for _, raw := range lines {
+		if raw == "" {
+			continue
+		}
+		p, err := parseProperty(raw)
+		if err != nil {
+			if inEvent {
+				props = append(props, property{name: "X-INVALID", value: err.Error()})
+			}
+			continue
+		}
+
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VCALENDAR"):
+			inCalendar = true
+		case !inCalendar:
+			continue
+		case p.name == "BEGIN" && inEvent:
+			// вложенные компоненты (VALARM) пропускаем целиком
+			depth++
+		case p.name == "END" && inEvent && depth > 0:
+			depth--
+		case depth > 0:
+			continue
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			inEvent, props = true, nil
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT") && inEvent:
+			inEvent = false
+			ev, err := buildEvent(props, loc)
+			if err != nil {
+				invalid = append(invalid, InvalidEvent{UID: ev.UID, Err: err})
+				continue
+			}
+			cal.Events = append(cal.Events, ev)
+		case inEvent:
+			props = append(props, p)
+		case p.name == "PRODID":
+			cal.ProdID = p.value
+		case p.name == "X-WR-CALNAME":
+			cal.Name = unescape(p.value)
+		}
+	}
+
+	if !inCalendar {
+		return nil, nil, ErrNotCalendar
+	}
+
+	return cal, invalid, nil
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// unfold склеивает свёрнутые строки (RFC 5545, 3.1)
+func unfold(r io.Reader) ([]string, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("ical: %w", err)
+	}
+
+	return lines, nil
+}
+
+// parseProperty разбирает NAME;PARAM=VALUE:value с учётом кавычек в параметрах
+func parseProperty(line string) (property, error) {
+	inQuotes := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		}
+		if r == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return property{}, fmt.Errorf("ical: malformed content line %q", line)
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := splitQuoted(head, ';')
+
+	p := property{name: strings.ToUpper(parts[0]), value: value, params: map[string]string{}}
+	for _, param := range parts[1:] {
+		k, v, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return p, nil
+}
+
+func splitQuoted(s string, sep rune) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == sep && !inQuotes:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func buildEvent(props []property, loc *time.Location) (Event, error) {
+	var ev Event
+	var dtend time.Time
+	var duration time.Duration
+	hasEnd, hasDuration := false, false
+
+	// UID нужен и для сообщения об ошибке, поэтому берём его первым
+	for _, p := range props {
+		if p.name == "UID" {
+			ev.UID = p.value
+		}
+	}
+
+	for _, p := range props {
+		var err error
+		switch p.name {
+		case "X-INVALID":
+			err = errors.New(p.value)
+		case "DTSTART":
+			ev.Start, ev.AllDay, err = parseDateTime(p, loc)
+		case "DTEND":
+			dtend, _, err = parseDateTime(p, loc)
+			hasEnd = true
+		case "DURATION":
+			duration, err = parseDuration(p.value)
+			hasDuration = true
+		case "RECURRENCE-ID":
+			ev.RecurrenceID, _, err = parseDateTime(p, loc)
+		case "RRULE":
+			ev.Rule, err = ParseRecurrence(p.value, loc)
+		case "EXDATE":
+			for _, v := range strings.Split(p.value, ",") {
+				var t time.Time
+				t, _, err = parseDateTime(property{name: p.name, params: p.params, value: v}, loc)
+				if err != nil {
+					break
+				}
+				ev.ExDates = append(ev.ExDates, t)
+			}
+		case "SUMMARY":
+			ev.Summary = unescape(p.value)
+		case "DESCRIPTION":
+			ev.Description = unescape(p.value)
+		case "STATUS":
+			ev.Status = Status(strings.ToUpper(p.value))
+		case "TRANSP":
+			ev.Transparency = Transparency(strings.ToUpper(p.value))
+		case "SEQUENCE":
+			_, err = fmt.Sscanf(p.value, "%d", &ev.Sequence)
+		}
+		if err != nil {
+			return ev, fmt.Errorf("%s: %w", p.name, err)
+		}
+	}
+
+	if ev.UID == "" {
+		return ev, errors.New("UID is required")
+	}
+	if ev.Start.IsZero() {
+		return ev, errors.New("DTSTART is required")
+	}
+
+	switch {
+	case hasEnd:
+		ev.End = dtend
+	case hasDuration:
+		ev.End = ev.Start.Add(duration)
+	case ev.AllDay:
+		ev.End = ev.Start.AddDate(0, 0, 1)
+	default:
+		ev.End = ev.Start
+	}
+
+	if ev.End.Before(ev.Start) {
+		return ev, errors.New("DTEND is before DTSTART")
+	}
+
+	return ev, nil
+}
+
+const (
+	dateLayout  = "20060102"
+	localLayout = "20060102T150405"
+)
+
+// parseDateTime понимает UTC (…Z), локальное время с TZID, плавающее время и VALUE=DATE
+func parseDateTime(p property, loc *time.Location) (time.Time, bool, error) {
+	value := strings.TrimSpace(p.value)
+
+	if p.params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, loc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcLayout, value)
+		return t, false, err
+	}
+
+	zone := loc
+	if tzid := p.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			zone = l
+		}
+	}
+
+	t, err := time.ParseInLocation(localLayout, value, zone)
+	return t, false, err
+}
+
+// parseDuration разбирает длительность вида [+-]P[nW][nD][T[nH][nM][nS]]
+func parseDuration(s string) (time.Duration, error) {
+	orig := s
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+	}
+	s = strings.TrimLeft(s, "+-")
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+	s = s[1:]
+
+	var total time.Duration
+	inTime := false
+	num := 0
+	digits := false
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			num = num*10 + int(r-'0')
+			digits = true
+			continue
+		case r == 'T':
+			inTime = true
+			continue
+		}
+
+		if !digits {
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		n := time.Duration(num)
+		switch {
+		case r == 'W' && !inTime:
+			total += n * 7 * 24 * time.Hour
+		case r == 'D' && !inTime:
+			total += n * 24 * time.Hour
+		case r == 'H' && inTime:
+			total += n * time.Hour
+		case r == 'M' && inTime:
+			total += n * time.Minute
+		case r == 'S' && inTime:
+			total += n * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		num, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+
+	return sign * total, nil
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, `;`,
+	`\,`, `,`,
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+func unescape(s string) string {
+	return textUnescaper.Replace(s)
+}
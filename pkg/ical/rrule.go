@@ -0,0 +1,337 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// ErrTooManyOccurrences — правило раскрывается в больше вхождений, чем разрешено
+var ErrTooManyOccurrences = errors.New("ical: too many occurrences")
+
+// WeekdayNum — элемент BYDAY: день недели и, для MONTHLY, его номер в месяце (-1 — последний)
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Recurrence — поддерживаемое подмножество RRULE: FREQ, INTERVAL, COUNT, UNTIL,
+// BYDAY (с номером только для MONTHLY) и BYMONTHDAY для MONTHLY
+type Recurrence struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+func ParseRecurrence(value string, loc *time.Location) (*Recurrence, error) {
+	rule := &Recurrence{Interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed RRULE part %q", part)
+		}
+
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(v))
+		case "INTERVAL":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", v)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", v)
+			}
+			rule.Count = n
+		case "UNTIL":
+			t, allDay, err := parseDateTime(property{value: v, params: map[string]string{}}, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid UNTIL %q", v)
+			}
+			if allDay {
+				// дата без времени включает весь день
+				t = t.AddDate(0, 0, 1).Add(-time.Second)
+			}
+			rule.Until = t
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				wd, err := parseWeekdayNum(d)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(v, ",") {
+				n, err := strconv.Atoi(d)
+				if err != nil || n < 1 || n > 31 {
+					return nil, fmt.Errorf("unsupported BYMONTHDAY %q", d)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "WKST":
+			// недели считаются с понедельника, как по умолчанию в RFC 5545
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %s", k)
+		}
+	}
+
+	switch rule.Freq {
+	case Daily, Weekly, Monthly, Yearly:
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %q", rule.Freq)
+	}
+
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return nil, errors.New("COUNT and UNTIL are mutually exclusive")
+	}
+
+	for _, wd := range rule.ByDay {
+		if wd.N != 0 && rule.Freq != Monthly {
+			return nil, fmt.Errorf("numbered BYDAY is only supported for MONTHLY")
+		}
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq != Monthly {
+		return nil, fmt.Errorf("BYMONTHDAY is only supported for MONTHLY")
+	}
+	if len(rule.ByMonthDay) > 0 && len(rule.ByDay) > 0 {
+		return nil, fmt.Errorf("BYDAY together with BYMONTHDAY is not supported")
+	}
+
+	return rule, nil
+}
+
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", s)
+	}
+
+	wd, ok := weekdays[s[len(s)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", s)
+	}
+
+	n := 0
+	if prefix := s[:len(s)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", s)
+		}
+	}
+
+	return WeekdayNum{Weekday: wd, N: n}, nil
+}
+
+// Occurrences возвращает начала вхождений события, пересекающих [from, to), с учётом
+// COUNT, UNTIL и EXDATE. Для события без RRULE — только его DTSTART.
+// Если вхождений в окне больше limit, возвращается ErrTooManyOccurrences.
+func (ev *Event) Occurrences(from, to time.Time, limit int) ([]time.Time, error) {
+	duration := ev.End.Sub(ev.Start)
+	inWindow := func(t time.Time) bool {
+		return t.Before(to) && t.Add(duration).After(from)
+	}
+
+	if ev.Rule == nil {
+		if inWindow(ev.Start) {
+			return []time.Time{ev.Start}, nil
+		}
+		return nil, nil
+	}
+
+	excluded := make(map[int64]struct{}, len(ev.ExDates))
+	for _, t := range ev.ExDates {
+		excluded[t.Unix()] = struct{}{}
+	}
+
+	rule := ev.Rule
+	var result []time.Time
+	generated := 0
+
+	// периоды перебираются по порядку; внутри периода кандидаты отсортированы
+	for period := 0; ; period++ {
+		candidates := rule.periodCandidates(ev.Start, period)
+		if candidates == nil {
+			break
+		}
+
+		for _, t := range candidates {
+			if t.Before(ev.Start) {
+				continue
+			}
+			if !rule.Until.IsZero() && t.After(rule.Until) {
+				return result, nil
+			}
+			if rule.Count > 0 && generated >= rule.Count {
+				return result, nil
+			}
+			if !t.Before(to) {
+				return result, nil
+			}
+
+			generated++
+			if _, skip := excluded[t.Unix()]; skip {
+				continue
+			}
+			if inWindow(t) {
+				if len(result) >= limit {
+					return nil, ErrTooManyOccurrences
+				}
+				result = append(result, t)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// periodCandidates — кандидаты period-го периода правила (дня, недели, месяца, года)
+// во времени суток DTSTART. nil означает, что период вышел за разумные пределы.
+func (r *Recurrence) periodCandidates(start time.Time, period int) []time.Time {
+	const maxYears = 200
+
+	step := period * r.Interval
+	loc := start.Location()
+	h, m, s := start.Clock()
+	at := func(y int, mon time.Month, d int) time.Time {
+		return time.Date(y, mon, d, h, m, s, 0, loc)
+	}
+
+	switch r.Freq {
+	case Daily:
+		t := at(start.Year(), start.Month(), start.Day()+step)
+		if t.Year()-start.Year() > maxYears {
+			return nil
+		}
+		if len(r.ByDay) > 0 && !r.hasWeekday(t.Weekday()) {
+			return []time.Time{}
+		}
+		return []time.Time{t}
+
+	case Weekly:
+		// неделя начинается с понедельника
+		offset := (int(start.Weekday()) + 6) % 7
+		monday := at(start.Year(), start.Month(), start.Day()-offset+7*step)
+		if monday.Year()-start.Year() > maxYears {
+			return nil
+		}
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []WeekdayNum{{Weekday: start.Weekday()}}
+		}
+		out := make([]time.Time, 0, len(days))
+		for _, wd := range days {
+			out = append(out, at(monday.Year(), monday.Month(), monday.Day()+(int(wd.Weekday)+6)%7))
+		}
+		sortTimes(out)
+		return out
+
+	case Monthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(step), 1, 0, 0, 0, 0, loc)
+		if first.Year()-start.Year() > maxYears {
+			return nil
+		}
+		y, mon := first.Year(), first.Month()
+		last := daysIn(y, mon, loc)
+
+		out := []time.Time{}
+		switch {
+		case len(r.ByDay) > 0:
+			for _, wd := range r.ByDay {
+				for _, d := range monthWeekdays(y, mon, wd, loc) {
+					out = append(out, at(y, mon, d))
+				}
+			}
+		case len(r.ByMonthDay) > 0:
+			for _, d := range r.ByMonthDay {
+				if d <= last {
+					out = append(out, at(y, mon, d))
+				}
+			}
+		default:
+			// 31-е число в коротких месяцах пропускается (RFC 5545, 3.3.10)
+			if start.Day() <= last {
+				out = append(out, at(y, mon, start.Day()))
+			}
+		}
+		sortTimes(out)
+		return out
+
+	case Yearly:
+		y := start.Year() + step
+		if y-start.Year() > maxYears {
+			return nil
+		}
+		if start.Day() > daysIn(y, start.Month(), loc) {
+			return []time.Time{}
+		}
+		return []time.Time{at(y, start.Month(), start.Day())}
+	}
+
+	return nil
+}
+
+func (r *Recurrence) hasWeekday(wd time.Weekday) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// monthWeekdays — числа месяца, подходящие под BYDAY (все такие дни или N-й / N-й с конца)
+func monthWeekdays(y int, mon time.Month, wd WeekdayNum, loc *time.Location) []int {
+	last := daysIn(y, mon, loc)
+	firstWd := time.Date(y, mon, 1, 0, 0, 0, 0, loc).Weekday()
+	firstDay := 1 + (int(wd.Weekday)-int(firstWd)+7)%7
+
+	var days []int
+	for d := firstDay; d <= last; d += 7 {
+		days = append(days, d)
+	}
+
+	switch {
+	case wd.N == 0:
+		return days
+	case wd.N > 0 && wd.N <= len(days):
+		return []int{days[wd.N-1]}
+	case wd.N < 0 && -wd.N <= len(days):
+		return []int{days[len(days)+wd.N]}
+	default:
+		return nil
+	}
+}
+
+func daysIn(y int, mon time.Month, loc *time.Location) int {
+	return time.Date(y, mon+1, 0, 0, 0, 0, 0, loc).Day()
+}
+
+func sortTimes(ts []time.Time) {
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Before(ts[j]) })
+}
@@ -0,0 +1,212 @@
+package ical
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func at(t *testing.T, s string) time.Time {
+	t.Helper()
+	v, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		t.Fatalf("parse %q: %v", s, err)
+	}
+	return v
+}
+
+func TestOccurrences(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   string // по умолчанию 2024-01-01 10:00, понедельник
+		rrule   string
+		exdates []string
+		from    string // окно по умолчанию — январь и февраль 2024
+		to      string
+		limit   int
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "single event in window",
+			want: []string{"2024-01-01 10:00"},
+		},
+		{
+			name: "single event outside window",
+			from: "2024-02-01 00:00",
+			want: nil,
+		},
+		{
+			name:  "daily count",
+			rrule: "FREQ=DAILY;COUNT=3",
+			want:  []string{"2024-01-01 10:00", "2024-01-02 10:00", "2024-01-03 10:00"},
+		},
+		{
+			name:  "daily interval",
+			rrule: "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			want:  []string{"2024-01-01 10:00", "2024-01-03 10:00", "2024-01-05 10:00"},
+		},
+		{
+			name:  "daily byday",
+			rrule: "FREQ=DAILY;BYDAY=SA,SU;COUNT=3",
+			want:  []string{"2024-01-06 10:00", "2024-01-07 10:00", "2024-01-13 10:00"},
+		},
+		{
+			name:  "weekly byday",
+			rrule: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4",
+			want:  []string{"2024-01-01 10:00", "2024-01-03 10:00", "2024-01-08 10:00", "2024-01-10 10:00"},
+		},
+		{
+			// BYDAY раньше DTSTART в первой неделе не даёт вхождений до начала серии
+			name:  "weekly byday before dtstart",
+			start: "2024-01-03 10:00",
+			rrule: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3",
+			want:  []string{"2024-01-03 10:00", "2024-01-08 10:00", "2024-01-10 10:00"},
+		},
+		{
+			name:  "weekly until is inclusive",
+			rrule: "FREQ=WEEKLY;UNTIL=20240115T100000Z",
+			want:  []string{"2024-01-01 10:00", "2024-01-08 10:00", "2024-01-15 10:00"},
+		},
+		{
+			name:  "until as date covers the whole day",
+			rrule: "FREQ=WEEKLY;UNTIL=20240115",
+			want:  []string{"2024-01-01 10:00", "2024-01-08 10:00", "2024-01-15 10:00"},
+		},
+		{
+			name:  "monthly bymonthday",
+			rrule: "FREQ=MONTHLY;BYMONTHDAY=1,15;COUNT=3",
+			want:  []string{"2024-01-01 10:00", "2024-01-15 10:00", "2024-02-01 10:00"},
+		},
+		{
+			name:  "monthly last friday",
+			rrule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=2",
+			want:  []string{"2024-01-26 10:00", "2024-02-23 10:00"},
+		},
+		{
+			name:  "monthly second tuesday",
+			rrule: "FREQ=MONTHLY;BYDAY=2TU;COUNT=2",
+			want:  []string{"2024-01-09 10:00", "2024-02-13 10:00"},
+		},
+		{
+			name:  "monthly on the 31st skips short months",
+			start: "2024-01-31 10:00",
+			rrule: "FREQ=MONTHLY;COUNT=3",
+			to:    "2025-01-01 00:00",
+			want:  []string{"2024-01-31 10:00", "2024-03-31 10:00", "2024-05-31 10:00"},
+		},
+		{
+			name:  "yearly on february 29",
+			start: "2024-02-29 10:00",
+			rrule: "FREQ=YEARLY;COUNT=2",
+			to:    "2030-01-01 00:00",
+			want:  []string{"2024-02-29 10:00", "2028-02-29 10:00"},
+		},
+		{
+			// исключённое вхождение всё равно расходует COUNT
+			name:    "exdate",
+			rrule:   "FREQ=DAILY;COUNT=4",
+			exdates: []string{"2024-01-02 10:00"},
+			want:    []string{"2024-01-01 10:00", "2024-01-03 10:00", "2024-01-04 10:00"},
+		},
+		{
+			name:  "window cuts the series",
+			rrule: "FREQ=DAILY;COUNT=10",
+			from:  "2024-01-05 00:00",
+			to:    "2024-01-07 00:00",
+			want:  []string{"2024-01-05 10:00", "2024-01-06 10:00"},
+		},
+		{
+			name:  "occurrence overlapping window start",
+			rrule: "FREQ=DAILY;COUNT=10",
+			from:  "2024-01-05 10:30",
+			to:    "2024-01-06 00:00",
+			want:  []string{"2024-01-05 10:00"},
+		},
+		{
+			name:  "limit reached exactly",
+			rrule: "FREQ=DAILY",
+			to:    "2024-01-06 00:00",
+			limit: 5,
+			want:  []string{"2024-01-01 10:00", "2024-01-02 10:00", "2024-01-03 10:00", "2024-01-04 10:00", "2024-01-05 10:00"},
+		},
+		{
+			name:    "limit exceeded",
+			rrule:   "FREQ=DAILY",
+			to:      "2024-01-07 00:00",
+			limit:   5,
+			wantErr: ErrTooManyOccurrences,
+		},
+		{
+			// вхождения до окна не расходуют limit
+			name:  "limit counts only the window",
+			rrule: "FREQ=DAILY",
+			from:  "2024-02-01 00:00",
+			to:    "2024-02-03 00:00",
+			limit: 2,
+			want:  []string{"2024-02-01 10:00", "2024-02-02 10:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := at(t, "2024-01-01 10:00")
+			if tt.start != "" {
+				start = at(t, tt.start)
+			}
+			from, to := at(t, "2024-01-01 00:00"), at(t, "2024-03-01 00:00")
+			if tt.from != "" {
+				from = at(t, tt.from)
+			}
+			if tt.to != "" {
+				to = at(t, tt.to)
+			}
+			limit := tt.limit
+			if limit == 0 {
+				limit = 1000
+			}
+
+			ev := &Event{UID: "ev-1", Start: start, End: start.Add(time.Hour)}
+			if tt.rrule != "" {
+				rule, err := ParseRecurrence(tt.rrule, time.UTC)
+				if err != nil {
+					t.Fatalf("ParseRecurrence: %v", err)
+				}
+				ev.Rule = rule
+			}
+			for _, s := range tt.exdates {
+				ev.ExDates = append(ev.ExDates, at(t, s))
+			}
+
+			got, err := ev.Occurrences(from, to, limit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Occurrences() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(at(t, tt.want[i])) {
+					t.Fatalf("Occurrences()[%d] = %v, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRecurrenceRejects(t *testing.T) {
+	for _, rrule := range []string{
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240101T000000Z",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;BYSETPOS=1",
+	} {
+		if _, err := ParseRecurrence(rrule, time.UTC); err == nil {
+			t.Errorf("ParseRecurrence(%q) succeeded, want error", rrule)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Package jwt — проверка JWT (RFC 7519) с подписью HS256 или RS256. Ключи RS256
+// берутся из локального JWKS-файла (RFC 7517).
+package jwt
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+)
+
+var (
+	ErrMalformed      = errors.New("malformed token")
+	ErrUnsupportedAlg = errors.New("unsupported signing algorithm")
+	ErrUnknownKey     = errors.New("unknown signing key")
+	ErrSignature      = errors.New("invalid signature")
+	ErrExpired        = errors.New("token is expired")
+	ErrNoExpiry       = errors.New("token has no expiration time")
+	ErrTooLong        = errors.New("token lifetime exceeds the maximum")
+	ErrNotYetValid    = errors.New("token is not valid yet")
+	ErrIssuer         = errors.New("unexpected issuer")
+	ErrAudience       = errors.New("unexpected audience")
+)
+
+var encoding = base64.RawURLEncoding
+
+// Claims — зарегистрированные поля токена; остальные доступны через Claim
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	IssuedAt  time.Time
+
+	raw map[string]any
+}
+
+// Claim возвращает строковое или списочное поле по имени
+func (c *Claims) Claim(name string) []string {
+	switch v := c.raw[name].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// Options — ключи и ожидаемые значения iss/aud; пустые Issuer и Audience не проверяются.
+// MaxAge ограничивает срок, на который выдан токен (exp − iat, без iat — exp − now); 0 — без ограничения.
+type Options struct {
+	Secret   []byte
+	Keys     map[string]*rsa.PublicKey
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+	MaxAge   time.Duration
+}
+
+// Verifier проверяет подпись и сроки действия токенов
+type Verifier struct {
+	opts Options
+	now  func() time.Time
+}
+
+func NewVerifier(opts Options) (*Verifier, error) {
+	if len(opts.Secret) == 0 && len(opts.Keys) == 0 {
+		return nil, errors.New("jwt.NewVerifier: no secret or keys configured")
+	}
+	return &Verifier{opts: opts, now: time.Now}, nil
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// Verify разбирает компактную форму токена и возвращает его claims
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, err
+	}
+
+	sig, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	if err := v.verifySignature(h, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]any
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, err
+	}
+
+	claims, err := parseClaims(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.validate(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func (v *Verifier) verifySignature(h header, signed string, sig []byte) error {
+	switch h.Alg {
+	case HS256:
+		if len(v.opts.Secret) == 0 {
+			return ErrUnsupportedAlg
+		}
+		mac := hmac.New(sha256.New, v.opts.Secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), sig) {
+			return ErrSignature
+		}
+		return nil
+
+	case RS256:
+		key, err := v.rsaKey(h.Kid)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256([]byte(signed))
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], sig); err != nil {
+			return ErrSignature
+		}
+		return nil
+	}
+
+	// в том числе "none"
+	return ErrUnsupportedAlg
+}
+
+// rsaKey ищет ключ по kid; без kid подходит только единственный ключ набора
+func (v *Verifier) rsaKey(kid string) (*rsa.PublicKey, error) {
+	if kid != "" {
+		if key, ok := v.opts.Keys[kid]; ok {
+			return key, nil
+		}
+		return nil, ErrUnknownKey
+	}
+
+	if len(v.opts.Keys) == 1 {
+		for _, key := range v.opts.Keys {
+			return key, nil
+		}
+	}
+	return nil, ErrUnknownKey
+}
+
+func (v *Verifier) validate(c *Claims) error {
+	now := v.now()
+	leeway := v.opts.Leeway
+
+	// бессрочный токен не отозвать, поэтому exp обязателен
+	if c.ExpiresAt.IsZero() {
+		return ErrNoExpiry
+	}
+	if !now.Before(c.ExpiresAt.Add(leeway)) {
+		return ErrExpired
+	}
+	if v.opts.MaxAge > 0 {
+		issued := c.IssuedAt
+		if issued.IsZero() || issued.After(now) {
+			issued = now
+		}
+		if c.ExpiresAt.Sub(issued) > v.opts.MaxAge+leeway {
+			return ErrTooLong
+		}
+	}
+	if !c.NotBefore.IsZero() && now.Add(leeway).Before(c.NotBefore) {
+		return ErrNotYetValid
+	}
+
+	if v.opts.Issuer != "" && c.Issuer != v.opts.Issuer {
+		return ErrIssuer
+	}
+
+	if v.opts.Audience != "" {
+		for _, aud := range c.Audience {
+			if aud == v.opts.Audience {
+				return nil
+			}
+		}
+		return ErrAudience
+	}
+
+	return nil
+}
+
+func decodeSegment(seg string, out any) error {
+	data, err := encoding.DecodeString(seg)
+	if err != nil {
+		return ErrMalformed
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return ErrMalformed
+	}
+	return nil
+}
+
+func parseClaims(raw map[string]any) (*Claims, error) {
+	c := &Claims{raw: raw}
+
+	var err error
+	if c.Subject, err = stringClaim(raw, "sub"); err != nil {
+		return nil, err
+	}
+	if c.Issuer, err = stringClaim(raw, "iss"); err != nil {
+		return nil, err
+	}
+	if c.ExpiresAt, err = timeClaim(raw, "exp"); err != nil {
+		return nil, err
+	}
+	if c.NotBefore, err = timeClaim(raw, "nbf"); err != nil {
+		return nil, err
+	}
+	if c.IssuedAt, err = timeClaim(raw, "iat"); err != nil {
+		return nil, err
+	}
+
+	// aud — строка или массив строк
+	switch aud := raw["aud"].(type) {
+	case nil:
+	case string:
+		c.Audience = []string{aud}
+	case []any:
+		for _, item := range aud {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: aud", ErrMalformed)
+			}
+			c.Audience = append(c.Audience, s)
+		}
+	default:
+		return nil, fmt.Errorf("%w: aud", ErrMalformed)
+	}
+
+	return c, nil
+}
+
+func stringClaim(raw map[string]any, name string) (string, error) {
+	switch v := raw[name].(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrMalformed, name)
+}
+
+// timeClaim читает NumericDate — секунды Unix, допускаются дробные
+func timeClaim(raw map[string]any, name string) (time.Time, error) {
+	switch v := raw[name].(type) {
+	case nil:
+		return time.Time{}, nil
+	case float64:
+		sec := int64(v)
+		return time.Unix(sec, int64((v-float64(sec))*1e9)), nil
+	}
+	return time.Time{}, fmt.Errorf("%w: %s", ErrMalformed, name)
+}
+
+// SignHS256 подписывает claims секретом — для выпуска служебных токенов (raspctl)
+func SignHS256(claims map[string]any, secret []byte) (string, error) {
+	if len(secret) == 0 {
+		return "", errors.New("jwt.SignHS256: empty secret")
+	}
+
+	h, err := json.Marshal(map[string]string{"alg": HS256, "typ": "JWT"})
+	if err != nil {
+		return "", fmt.Errorf("jwt.SignHS256: %w", err)
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("jwt.SignHS256: %w", err)
+	}
+
+	signed := encoding.EncodeToString(h) + "." + encoding.EncodeToString(c)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+
+	return signed + "." + encoding.EncodeToString(mac.Sum(nil)), nil
+}
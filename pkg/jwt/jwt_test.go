@@ -0,0 +1,284 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	testSecret = []byte("test-secret")
+	testNow    = time.Unix(1_700_000_000, 0)
+)
+
+func mustKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+// encode собирает токен с произвольным заголовком; подпись считает sign
+func encode(t *testing.T, h map[string]string, claims map[string]any, sign func(signed string) []byte) string {
+	t.Helper()
+	hb, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("marshal header: %v", err)
+	}
+	cb, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	signed := encoding.EncodeToString(hb) + "." + encoding.EncodeToString(cb)
+	return signed + "." + encoding.EncodeToString(sign(signed))
+}
+
+func hs256(secret []byte) func(string) []byte {
+	return func(signed string) []byte {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signed))
+		return mac.Sum(nil)
+	}
+}
+
+func rs256(t *testing.T, key *rsa.PrivateKey) func(string) []byte {
+	return func(signed string) []byte {
+		sum := sha256.Sum256([]byte(signed))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return sig
+	}
+}
+
+func verifier(t *testing.T, opts Options) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(opts)
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	v.now = func() time.Time { return testNow }
+	return v
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub": "user-1",
+		"iat": testNow.Add(-time.Minute).Unix(),
+		"exp": testNow.Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerifyRejectsNoneAndConfusedAlgorithms(t *testing.T) {
+	rsaKey := mustKey(t)
+	pub, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+
+	rsaOnly := verifier(t, Options{Keys: map[string]*rsa.PublicKey{"k1": &rsaKey.PublicKey}})
+	hsOnly := verifier(t, Options{Secret: testSecret})
+
+	tests := []struct {
+		name  string
+		v     *Verifier
+		token string
+		want  error
+	}{
+		{
+			name:  "alg none without signature",
+			v:     hsOnly,
+			token: encode(t, map[string]string{"alg": "none"}, validClaims(), func(string) []byte { return nil }),
+			want:  ErrUnsupportedAlg,
+		},
+		{
+			name:  "alg none with rsa keys",
+			v:     rsaOnly,
+			token: encode(t, map[string]string{"alg": "none"}, validClaims(), func(string) []byte { return nil }),
+			want:  ErrUnsupportedAlg,
+		},
+		{
+			// классическая подмена: HS256, подписанный публичным RSA-ключом как секретом
+			name:  "hs256 signed with rsa public key",
+			v:     rsaOnly,
+			token: encode(t, map[string]string{"alg": HS256, "kid": "k1"}, validClaims(), hs256(pub)),
+			want:  ErrUnsupportedAlg,
+		},
+		{
+			name:  "rs256 without configured keys",
+			v:     hsOnly,
+			token: encode(t, map[string]string{"alg": RS256}, validClaims(), rs256(t, rsaKey)),
+			want:  ErrUnknownKey,
+		},
+		{
+			name:  "unknown alg",
+			v:     hsOnly,
+			token: encode(t, map[string]string{"alg": "HS512"}, validClaims(), hs256(testSecret)),
+			want:  ErrUnsupportedAlg,
+		},
+		{
+			name:  "hs256 with wrong secret",
+			v:     hsOnly,
+			token: encode(t, map[string]string{"alg": HS256}, validClaims(), hs256([]byte("other"))),
+			want:  ErrSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.v.Verify(tt.token); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyTimeClaims(t *testing.T) {
+	const leeway = 30 * time.Second
+
+	tests := []struct {
+		name   string
+		claims map[string]any
+		maxAge time.Duration
+		want   error
+	}{
+		{
+			name:   "valid",
+			claims: validClaims(),
+		},
+		{
+			name:   "no exp",
+			claims: map[string]any{"sub": "user-1"},
+			want:   ErrNoExpiry,
+		},
+		{
+			name:   "expired beyond leeway",
+			claims: map[string]any{"exp": testNow.Add(-leeway - time.Second).Unix()},
+			want:   ErrExpired,
+		},
+		{
+			name:   "expired within leeway",
+			claims: map[string]any{"exp": testNow.Add(-leeway + time.Second).Unix()},
+		},
+		{
+			name:   "expires exactly at leeway edge",
+			claims: map[string]any{"exp": testNow.Add(-leeway).Unix()},
+			want:   ErrExpired,
+		},
+		{
+			name: "nbf beyond leeway",
+			claims: map[string]any{
+				"nbf": testNow.Add(leeway + time.Second).Unix(),
+				"exp": testNow.Add(time.Hour).Unix(),
+			},
+			want: ErrNotYetValid,
+		},
+		{
+			name: "nbf within leeway",
+			claims: map[string]any{
+				"nbf": testNow.Add(leeway - time.Second).Unix(),
+				"exp": testNow.Add(time.Hour).Unix(),
+			},
+		},
+		{
+			name:   "exp is not a number",
+			claims: map[string]any{"exp": "tomorrow"},
+			want:   ErrMalformed,
+		},
+		{
+			name:   "lifetime within max age",
+			claims: validClaims(),
+			maxAge: 2 * time.Hour,
+		},
+		{
+			name: "lifetime over max age",
+			claims: map[string]any{
+				"iat": testNow.Unix(),
+				"exp": testNow.Add(48 * time.Hour).Unix(),
+			},
+			maxAge: 24 * time.Hour,
+			want:   ErrTooLong,
+		},
+		{
+			name:   "lifetime over max age without iat",
+			claims: map[string]any{"exp": testNow.Add(48 * time.Hour).Unix()},
+			maxAge: 24 * time.Hour,
+			want:   ErrTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := verifier(t, Options{Secret: testSecret, Leeway: leeway, MaxAge: tt.maxAge})
+			token := encode(t, map[string]string{"alg": HS256}, tt.claims, hs256(testSecret))
+			if _, err := v.Verify(token); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyKeyLookup(t *testing.T) {
+	k1, k2 := mustKey(t), mustKey(t)
+
+	single := verifier(t, Options{Keys: map[string]*rsa.PublicKey{"k1": &k1.PublicKey}})
+	pair := verifier(t, Options{Keys: map[string]*rsa.PublicKey{"k1": &k1.PublicKey, "k2": &k2.PublicKey}})
+
+	tests := []struct {
+		name string
+		v    *Verifier
+		kid  string
+		key  *rsa.PrivateKey
+		want error
+	}{
+		{name: "matching kid", v: pair, kid: "k2", key: k2},
+		{name: "kid of another key", v: pair, kid: "k1", key: k2, want: ErrSignature},
+		{name: "unknown kid", v: pair, kid: "k3", key: k1, want: ErrUnknownKey},
+		{name: "no kid with several keys", v: pair, key: k1, want: ErrUnknownKey},
+		{name: "no kid with single key", v: single, key: k1},
+		{name: "unknown kid with single key", v: single, kid: "k2", key: k1, want: ErrUnknownKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := map[string]string{"alg": RS256}
+			if tt.kid != "" {
+				h["kid"] = tt.kid
+			}
+			token := encode(t, h, validClaims(), rs256(t, tt.key))
+
+			claims, err := tt.v.Verify(token)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.want)
+			}
+			if err == nil && claims.Subject != "user-1" {
+				t.Fatalf("Subject = %q, want user-1", claims.Subject)
+			}
+		})
+	}
+}
+
+func TestSignHS256RoundTrip(t *testing.T) {
+	token, err := SignHS256(validClaims(), testSecret)
+	if err != nil {
+		t.Fatalf("SignHS256: %v", err)
+	}
+
+	claims, err := verifier(t, Options{Secret: testSecret}).Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if claims.Subject != "user-1" || !claims.ExpiresAt.Equal(testNow.Add(time.Hour)) {
+		t.Fatalf("claims = %+v", claims)
+	}
+}
@@ -0,0 +1,40 @@
+package actor
+
+import (
+	"context"
+	"net/http"
+)
+
+// Header — заголовок, в котором клиент передаёт идентификатор инициатора запроса
+const Header = "X-Actor-ID"
+
+// Anonymous используется, когда инициатор не указан
+const Anonymous = "anonymous"
+
+type ctxKey struct{}
+
+// New кладёт идентификатор инициатора из заголовка X-Actor-ID в контекст запроса
+func New() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if id := r.Header.Get(Header); id != "" {
+				r = r.WithContext(WithActor(r.Context(), id))
+			}
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+func WithActor(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext возвращает инициатора запроса или Anonymous
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(ctxKey{}).(string); ok && id != "" {
+		return id
+	}
+	return Anonymous
+}
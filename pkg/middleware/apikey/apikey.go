@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/middleware/auth"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// Header — заголовок, в котором внутренние сервисы передают API-ключ
+const Header = "X-API-Key"
+
+// Authenticator проверяет ключ и возвращает пользователя с правами ключа.
+// Неизвестный, отозванный или истёкший ключ — response.ErrUnauthorized.
+type Authenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+// New аутентифицирует запросы с заголовком X-API-Key и кладёт пользователя ключа
+// в контекст; дальше по цепочке auth.New такой запрос уже не проверяет. Запросы
+// без заголовка проходят без изменений.
+func New(log *slog.Logger, a Authenticator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware.apikey.New"
+
+			key := r.Header.Get(Header)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			p, err := a.AuthenticateAPIKey(r.Context(), key)
+			if err != nil {
+				log := log.With(
+					slog.String("op", op),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+				)
+
+				if errors.Is(err, response.ErrUnauthorized) {
+					log.Info("Rejected API key", slog.String("reason", err.Error()))
+					w.WriteHeader(http.StatusUnauthorized)
+					render.JSON(w, r, response.Error(string(response.UNAUTHORIZED), "invalid api key"))
+					return
+				}
+
+				log.Error("Failed to check API key", sl.Err(err))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error(string(response.FAILED_REQUEST), "failed to check api key"))
+				return
+			}
+
+			ctx := auth.WithPrincipal(r.Context(), p)
+			ctx = actor.WithActor(ctx, p.Subject)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
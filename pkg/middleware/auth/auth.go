@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"rasp-service/pkg/jwt"
+	"rasp-service/pkg/middleware/actor"
+	"rasp-service/pkg/middleware/tenant"
+	"rasp-service/pkg/response"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// Role — роль пользователя из токена
+type Role string
+
+const (
+	RoleAdmin   Role = "admin"
+	RoleTeacher Role = "teacher"
+	RoleStudent Role = "student"
+)
+
+// DefaultRoleClaim — поле токена с ролью по умолчанию
+const DefaultRoleClaim = "role"
+
+// DefaultTenantClaim — поле токена с арендатором по умолчанию
+const DefaultTenantClaim = "tenant"
+
+var (
+	ErrMissingToken  = errors.New("missing bearer token")
+	ErrNoSubject     = errors.New("token has no subject")
+	ErrNoRole        = errors.New("token has no known role")
+	ErrUnknownTenant = errors.New("token tenant is not served by this deployment")
+	ErrNoTenant      = errors.New("token has no tenant")
+)
+
+// Principal — аутентифицированный пользователь запроса. У API-ключа заполнены
+// APIKeyID и Scopes: права ключа сужают права роли. Tenant — арендатор, которому
+// принадлежит пользователь; пустой только без настроенных арендаторов.
+type Principal struct {
+	Subject string
+	Role    Role
+	Tenant  string
+
+	APIKeyID string
+	Scopes   []string
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin
+}
+
+func (p *Principal) IsAPIKey() bool {
+	return p.APIKeyID != ""
+}
+
+// HasScope проверяет право API-ключа; право "x:write" включает "x:read"
+func (p *Principal) HasScope(scope string) bool {
+	write, isRead := strings.CutSuffix(scope, ":read")
+	write += ":write"
+	for _, s := range p.Scopes {
+		if s == scope || (isRead && s == write) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows — можно ли пользователю операция с правом scope: ограничения прав
+// действуют только на API-ключи
+func (p *Principal) Allows(scope string) bool {
+	return !p.IsAPIKey() || p.HasScope(scope)
+}
+
+type ctxKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext возвращает пользователя запроса; false — запрос без аутентификации
+// (аутентификация выключена или вызов изнутри сервиса)
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(ctxKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// Authenticator превращает bearer-токен в Principal
+type Authenticator struct {
+	verifier  *jwt.Verifier
+	roleClaim string
+
+	tenantClaim string
+	tenants     *tenant.Resolver
+}
+
+func NewAuthenticator(verifier *jwt.Verifier, roleClaim string) *Authenticator {
+	if roleClaim == "" {
+		roleClaim = DefaultRoleClaim
+	}
+	return &Authenticator{verifier: verifier, roleClaim: roleClaim}
+}
+
+// WithTenants читает арендатора из поля claim; арендатор должен быть известен r.
+// Токен без арендатора допустим только при единственном арендаторе и привязывается к нему.
+func (a *Authenticator) WithTenants(claim string, r *tenant.Resolver) *Authenticator {
+	if claim == "" {
+		claim = DefaultTenantClaim
+	}
+	a.tenantClaim = claim
+	a.tenants = r
+	return a
+}
+
+// Authenticate проверяет значение заголовка Authorization вида "Bearer <jwt>"
+func (a *Authenticator) Authenticate(authorization string) (*Principal, error) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, ErrMissingToken
+	}
+
+	claims, err := a.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, ErrNoSubject
+	}
+
+	role, ok := pickRole(claims.Claim(a.roleClaim))
+	if !ok {
+		return nil, ErrNoRole
+	}
+
+	p := &Principal{Subject: claims.Subject, Role: role}
+
+	if a.tenants != nil {
+		values := claims.Claim(a.tenantClaim)
+		switch {
+		case len(values) > 0 && values[0] != "":
+			if !a.tenants.Known(values[0]) {
+				return nil, ErrUnknownTenant
+			}
+			p.Tenant = values[0]
+		case len(a.tenants.Tenants()) == 1:
+			p.Tenant = a.tenants.Tenants()[0]
+		default:
+			// иначе токен одной школы открывал бы любую сменой X-Tenant-ID
+			return nil, ErrNoTenant
+		}
+	}
+
+	return p, nil
+}
+
+// BindTenant делает арендатора пользователя арендатором запроса; явно запрошенный
+// чужой арендатор — response.ErrForbidden. Пользователь без арендатора бывает только
+// без настроенных арендаторов и работает в арендаторе из запроса.
+func BindTenant(ctx context.Context, p *Principal) (context.Context, error) {
+	if p.Tenant == "" {
+		return ctx, nil
+	}
+	if requested, ok := tenant.Requested(ctx); ok && requested != p.Tenant {
+		return ctx, response.ErrForbidden
+	}
+	return tenant.WithTenant(ctx, p.Tenant), nil
+}
+
+// pickRole выбирает самую широкую из известных ролей: в токене может быть список
+func pickRole(values []string) (Role, bool) {
+	found := map[Role]bool{}
+	for _, v := range values {
+		found[Role(strings.ToLower(v))] = true
+	}
+	for _, role := range []Role{RoleAdmin, RoleTeacher, RoleStudent} {
+		if found[role] {
+			return role, true
+		}
+	}
+	return "", false
+}
+
+// New требует валидный bearer-токен и кладёт пользователя в контекст. Инициатором
+// запроса (actor) становится subject токена: X-Actor-ID подделать уже нельзя, а
+// арендатором — арендатор из токена, если он там есть.
+// Запрос, уже аутентифицированный API-ключом, пропускается как есть.
+func New(log *slog.Logger, a *Authenticator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware.auth.New"
+
+			if _, ok := FromContext(r.Context()); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			p, err := a.Authenticate(r.Header.Get("Authorization"))
+			if err != nil {
+				log.Info("Unauthenticated request",
+					slog.String("op", op),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("reason", err.Error()),
+				)
+				Unauthorized(w, r, err)
+				return
+			}
+
+			ctx, err := BindTenant(r.Context(), p)
+			if err != nil {
+				log.Info("Token tenant mismatch",
+					slog.String("op", op),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("tenant", p.Tenant),
+				)
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error(string(response.FORBIDDEN), "token belongs to another tenant"))
+				return
+			}
+
+			ctx = WithPrincipal(ctx, p)
+			ctx = actor.WithActor(ctx, p.Subject)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// Unauthorized отвечает 401 с заголовком WWW-Authenticate по RFC 6750
+func Unauthorized(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrMissingToken) {
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+	} else {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="invalid_token", error_description=%q`, err.Error()))
+	}
+	w.WriteHeader(http.StatusUnauthorized)
+	render.JSON(w, r, response.Error(string(response.UNAUTHORIZED), "authentication required"))
+}
@@ -0,0 +1,299 @@
+// Package openapi проверяет запросы по спецификации api/openapi.yml: тело, параметры
+// пути и query. Запрос, не прошедший проверку, получает 400 со списком полей и до
+// обработчика не доходит. В режиме ValidateResponses ответы тоже сверяются со
+// спецификацией, расхождения пишутся в лог — режим для разработки.
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"rasp-service/pkg/response"
+	"rasp-service/pkg/sl"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// responseLimit — ответы больше этого размера не сверяются со спецификацией
+const responseLimit = 1 << 20
+
+type Options struct {
+	// ValidateResponses сверяет ответы со спецификацией и пишет расхождения в лог
+	ValidateResponses bool
+}
+
+// FieldError — ошибка одного поля запроса
+type FieldError struct {
+	// Field — имя параметра или путь в теле запроса, например items[0].status
+	Field string `json:"field,omitempty"`
+	// In — где поле: path, query, header или body
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
+
+func init() {
+	// импорт календаря принимает тело как text/calendar
+	openapi3filter.RegisterBodyDecoder("text/calendar", openapi3filter.PlainBodyDecoder)
+	// время в шаблонах доступности — HH:MM
+	openapi3.DefineStringFormatValidator("time", openapi3.NewRegexpFormatValidator(`^([01][0-9]|2[0-3]):[0-5][0-9]$`))
+}
+
+// New разбирает спецификацию и возвращает middleware. Запросы к путям и методам,
+// которых нет в спецификации, пропускаются без проверки.
+func New(log *slog.Logger, spec []byte, opts Options) (func(next http.Handler) http.Handler, error) {
+	const op = "middleware.openapi.New"
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// маршруты сопоставляются только по пути: servers в спецификации — адреса для документации
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	requestOptions := &openapi3filter.Options{
+		MultiError:          true,
+		SkipSettingDefaults: true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+	}
+	responseOptions := &openapi3filter.Options{
+		MultiError:            true,
+		IncludeResponseStatus: true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		log := log.With(
+			slog.String("component", "middleware/openapi"),
+		)
+
+		log.Info("openapi validation middleware enabled", slog.Bool("validate_responses", opts.ValidateResponses))
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil || emptyParam(pathParams) {
+				// 404 и 405 отдаст основной роутер
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    requestOptions,
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				fields := fieldErrors(err)
+				log.Warn("Request does not match OpenAPI spec",
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.Any("fields", fields),
+				)
+
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorWithDetails(string(response.VALIDATION_FAILED), "request does not match the API specification", map[string]any{
+					"fields": fields,
+				}))
+				return
+			}
+
+			if !opts.ValidateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			validateResponse(r.Context(), log, input, rec, responseOptions)
+		}
+
+		return http.HandlerFunc(fn)
+	}, nil
+}
+
+func validateResponse(ctx context.Context, log *slog.Logger, input *openapi3filter.RequestValidationInput, rec *recorder, opts *openapi3filter.Options) {
+	if rec.overflow || strings.HasPrefix(rec.Header().Get("Content-Type"), "text/event-stream") {
+		return
+	}
+
+	err := openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rec.status,
+		Header:                 rec.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+		Options:                opts,
+	})
+	if err != nil {
+		log.Warn("Response does not match OpenAPI spec",
+			slog.String("method", input.Request.Method),
+			slog.String("route", input.Route.Path),
+			slog.Int("status", rec.status),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			sl.Err(err),
+		)
+	}
+}
+
+// emptyParam — путь совпал с шаблоном только при пустом сегменте, например
+// /slots для /slots/{id}; такого маршрута в API нет
+func emptyParam(params map[string]string) bool {
+	for _, v := range params {
+		if v == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldErrors раскладывает ошибку openapi3filter на ошибки отдельных полей
+func fieldErrors(err error) []FieldError {
+	var fields []FieldError
+
+	if multi, ok := err.(openapi3.MultiError); ok {
+		for _, e := range multi {
+			fields = append(fields, fieldErrors(e)...)
+		}
+		return fields
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return []FieldError{{Message: err.Error()}}
+	}
+
+	switch {
+	case reqErr.Parameter != nil:
+		return []FieldError{{
+			Field:   reqErr.Parameter.Name,
+			In:      reqErr.Parameter.In,
+			Message: parameterMessage(reqErr),
+		}}
+	case reqErr.RequestBody != nil:
+		return bodyErrors(reqErr)
+	}
+
+	return []FieldError{{Message: reqErr.Error()}}
+}
+
+func parameterMessage(reqErr *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	var parseErr *openapi3filter.ParseError
+	if errors.As(reqErr.Err, &parseErr) {
+		return parseErr.Reason
+	}
+	if reqErr.Err != nil {
+		return reqErr.Err.Error()
+	}
+	return reqErr.Reason
+}
+
+func bodyErrors(reqErr *openapi3filter.RequestError) []FieldError {
+	var fields []FieldError
+
+	if multi, ok := reqErr.Err.(openapi3.MultiError); ok {
+		for _, e := range multi {
+			fields = append(fields, schemaField(e))
+		}
+		return fields
+	}
+
+	if reqErr.Err == nil {
+		return []FieldError{{In: "body", Message: reqErr.Reason}}
+	}
+
+	return []FieldError{schemaField(reqErr.Err)}
+}
+
+func schemaField(err error) FieldError {
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return FieldError{In: "body", Message: "failed to decode request body"}
+	}
+
+	return FieldError{
+		Field:   fieldPath(schemaErr.JSONPointer()),
+		In:      "body",
+		Message: schemaErr.Reason,
+	}
+}
+
+// fieldPath собирает путь вида items[0].status
+func fieldPath(pointer []string) string {
+	var b strings.Builder
+	for _, part := range pointer {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// recorder пропускает ответ клиенту и копит его тело для проверки
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+	overflow    bool
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	rec.wroteHeader = true
+	if !rec.overflow {
+		if rec.body.Len()+len(b) > responseLimit {
+			rec.overflow = true
+			rec.body.Reset()
+		} else {
+			rec.body.Write(b)
+		}
+	}
+	return rec.ResponseWriter.Write(b)
+}
+
+func (rec *recorder) Flush() {
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap нужен http.ResponseController (SSE снимает дедлайн записи)
+func (rec *recorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,143 @@
+// Package tenant — арендатор (школа) запроса. Все строки в базе принадлежат одному
+// арендатору; storage берёт его из контекста и без него к базе не обращается.
+package tenant
+
+import (
+	"rasp-service/pkg/response"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+)
+
+// Header — заголовок, в котором клиент передаёт идентификатор арендатора
+const Header = "X-Tenant-ID"
+
+// Query — параметр ссылки для клиентов, которые не умеют заголовки (ICS-ленты в календарях)
+const Query = "tenant"
+
+// Resolver знает арендаторов развёртывания и арендатора по умолчанию
+type Resolver struct {
+	def     string
+	tenants []string
+	known   map[string]bool
+}
+
+// NewResolver проверяет список арендаторов. Пустой def — арендатор обязателен в
+// каждом запросе; непустой должен быть в списке.
+func NewResolver(def string, tenants []string) (*Resolver, error) {
+	if len(tenants) == 0 {
+		return nil, errors.New("at least one tenant is required")
+	}
+
+	r := &Resolver{def: def, known: make(map[string]bool, len(tenants))}
+	for _, id := range tenants {
+		if id == "" {
+			return nil, errors.New("tenant id must not be empty")
+		}
+		if r.known[id] {
+			continue
+		}
+		r.known[id] = true
+		r.tenants = append(r.tenants, id)
+	}
+
+	if def != "" && !r.known[def] {
+		return nil, fmt.Errorf("default tenant %q is not in the tenant list", def)
+	}
+
+	return r, nil
+}
+
+// Tenants возвращает всех арендаторов — по ним проходят фоновые воркеры
+func (r *Resolver) Tenants() []string {
+	return r.tenants
+}
+
+func (r *Resolver) Default() string {
+	return r.def
+}
+
+func (r *Resolver) Known(id string) bool {
+	return r.known[id]
+}
+
+// Resolve возвращает арендатора запроса: пустой id — арендатор по умолчанию
+func (r *Resolver) Resolve(id string) (string, error) {
+	if id == "" {
+		if r.def == "" {
+			return "", response.ErrTenantRequired
+		}
+		return r.def, nil
+	}
+	if !r.known[id] {
+		return "", response.ErrUnknownTenant
+	}
+	return id, nil
+}
+
+// New кладёт в контекст арендатора из заголовка X-Tenant-ID или параметра tenant,
+// без них — арендатора по умолчанию. Неизвестный арендатор — 400 UNKNOWN_TENANT.
+func New(log *slog.Logger, r *Resolver) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, req *http.Request) {
+			const op = "middleware.tenant.New"
+
+			requested := req.Header.Get(Header)
+			if requested == "" {
+				requested = req.URL.Query().Get(Query)
+			}
+
+			id, err := r.Resolve(requested)
+			if err != nil {
+				log.Info("Rejected tenant",
+					slog.String("op", op),
+					slog.String("request_id", middleware.GetReqID(req.Context())),
+					slog.String("tenant", requested),
+					slog.String("reason", err.Error()),
+				)
+
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, req, response.Error(string(response.UNKNOWN_TENANT), err.Error()))
+				return
+			}
+
+			ctx := WithTenant(req.Context(), id)
+			if requested != "" {
+				ctx = WithRequested(ctx, id)
+			}
+			next.ServeHTTP(w, req.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+type ctxKey struct{}
+
+type requestedKey struct{}
+
+func WithTenant(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext возвращает арендатора запроса; false — арендатор не определён
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxKey{}).(string)
+	return id, ok && id != ""
+}
+
+// WithRequested отмечает, что клиент указал арендатора явно
+func WithRequested(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestedKey{}, id)
+}
+
+// Requested возвращает арендатора, явно указанного клиентом в заголовке или ссылке
+func Requested(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestedKey{}).(string)
+	return id, ok && id != ""
+}
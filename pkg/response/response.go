@@ -1,6 +1,10 @@
 package response
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // import (
 // 	"fmt"
@@ -15,6 +19,7 @@ type Response struct {
 type ResponseError struct {
 	Code string `json:"code"`
 	Message  string `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
 }
 
 //Error Codes
@@ -26,6 +31,23 @@ var (
 	LOCKED ErrCode = "LOCKED"
 	CONFLICT ErrCode = "CONFLICT"
 	SLOT_NOT_AVAILABLE ErrCode = "SLOT_NOT_AVAILABLE"
+	BOOKING_OVERLAP ErrCode = "BOOKING_OVERLAP"
+	BOOKING_RULE_VIOLATION ErrCode = "BOOKING_RULE_VIOLATION"
+	INVALID_REQUEST ErrCode = "INVALID_REQUEST"
+	ATTENDANCE_EXISTS ErrCode = "ATTENDANCE_EXISTS"
+	BOOKING_NOT_CONFIRMED ErrCode = "BOOKING_NOT_CONFIRMED"
+	LESSON_NOT_STARTED ErrCode = "LESSON_NOT_STARTED"
+	BULK_VALIDATION_FAILED ErrCode = "BULK_VALIDATION_FAILED"
+	INVALID_CHECK_IN_CODE ErrCode = "INVALID_CHECK_IN_CODE"
+	CHECK_IN_CLOSED ErrCode = "CHECK_IN_CLOSED"
+	STUDENT_RESTRICTED ErrCode = "STUDENT_RESTRICTED"
+	TEACHER_CONFIRMATION_REQUIRED ErrCode = "TEACHER_CONFIRMATION_REQUIRED"
+	CREDIT_NOT_AVAILABLE ErrCode = "CREDIT_NOT_AVAILABLE"
+	INVALID_CALENDAR_TOKEN ErrCode = "INVALID_CALENDAR_TOKEN"
+	VALIDATION_FAILED ErrCode = "VALIDATION_FAILED"
+	UNAUTHORIZED ErrCode = "UNAUTHORIZED"
+	FORBIDDEN ErrCode = "FORBIDDEN"
+	UNKNOWN_TENANT ErrCode = "UNKNOWN_TENANT"
 )
 
 var (
@@ -35,8 +57,79 @@ var (
 	ErrLocked = errors.New("resource is locked")
 	ErrConflict = errors.New("conflict")
 	ErrSlotNotAvailable = errors.New("slot is not available")
+	ErrBookingOverlap = errors.New("student already has a booking at this time")
+	ErrRuleViolation = errors.New("booking rule violated")
+	ErrBookingNotConfirmed = errors.New("booking is not confirmed")
+	ErrLessonNotStarted = errors.New("lesson has not started yet")
+	ErrAttendanceExists = errors.New("attendance already recorded for this booking")
+	ErrBulkValidation = errors.New("bulk request contains invalid items")
+	ErrInvalidCheckInCode = errors.New("invalid check-in code")
+	ErrCheckInClosed = errors.New("check-in is not open")
+	ErrStudentRestricted = errors.New("student is restricted from booking")
+	ErrTeacherConfirmationRequired = errors.New("booking must be confirmed by the teacher")
+	ErrCreditNotAvailable = errors.New("makeup credit is not available")
+	ErrInvalidCalendarToken = errors.New("invalid calendar token")
+	ErrUnauthorized = errors.New("authentication required")
+	ErrForbidden = errors.New("access denied")
+	ErrUnknownTenant = errors.New("unknown tenant")
+	ErrTenantRequired = errors.New("tenant is required")
 )
 
+// AttendanceExistsError несёт идентификатор уже существующей отметки посещаемости.
+type AttendanceExistsError struct {
+	AttendanceID string
+}
+
+func (e *AttendanceExistsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrAttendanceExists.Error(), e.AttendanceID)
+}
+
+func (e *AttendanceExistsError) Unwrap() error {
+	return ErrAttendanceExists
+}
+
+// StudentRestrictedError несёт действующее ограничение студента и срок его окончания.
+type StudentRestrictedError struct {
+	RestrictionID string
+	Until         time.Time
+}
+
+func (e *StudentRestrictedError) Error() string {
+	return fmt.Sprintf("%s until %s: %s", ErrStudentRestricted.Error(), e.Until.Format(time.RFC3339), e.RestrictionID)
+}
+
+func (e *StudentRestrictedError) Unwrap() error {
+	return ErrStudentRestricted
+}
+
+// BookingOverlapError несёт идентификатор бронирования, с которым пересекается новое.
+type BookingOverlapError struct {
+	BookingID string
+}
+
+func (e *BookingOverlapError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrBookingOverlap.Error(), e.BookingID)
+}
+
+func (e *BookingOverlapError) Unwrap() error {
+	return ErrBookingOverlap
+}
+
+// RuleViolationError описывает нарушенное правило бронирования: min_notice, max_advance или weekly_quota.
+type RuleViolationError struct {
+	Rule    string
+	Limit   int
+	Message string
+}
+
+func (e *RuleViolationError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", ErrRuleViolation.Error(), e.Rule, e.Message)
+}
+
+func (e *RuleViolationError) Unwrap() error {
+	return ErrRuleViolation
+}
+
 func Error(code, msg string) Response {
 	return Response{
 		ResponseError: ResponseError{
@@ -46,6 +139,12 @@ func Error(code, msg string) Response {
 	}
 }	
 
+func ErrorWithDetails(code, msg string, details map[string]any) Response {
+	resp := Error(code, msg)
+	resp.Details = details
+	return resp
+}
+
 // func ValidationError(errs validator.ValidationErrors) Response {
 // 	var errMsg []string
 
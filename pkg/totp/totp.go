@@ -0,0 +1,76 @@
+// Package totp — одноразовые коды по RFC 6238 (HMAC-SHA1, 6 цифр).
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const Digits = 6
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret возвращает случайный 160-битный секрет в base32
+func NewSecret() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("totp.NewSecret: %w", err)
+	}
+	return encoding.EncodeToString(buf), nil
+}
+
+// Generate считает код для момента t с шагом step
+func Generate(secret string, t time.Time, step time.Duration) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("totp.Generate: %w", err)
+	}
+	return code(key, counter(t, step)), nil
+}
+
+// Validate принимает код текущего шага и skew соседних шагов в обе стороны
+func Validate(secret, value string, t time.Time, step time.Duration, skew int) bool {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(value) != Digits {
+		return false
+	}
+
+	c := counter(t, step)
+	for i := -skew; i <= skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(code(key, c+uint64(i))), []byte(value)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpiresAt — конец шага, в котором действует код для момента t
+func ExpiresAt(t time.Time, step time.Duration) time.Time {
+	return time.Unix(int64(counter(t, step)+1)*int64(step/time.Second), 0)
+}
+
+func counter(t time.Time, step time.Duration) uint64 {
+	return uint64(t.Unix() / int64(step/time.Second))
+}
+
+func code(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation, RFC 4226 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, bin%1000000)
+}
@@ -0,0 +1,115 @@
+// Package webhook — подпись исходящих вебхуков (HMAC-SHA256) и её проверка на стороне получателя.
+//
+// Заголовок подписи имеет вид "t=<unix>,v1=<hex>", где hex — HMAC-SHA256 секрета
+// подписки от строки "<unix>.<тело запроса>". Метка времени защищает от повтора
+// перехваченного запроса.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	// DefaultTolerance — допустимое расхождение метки времени подписи с часами получателя
+	DefaultTolerance = 5 * time.Minute
+
+	signatureVersion = "v1"
+)
+
+var (
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrSignatureExpired = errors.New("webhook: signature timestamp outside tolerance")
+)
+
+// NewSecret возвращает случайный 256-битный секрет в hex с префиксом whsec_
+func NewSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("webhook.NewSecret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// Sign возвращает значение заголовка подписи тела body на момент t
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + "," + signatureVersion + "=" + mac(secret, ts, body)
+}
+
+// Verify проверяет заголовок подписи; tolerance <= 0 отключает проверку времени
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			ts = v
+		case signatureVersion:
+			signatures = append(signatures, v)
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	if tolerance > 0 {
+		if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+			return ErrSignatureExpired
+		}
+	}
+
+	expected := mac(secret, ts, body)
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// Backoff — задержка перед попыткой attempt+1 после attempt неудачных: base·2^(attempt-1), не больше max
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= max || d <= 0 {
+			return max
+		}
+	}
+	if d > max {
+		return max
+	}
+
+	return d
+}
+
+func mac(secret, ts string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte{'.'})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "whsec_test"
+
+func TestSignVerifyThroughReceiver(t *testing.T) {
+	body := []byte(`{"event":"booking.created"}`)
+	sent := time.Now()
+
+	var got error
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read body: %v", err)
+		}
+		got = Verify(testSecret, r.Header.Get(SignatureHeader), payload, time.Now(), DefaultTolerance)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	req, err := http.NewRequest(http.MethodPost, receiver.URL, strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set(SignatureHeader, Sign(testSecret, sent, body))
+
+	resp, err := receiver.Client().Do(req)
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+
+	if got != nil {
+		t.Fatalf("receiver Verify() = %v, want nil", got)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":1}`)
+	now := time.Unix(1_700_000_000, 0)
+	valid := Sign(testSecret, now, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		body      []byte
+		tolerance time.Duration
+		want      error
+	}{
+		{name: "valid", secret: testSecret, header: valid, body: body, tolerance: DefaultTolerance},
+		{name: "tampered body", secret: testSecret, header: valid, body: []byte(`{"id":2}`), tolerance: DefaultTolerance, want: ErrInvalidSignature},
+		{name: "wrong secret", secret: "whsec_other", header: valid, body: body, tolerance: DefaultTolerance, want: ErrInvalidSignature},
+		{
+			name:      "too old",
+			secret:    testSecret,
+			header:    Sign(testSecret, now.Add(-DefaultTolerance-time.Second), body),
+			body:      body,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureExpired,
+		},
+		{
+			name:      "from the future",
+			secret:    testSecret,
+			header:    Sign(testSecret, now.Add(DefaultTolerance+time.Second), body),
+			body:      body,
+			tolerance: DefaultTolerance,
+			want:      ErrSignatureExpired,
+		},
+		{
+			name:   "old but tolerance disabled",
+			secret: testSecret,
+			header: Sign(testSecret, now.Add(-time.Hour), body),
+			body:   body,
+		},
+		{
+			// при смене секрета в заголовке может быть несколько подписей
+			name:      "one of several signatures",
+			secret:    testSecret,
+			header:    valid + ",v1=deadbeef",
+			body:      body,
+			tolerance: DefaultTolerance,
+		},
+		{name: "no timestamp", secret: testSecret, header: "v1=deadbeef", body: body, want: ErrInvalidSignature},
+		{name: "no signature", secret: testSecret, header: "t=1700000000", body: body, want: ErrInvalidSignature},
+		{name: "empty header", secret: testSecret, header: "", body: body, want: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify(tt.secret, tt.header, tt.body, now, tt.tolerance); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	const (
+		base = 30 * time.Second
+		max  = 10 * time.Minute
+	)
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: base},
+		{attempt: 1, want: base},
+		{attempt: 2, want: 2 * base},
+		{attempt: 3, want: 4 * base},
+		{attempt: 5, want: 16 * base},
+		{attempt: 6, want: max},
+		{attempt: 100, want: max},
+		// удвоение переполнило бы time.Duration
+		{attempt: 1000, want: max},
+	}
+
+	for _, tt := range tests {
+		if got := Backoff(tt.attempt, base, max); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+
+	if got := Backoff(1, time.Hour, max); got != max {
+		t.Errorf("Backoff with base above max = %s, want %s", got, max)
+	}
+}